@@ -6,6 +6,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
@@ -17,7 +18,10 @@ import (
 	"strings"
 	"testing"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/request"
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -258,6 +262,14 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			meta = new(conns.AWSClient)
 		}
 		meta.SetHTTPClient(httpClient)
+		// Don't retry AWS SDK for Go v2 API requests if a recorded interaction isn't found.
+		meta.AddSDKv2IsErrorRetryables(retry.IsErrorRetryableFunc(func(err error) aws_sdkv2.Ternary {
+			if errors.Is(err, cassette.ErrInteractionNotFound) {
+				return aws_sdkv2.FalseTernary
+			}
+
+			return aws_sdkv2.UnknownTernary
+		}))
 		provider.SetMeta(meta)
 
 		if v, diags := configureContextFunc(ctx, d); diags.HasError() {
@@ -266,16 +278,22 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 			meta = v.(*conns.AWSClient)
 		}
 
-		// Don't retry requests if a recorded interaction isn't found.
-		// TODO Need to loop through all API clients to do this.
-		// TODO Use []*client.Client?
-		// TODO AWS SDK for Go v2 API clients.
-		meta.LogsConn().Handlers.AfterRetry.PushFront(func(r *request.Request) {
-			// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
-			if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
-				r.Retryable = aws.Bool(false)
-			}
-		})
+		// Don't retry AWS SDK for Go v1 API requests if a recorded interaction isn't found.
+		// Only the API clients of service packages whose acceptance tests support VCR are handled.
+		for _, c := range []*client.Client{
+			meta.APIGatewayV2Conn().Client,
+			meta.ECRConn().Client,
+			meta.ECSConn().Client,
+			meta.LogsConn().Client,
+			meta.Route53Conn().Client,
+		} {
+			c.Handlers.AfterRetry.PushFront(func(r *request.Request) {
+				// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
+				if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
+					r.Retryable = aws.Bool(false)
+				}
+			})
+		}
 
 		providerMetas[testName] = meta
 
@@ -454,3 +472,29 @@ func RandInt(t *testing.T) int {
 func RandomWithPrefix(t *testing.T, prefix string) string {
 	return fmt.Sprintf("%s-%d", prefix, RandInt(t))
 }
+
+// RandString is a VCR-friendly replacement for acctest.RandString.
+func RandString(t *testing.T, strlen int) string {
+	return RandStringFromCharSet(t, strlen, sdkacctest.CharSetAlphaNum)
+}
+
+// RandStringFromCharSet is a VCR-friendly replacement for acctest.RandStringFromCharSet.
+func RandStringFromCharSet(t *testing.T, strlen int, charSet string) string {
+	if !isVCREnabled() {
+		return sdkacctest.RandStringFromCharSet(strlen, charSet)
+	}
+
+	s, err := vcrRandomnessSource(t)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := rand.New(s.source)
+	result := make([]byte, strlen)
+	for i := 0; i < strlen; i++ {
+		result[i] = charSet[r.Intn(len(charSet))]
+	}
+
+	return string(result)
+}
@@ -45,3 +45,26 @@ func TestRandomWithPrefix(t *testing.T) { //nolint:paralleltest
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestRandString(t *testing.T) { //nolint:paralleltest
+	t.Setenv("VCR_PATH", t.TempDir())
+
+	t.Setenv("VCR_MODE", "RECORDING")
+	rec1 := acctest.RandString(t, 16)
+	rec2 := acctest.RandString(t, 16)
+	acctest.CloseVCRRecorder(t)
+
+	t.Setenv("VCR_MODE", "REPLAYING")
+	rep1 := acctest.RandString(t, 16)
+	rep2 := acctest.RandString(t, 16)
+
+	if rep1 != rec1 {
+		t.Errorf("REPLAYING: %s, RECORDING: %s", rep1, rec1)
+	}
+	if rep2 != rec2 {
+		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
+	}
+	if len(rec1) != 16 {
+		t.Errorf("length: %d", len(rec1))
+	}
+}
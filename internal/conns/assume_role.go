@@ -0,0 +1,90 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// chainAssumeRoles assumes each of the specified IAM roles in turn, starting from the credentials in cfg.
+// The returned configuration uses the credentials of the last role assumed.
+func (c *Config) chainAssumeRoles(ctx context.Context, cfg aws_sdkv2.Config, assumeRoles []*awsbase.AssumeRole) (aws_sdkv2.Config, error) {
+	for _, ar := range assumeRoles {
+		if ar == nil || ar.RoleARN == "" {
+			continue
+		}
+
+		tflog.Info(ctx, "Assuming chained IAM Role", map[string]any{
+			"tf_aws.assume_role.role_arn":        ar.RoleARN,
+			"tf_aws.assume_role.session_name":    ar.SessionName,
+			"tf_aws.assume_role.external_id":     ar.ExternalID,
+			"tf_aws.assume_role.source_identity": ar.SourceIdentity,
+		})
+
+		client := sts.NewFromConfig(cfg, func(o *sts.Options) {
+			if c.STSRegion != "" {
+				o.Region = c.STSRegion
+			}
+
+			if endpoint := c.Endpoints[names.STS]; endpoint != "" {
+				o.EndpointResolver = sts.EndpointResolverFromURL(endpoint)
+			}
+		})
+
+		provider := stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			expandAssumeRoleOptions(o, ar)
+		})
+
+		// Fail fast if the role can't be assumed, rather than on first use.
+		if _, err := provider.Retrieve(ctx); err != nil {
+			return cfg, fmt.Errorf("assuming IAM Role (%s): %w", ar.RoleARN, err)
+		}
+
+		cfg = cfg.Copy()
+		cfg.Credentials = aws_sdkv2.NewCredentialsCache(provider)
+	}
+
+	return cfg, nil
+}
+
+func expandAssumeRoleOptions(o *stscreds.AssumeRoleOptions, ar *awsbase.AssumeRole) {
+	o.Duration = ar.Duration
+
+	if ar.ExternalID != "" {
+		o.ExternalID = aws_sdkv2.String(ar.ExternalID)
+	}
+
+	if ar.Policy != "" {
+		o.Policy = aws_sdkv2.String(ar.Policy)
+	}
+
+	for _, v := range ar.PolicyARNs {
+		o.PolicyARNs = append(o.PolicyARNs, ststypes.PolicyDescriptorType{
+			Arn: aws_sdkv2.String(v),
+		})
+	}
+
+	if ar.SessionName != "" {
+		o.RoleSessionName = ar.SessionName
+	}
+
+	if ar.SourceIdentity != "" {
+		o.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+	}
+
+	for k, v := range ar.Tags {
+		o.Tags = append(o.Tags, ststypes.Tag{
+			Key:   aws_sdkv2.String(k),
+			Value: aws_sdkv2.String(v),
+		})
+	}
+
+	o.TransitiveTagKeys = ar.TransitiveTagKeys
+}
@@ -0,0 +1,66 @@
+package conns
+
+import (
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+func TestExpandAssumeRoleOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    *awsbase.AssumeRole
+		expected stscreds.AssumeRoleOptions
+	}{
+		{
+			name:  "role ARN only",
+			input: &awsbase.AssumeRole{RoleARN: "arn:aws:iam::123456789012:role/bastion"}, //lintignore:AWSAT005
+		},
+		{
+			name: "all options",
+			input: &awsbase.AssumeRole{
+				RoleARN:           "arn:aws:iam::123456789012:role/deploy", //lintignore:AWSAT005
+				Duration:          30 * time.Minute,
+				ExternalID:        "ext",
+				Policy:            `{"Version":"2012-10-17"}`,
+				PolicyARNs:        []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"}, //lintignore:AWSAT005
+				SessionName:       "deploy",
+				SourceIdentity:    "ci",
+				Tags:              map[string]string{"Team": "platform"},
+				TransitiveTagKeys: []string{"Team"},
+			},
+			expected: stscreds.AssumeRoleOptions{
+				Duration:          30 * time.Minute,
+				ExternalID:        aws_sdkv2.String("ext"),
+				Policy:            aws_sdkv2.String(`{"Version":"2012-10-17"}`),
+				PolicyARNs:        []ststypes.PolicyDescriptorType{{Arn: aws_sdkv2.String("arn:aws:iam::aws:policy/ReadOnlyAccess")}}, //lintignore:AWSAT005
+				RoleSessionName:   "deploy",
+				SourceIdentity:    aws_sdkv2.String("ci"),
+				Tags:              []ststypes.Tag{{Key: aws_sdkv2.String("Team"), Value: aws_sdkv2.String("platform")}},
+				TransitiveTagKeys: []string{"Team"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got stscreds.AssumeRoleOptions
+			expandAssumeRoleOptions(&got, testCase.input)
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreUnexported(ststypes.PolicyDescriptorType{}, ststypes.Tag{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
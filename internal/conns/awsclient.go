@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go/service/s3"
 )
 
@@ -43,3 +44,19 @@ func (client *AWSClient) SetHTTPClient(httpClient *http.Client) {
 func (client *AWSClient) HTTPClient() *http.Client {
 	return client.httpClient
 }
+
+// AddSDKv2IsErrorRetryables adds checks of whether errors returned by AWS SDK for Go v2 API calls are retryable.
+// A check returning a known value takes precedence over the API client's retryer.
+// To have effect it must be called before the AWS SDK v1 Session is created.
+func (client *AWSClient) AddSDKv2IsErrorRetryables(retryables ...retry.IsErrorRetryable) {
+	if client.Session == nil {
+		client.sdkv2IsErrorRetryables = append(client.sdkv2IsErrorRetryables, retryables...)
+	}
+}
+
+// ShouldRetryReadNotFound returns whether a resource read that finds nothing should be retried.
+// Reads of newly created resources are always retried to allow for eventual consistency.
+// Reads of resources already in state are retried unless skip_refresh_propagation_retries is set.
+func (client *AWSClient) ShouldRetryReadNotFound(isNewResource bool) bool {
+	return isNewResource || !client.SkipRefreshPropagationRetries
+}
@@ -4,12 +4,15 @@ package conns
 import (
 	"net/http"
 
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	cloudwatchlogs_sdkv2 "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/comprehend"
 	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	configservice_sdkv2 "github.com/aws/aws-sdk-go-v2/service/configservice"
 	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ecs_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/aws/aws-sdk-go-v2/service/fis"
 	"github.com/aws/aws-sdk-go-v2/service/identitystore"
 	"github.com/aws/aws-sdk-go-v2/service/inspector2"
@@ -324,25 +327,34 @@ import (
 )
 
 type AWSClient struct {
-	AccountID               string
-	DefaultTagsConfig       *tftags.DefaultConfig
-	DNSSuffix               string
-	IgnoreTagsConfig        *tftags.IgnoreConfig
-	MediaConvertAccountConn *mediaconvert.MediaConvert
-	Partition               string
-	Region                  string
-	ReverseDNSPrefix        string
-	ServicePackages         []intf.ServicePackage
-	Session                 *session.Session
-	TerraformVersion        string
-
-	httpClient *http.Client
-
-	ec2Client       lazyClient[*ec2_sdkv2.Client]
-	logsClient      lazyClient[*cloudwatchlogs_sdkv2.Client]
-	rdsClient       lazyClient[*rds_sdkv2.Client]
-	s3controlClient lazyClient[*s3control_sdkv2.Client]
-	ssmClient       lazyClient[*ssm_sdkv2.Client]
+	AccountID                     string
+	DefaultTagsConfig             *tftags.DefaultConfig
+	DNSSuffix                     string
+	IgnoreTagsConfig              *tftags.IgnoreConfig
+	MediaConvertAccountConn       *mediaconvert.MediaConvert
+	Partition                     string
+	Region                        string
+	ReverseDNSPrefix              string
+	ServicePackages               []intf.ServicePackage
+	Session                       *session.Session
+	SkipRefreshPropagationRetries bool
+	SkipTagging                   bool
+	StrictTagging                 bool
+	TerraformVersion              string
+	WaitForStabilization          bool
+
+	apiCallMetrics         *apiCallMetrics
+	httpClient             *http.Client
+	sdkv2IsErrorRetryables retry.IsErrorRetryables
+	tagBatcher             *tagBatcher
+
+	configserviceClient lazyClient[*configservice_sdkv2.Client]
+	ec2Client           lazyClient[*ec2_sdkv2.Client]
+	ecsClient           lazyClient[*ecs_sdkv2.Client]
+	logsClient          lazyClient[*cloudwatchlogs_sdkv2.Client]
+	rdsClient           lazyClient[*rds_sdkv2.Client]
+	s3controlClient     lazyClient[*s3control_sdkv2.Client]
+	ssmClient           lazyClient[*ssm_sdkv2.Client]
 
 	acmConn                          *acm.ACM
 	acmpcaConn                       *acmpca.ACMPCA
@@ -917,6 +929,10 @@ func (client *AWSClient) ConfigServiceConn() *configservice.ConfigService {
 	return client.configserviceConn
 }
 
+func (client *AWSClient) ConfigServiceClient() *configservice_sdkv2.Client {
+	return client.configserviceClient.Client()
+}
+
 func (client *AWSClient) ConnectConn() *connect.Connect {
 	return client.connectConn
 }
@@ -1037,6 +1053,10 @@ func (client *AWSClient) ECSConn() *ecs.ECS {
 	return client.ecsConn
 }
 
+func (client *AWSClient) ECSClient() *ecs_sdkv2.Client {
+	return client.ecsClient.Client()
+}
+
 func (client *AWSClient) EFSConn() *efs.EFS {
 	return client.efsConn
 }
@@ -87,3 +87,54 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+func TestAWSClientShouldRetryReadNotFound(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		AWSClient     *AWSClient
+		IsNewResource bool
+		Expected      bool
+	}{
+		{
+			Name:          "new resource",
+			AWSClient:     &AWSClient{},
+			IsNewResource: true,
+			Expected:      true,
+		},
+		{
+			Name:      "existing resource",
+			AWSClient: &AWSClient{},
+			Expected:  true,
+		},
+		{
+			Name: "new resource skip refresh propagation retries",
+			AWSClient: &AWSClient{
+				SkipRefreshPropagationRetries: true,
+			},
+			IsNewResource: true,
+			Expected:      true,
+		},
+		{
+			Name: "existing resource skip refresh propagation retries",
+			AWSClient: &AWSClient{
+				SkipRefreshPropagationRetries: true,
+			},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.AWSClient.ShouldRetryReadNotFound(testCase.IsNewResource)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
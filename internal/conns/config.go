@@ -46,8 +46,10 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	APICallMetrics                 bool
+	AssumeRole                     []*awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	BatchTagReads                  bool
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -63,18 +65,24 @@ type Config struct {
 	Region                         string
 	S3UsePathStyle                 bool
 	SecretKey                      string
+	ServiceEndpointOptions         map[string]*ServiceEndpointOptions
+	ServiceRetries                 map[string]*ServiceRetryConfig
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
 	SkipGetEC2Platforms            bool
+	SkipRefreshPropagationRetries  bool
 	SkipRegionValidation           bool
 	SkipRequestingAccountId        bool
+	SkipTagging                    bool
+	StrictTagging                  bool
 	STSRegion                      string
 	SuppressDebugLog               bool
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
+	WaitForStabilization           bool
 }
 
 // ConfigureProvider configures the provided provider Meta (instance data).
@@ -103,8 +111,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:               c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	// The first role is assumed by the base library, any further roles are chained from it.
+	if len(c.AssumeRole) > 0 && c.AssumeRole[0] != nil && c.AssumeRole[0].RoleARN != "" {
+		awsbaseConfig.AssumeRole = c.AssumeRole[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -133,6 +142,17 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
 	}
 
+	if len(c.AssumeRole) > 1 {
+		cfg, err = c.chainAssumeRoles(ctx, cfg, c.AssumeRole[1:])
+		if err != nil {
+			return nil, diag.Errorf("configuring Terraform AWS Provider: %s", err)
+		}
+	}
+
+	if len(client.sdkv2IsErrorRetryables) > 0 {
+		cfg.Retryer = withIsErrorRetryables(cfg.Retryer, client.sdkv2IsErrorRetryables)
+	}
+
 	if !c.SkipRegionValidation {
 		if err := awsbase.ValidateRegion(cfg.Region); err != nil {
 			return nil, diag.FromErr(err)
@@ -180,7 +200,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.AccountID = accountID
-	client.DefaultTagsConfig = c.DefaultTagsConfig
+	client.DefaultTagsConfig = resolveDefaultTags(ctx, c.DefaultTagsConfig, c.defaultTagsPlaceholders(accountID, partition))
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
@@ -188,13 +208,27 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
+	client.SkipRefreshPropagationRetries = c.SkipRefreshPropagationRetries
+	client.SkipTagging = c.SkipTagging
+	client.StrictTagging = c.StrictTagging
 	client.TerraformVersion = c.TerraformVersion
+	client.WaitForStabilization = c.WaitForStabilization
+
+	if c.APICallMetrics {
+		client.apiCallMetrics = newAPICallMetrics()
+		client.apiCallMetrics.instrumentSDKv1(&sess.Handlers)
+		cfg.APIOptions = append(cfg.APIOptions, client.apiCallMetrics.sdkv2APIOption)
+	}
 
 	// API clients (generated).
 	c.sdkv1Conns(client, sess)
 	c.sdkv2Conns(client, cfg)
 	c.sdkv2LazyConns(client, cfg)
 
+	if c.BatchTagReads {
+		client.tagBatcher = newTagBatcher(client.ResourceGroupsTaggingAPIConn())
+	}
+
 	// AWS SDK for Go v1 custom API clients.
 
 	// STS.
@@ -204,14 +238,14 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	if c.STSRegion != "" {
 		stsConfig.Region = aws.String(c.STSRegion)
 	}
-	client.stsConn = sts.New(sess.Copy(stsConfig))
+	client.stsConn = sts.New(sess.Copy(c.sdkv1EndpointOptions(names.STS, stsConfig)))
 
 	// Services that require multiple client configurations.
 	s3Config := &aws.Config{
 		Endpoint:         aws.String(c.Endpoints[names.S3]),
 		S3ForcePathStyle: aws.Bool(c.S3UsePathStyle),
 	}
-	client.s3Conn = s3.New(sess.Copy(s3Config))
+	client.s3Conn = s3.New(sess.Copy(c.sdkv1EndpointOptions(names.S3, s3Config)))
 
 	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
 	client.s3ConnURICleaningDisabled = s3.New(sess.Copy(s3Config))
@@ -252,11 +286,11 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
 	}
 
-	client.globalacceleratorConn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
-	client.route53Conn = route53.New(sess.Copy(route53Config))
-	client.route53recoverycontrolconfigConn = route53recoverycontrolconfig.New(sess.Copy(route53RecoveryControlConfigConfig))
-	client.route53recoveryreadinessConn = route53recoveryreadiness.New(sess.Copy(route53RecoveryReadinessConfig))
-	client.shieldConn = shield.New(sess.Copy(shieldConfig))
+	client.globalacceleratorConn = globalaccelerator.New(sess.Copy(c.sdkv1EndpointOptions(names.GlobalAccelerator, globalAcceleratorConfig)))
+	client.route53Conn = route53.New(sess.Copy(c.sdkv1EndpointOptions(names.Route53, route53Config)))
+	client.route53recoverycontrolconfigConn = route53recoverycontrolconfig.New(sess.Copy(c.sdkv1EndpointOptions(names.Route53RecoveryControlConfig, route53RecoveryControlConfigConfig)))
+	client.route53recoveryreadinessConn = route53recoveryreadiness.New(sess.Copy(c.sdkv1EndpointOptions(names.Route53RecoveryReadiness, route53RecoveryReadinessConfig)))
+	client.shieldConn = shield.New(sess.Copy(c.sdkv1EndpointOptions(names.Shield, shieldConfig)))
 
 	client.apigatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
 		// Many operations can return an error such as:
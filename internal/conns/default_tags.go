@@ -0,0 +1,57 @@
+package conns
+
+import (
+	"context"
+	"strings"
+
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// Placeholders that can be used in default_tags values.
+const (
+	defaultTagsPlaceholderAccountID   = "{{account_id}}"
+	defaultTagsPlaceholderPartition   = "{{partition}}"
+	defaultTagsPlaceholderRegion      = "{{region}}"
+	defaultTagsPlaceholderSessionName = "{{session_name}}"
+)
+
+// defaultTagsPlaceholders returns the values of the default_tags placeholders for the provider configuration.
+func (c *Config) defaultTagsPlaceholders(accountID, partition string) map[string]string {
+	var sessionName string
+
+	// With chained roles, the session is that of the last role assumed.
+	if n := len(c.AssumeRole); n > 0 && c.AssumeRole[n-1] != nil && c.AssumeRole[n-1].SessionName != "" {
+		sessionName = c.AssumeRole[n-1].SessionName
+	} else if c.AssumeRoleWithWebIdentity != nil {
+		sessionName = c.AssumeRoleWithWebIdentity.SessionName
+	}
+
+	return map[string]string{
+		defaultTagsPlaceholderAccountID:   accountID,
+		defaultTagsPlaceholderPartition:   partition,
+		defaultTagsPlaceholderRegion:      c.Region,
+		defaultTagsPlaceholderSessionName: sessionName,
+	}
+}
+
+// resolveDefaultTags returns a copy of config with placeholders in tag values replaced.
+func resolveDefaultTags(ctx context.Context, config *tftags.DefaultConfig, placeholders map[string]string) *tftags.DefaultConfig {
+	if config == nil || len(config.Tags) == 0 {
+		return config
+	}
+
+	var oldnew []string
+	for k, v := range placeholders {
+		oldnew = append(oldnew, k, v)
+	}
+	replacer := strings.NewReplacer(oldnew...)
+
+	tags := make(map[string]string, len(config.Tags))
+	for k, v := range config.Tags.Map() {
+		tags[k] = replacer.Replace(v)
+	}
+
+	return &tftags.DefaultConfig{
+		Tags: tftags.New(ctx, tags),
+	}
+}
@@ -0,0 +1,48 @@
+package conns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func TestResolveDefaultTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := &Config{
+		AssumeRole: []*awsbase.AssumeRole{{SessionName: "bastion"}, {SessionName: "deploy"}},
+		Region:     "us-west-2", //lintignore:AWSAT003
+	}
+	config := &tftags.DefaultConfig{
+		Tags: tftags.New(ctx, map[string]string{
+			"Owner":      "{{account_id}}/{{session_name}}",
+			"Location":   "{{partition}}:{{region}}",
+			"Team":       "platform",
+			"Unresolved": "{{unknown}}",
+		}),
+	}
+
+	got := resolveDefaultTags(ctx, config, c.defaultTagsPlaceholders("123456789012", "aws")).Tags.Map()
+	want := map[string]string{
+		"Owner":      "123456789012/deploy",
+		"Location":   "aws:us-west-2", //lintignore:AWSAT003
+		"Team":       "platform",
+		"Unresolved": "{{unknown}}",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if got := config.Tags.Map()["Owner"]; got != "{{account_id}}/{{session_name}}" {
+		t.Errorf("configured tags modified: %s", got)
+	}
+
+	if resolveDefaultTags(ctx, nil, nil) != nil {
+		t.Error("expected nil config")
+	}
+}
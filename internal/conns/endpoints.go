@@ -0,0 +1,52 @@
+package conns
+
+import (
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// ServiceEndpointOptions selects the variant of the endpoint resolved for the API clients of a single service.
+// The options can only enable a variant; a variant enabled at the provider level applies to all services.
+// They have no effect if the service endpoint URL is overridden.
+type ServiceEndpointOptions struct {
+	UseDualStackEndpoint bool
+	UseFIPSEndpoint      bool
+}
+
+// sdkv1EndpointOptions sets the endpoint variant in the AWS SDK for Go v1 client configuration for the specified service.
+func (c *Config) sdkv1EndpointOptions(pkg string, config *aws.Config) *aws.Config {
+	v, ok := c.ServiceEndpointOptions[pkg]
+
+	if !ok {
+		return config
+	}
+
+	if v.UseDualStackEndpoint {
+		config.UseDualStackEndpoint = endpoints.DualStackEndpointStateEnabled
+	}
+
+	if v.UseFIPSEndpoint {
+		config.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+	}
+
+	return config
+}
+
+// sdkv2DualStackEndpointState returns the AWS SDK for Go v2 dual-stack endpoint state for the specified service.
+func (c *Config) sdkv2DualStackEndpointState(pkg string, state aws_sdkv2.DualStackEndpointState) aws_sdkv2.DualStackEndpointState {
+	if v, ok := c.ServiceEndpointOptions[pkg]; ok && v.UseDualStackEndpoint {
+		return aws_sdkv2.DualStackEndpointStateEnabled
+	}
+
+	return state
+}
+
+// sdkv2FIPSEndpointState returns the AWS SDK for Go v2 FIPS endpoint state for the specified service.
+func (c *Config) sdkv2FIPSEndpointState(pkg string, state aws_sdkv2.FIPSEndpointState) aws_sdkv2.FIPSEndpointState {
+	if v, ok := c.ServiceEndpointOptions[pkg]; ok && v.UseFIPSEndpoint {
+		return aws_sdkv2.FIPSEndpointStateEnabled
+	}
+
+	return state
+}
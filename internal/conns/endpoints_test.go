@@ -0,0 +1,74 @@
+package conns
+
+import (
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestConfigSDKV1EndpointOptions(t *testing.T) {
+	t.Parallel()
+
+	c := &Config{
+		ServiceEndpointOptions: map[string]*ServiceEndpointOptions{
+			names.ECR: {UseDualStackEndpoint: true},
+			names.ECS: {UseFIPSEndpoint: true},
+		},
+	}
+
+	sess := &session.Session{Config: aws.NewConfig()}
+
+	config := c.sdkv1Config(names.EC2, sess)
+
+	if got, expected := config.UseDualStackEndpoint, endpoints.DualStackEndpointStateUnset; got != expected {
+		t.Errorf("EC2 dual-stack endpoint state: got %v, expected %v", got, expected)
+	}
+	if got, expected := config.UseFIPSEndpoint, endpoints.FIPSEndpointStateUnset; got != expected {
+		t.Errorf("EC2 FIPS endpoint state: got %v, expected %v", got, expected)
+	}
+
+	config = c.sdkv1Config(names.ECR, sess)
+
+	if got, expected := config.UseDualStackEndpoint, endpoints.DualStackEndpointStateEnabled; got != expected {
+		t.Errorf("ECR dual-stack endpoint state: got %v, expected %v", got, expected)
+	}
+	if got, expected := config.UseFIPSEndpoint, endpoints.FIPSEndpointStateUnset; got != expected {
+		t.Errorf("ECR FIPS endpoint state: got %v, expected %v", got, expected)
+	}
+
+	config = c.sdkv1Config(names.ECS, sess)
+
+	if got, expected := config.UseDualStackEndpoint, endpoints.DualStackEndpointStateUnset; got != expected {
+		t.Errorf("ECS dual-stack endpoint state: got %v, expected %v", got, expected)
+	}
+	if got, expected := config.UseFIPSEndpoint, endpoints.FIPSEndpointStateEnabled; got != expected {
+		t.Errorf("ECS FIPS endpoint state: got %v, expected %v", got, expected)
+	}
+}
+
+func TestConfigSDKV2EndpointStates(t *testing.T) {
+	t.Parallel()
+
+	c := &Config{
+		ServiceEndpointOptions: map[string]*ServiceEndpointOptions{
+			names.SSM: {UseDualStackEndpoint: true, UseFIPSEndpoint: true},
+		},
+	}
+
+	if got, expected := c.sdkv2FIPSEndpointState(names.Route53Domains, aws_sdkv2.FIPSEndpointStateUnset), aws_sdkv2.FIPSEndpointStateUnset; got != expected {
+		t.Errorf("Route 53 Domains FIPS endpoint state: got %v, expected %v", got, expected)
+	}
+	if got, expected := c.sdkv2FIPSEndpointState(names.Route53Domains, aws_sdkv2.FIPSEndpointStateEnabled), aws_sdkv2.FIPSEndpointStateEnabled; got != expected {
+		t.Errorf("Route 53 Domains FIPS endpoint state (provider-level): got %v, expected %v", got, expected)
+	}
+	if got, expected := c.sdkv2FIPSEndpointState(names.SSM, aws_sdkv2.FIPSEndpointStateDisabled), aws_sdkv2.FIPSEndpointStateEnabled; got != expected {
+		t.Errorf("SSM FIPS endpoint state: got %v, expected %v", got, expected)
+	}
+	if got, expected := c.sdkv2DualStackEndpointState(names.SSM, aws_sdkv2.DualStackEndpointStateUnset), aws_sdkv2.DualStackEndpointStateEnabled; got != expected {
+		t.Errorf("SSM dual-stack endpoint state: got %v, expected %v", got, expected)
+	}
+}
@@ -0,0 +1,202 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http/httptrace"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// apiCallMetrics counts the AWS API calls made by a configured provider, by service and operation,
+// and the HTTP connections used to make them.
+type apiCallMetrics struct {
+	mu         sync.Mutex
+	operations map[apiOperation]*apiOperationMetrics
+
+	newConns    int64
+	reusedConns int64
+}
+
+// apiCallMetricsContextKey is the context key of the metrics of a single provider operation.
+type apiCallMetricsContextKey struct{}
+
+type apiOperation struct {
+	service   string
+	operation string
+}
+
+type apiOperationMetrics struct {
+	calls   int
+	retries int
+}
+
+func newAPICallMetrics() *apiCallMetrics {
+	return &apiCallMetrics{
+		operations: make(map[apiOperation]*apiOperationMetrics),
+	}
+}
+
+// recordCall records a completed API call, including the number of times it was retried.
+func (m *apiCallMetrics) recordCall(service, operation string, retries int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	k := apiOperation{service: service, operation: operation}
+	v, ok := m.operations[k]
+
+	if !ok {
+		v = &apiOperationMetrics{}
+		m.operations[k] = v
+	}
+
+	v.calls++
+	v.retries += retries
+}
+
+// recordCallContext records a completed API call, also in the metrics of the provider operation in ctx, if any.
+func (m *apiCallMetrics) recordCallContext(ctx context.Context, service, operation string, retries int) {
+	m.recordCall(service, operation, retries)
+
+	if v, ok := ctx.Value(apiCallMetricsContextKey{}).(*apiCallMetrics); ok {
+		v.recordCall(service, operation, retries)
+	}
+}
+
+// recordConn records an HTTP connection used to make an API call.
+func (m *apiCallMetrics) recordConn(reused bool) {
+	if reused {
+		atomic.AddInt64(&m.reusedConns, 1)
+	} else {
+		atomic.AddInt64(&m.newConns, 1)
+	}
+}
+
+// clientTrace returns an HTTP client trace that counts new and reused connections,
+// also in the metrics of the provider operation in ctx, if any.
+func (m *apiCallMetrics) clientTrace(ctx context.Context) *httptrace.ClientTrace {
+	op, _ := ctx.Value(apiCallMetricsContextKey{}).(*apiCallMetrics)
+
+	return &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			m.recordConn(info.Reused)
+
+			if op != nil {
+				op.recordConn(info.Reused)
+			}
+		},
+	}
+}
+
+// instrumentSDKv1 adds handlers recording API calls to the specified AWS SDK for Go v1 handlers.
+// Sessions copied from the instrumented session share the handlers.
+func (m *apiCallMetrics) instrumentSDKv1(handlers *request.Handlers) {
+	handlers.Build.PushBackNamed(request.NamedHandler{
+		Name: "tf.APICallMetricsClientTrace",
+		Fn: func(r *request.Request) {
+			ctx := r.HTTPRequest.Context()
+			r.HTTPRequest = r.HTTPRequest.WithContext(httptrace.WithClientTrace(ctx, m.clientTrace(ctx)))
+		},
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "tf.APICallMetrics",
+		Fn: func(r *request.Request) {
+			m.recordCallContext(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name, r.RetryCount)
+		},
+	})
+}
+
+// sdkv2APIOption adds middleware recording API calls to an AWS SDK for Go v2 operation's stack.
+func (m *apiCallMetrics) sdkv2APIOption(stack *middleware.Stack) error {
+	err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc("APICallMetrics", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		retries := 0
+		if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+			retries = len(v.Results) - 1
+		}
+
+		m.recordCallContext(ctx, awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), retries)
+
+		return out, metadata, err
+	}), middleware.After)
+
+	if err != nil {
+		return err
+	}
+
+	// Added after the retry middleware so that each attempt is traced.
+	return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("APICallMetricsClientTrace", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		return next.HandleFinalize(httptrace.WithClientTrace(ctx, m.clientTrace(ctx)), in)
+	}), middleware.After)
+}
+
+// summary returns a summary of the recorded API calls, most frequently called operations first.
+func (m *apiCallMetrics) summary() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	keys := make([]apiOperation, 0, len(m.operations))
+	calls, retries := 0, 0
+
+	for k, v := range m.operations {
+		keys = append(keys, k)
+		calls += v.calls
+		retries += v.retries
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if x, y := m.operations[keys[i]].calls, m.operations[keys[j]].calls; x != y {
+			return x > y
+		}
+		if keys[i].service != keys[j].service {
+			return keys[i].service < keys[j].service
+		}
+		return keys[i].operation < keys[j].operation
+	})
+
+	lines := []string{
+		fmt.Sprintf("%d AWS API calls (%d retries) to %d operations using %d new and %d reused HTTP connections", calls, retries, len(keys), atomic.LoadInt64(&m.newConns), atomic.LoadInt64(&m.reusedConns)),
+	}
+
+	for _, k := range keys {
+		v := m.operations[k]
+		lines = append(lines, fmt.Sprintf("%s %s: %d calls (%d retries)", k.service, k.operation, v.calls, v.retries))
+	}
+
+	return lines
+}
+
+// WithAPICallMetrics returns a context in which the AWS API calls made by a single provider operation,
+// e.g. "aws_ecr_repository Read", are also counted separately, and a function to call when the operation completes.
+// That function logs a summary of the operation's API calls and the provider's running totals.
+// Nothing is counted or logged unless the provider's api_call_metrics argument is set.
+func (client *AWSClient) WithAPICallMetrics(ctx context.Context, operation string) (context.Context, func()) {
+	if client.apiCallMetrics == nil {
+		return ctx, func() {}
+	}
+
+	m := newAPICallMetrics()
+
+	return context.WithValue(ctx, apiCallMetricsContextKey{}, m), func() {
+		lines := m.summary()
+
+		// The first line is the totals, further lines are per API operation.
+		if len(lines) < 2 {
+			return
+		}
+
+		for _, v := range lines {
+			log.Printf("[DEBUG] API call metrics (%s): %s", operation, v)
+		}
+
+		log.Printf("[DEBUG] API call metrics (provider total): %s", client.apiCallMetrics.summary()[0])
+	}
+}
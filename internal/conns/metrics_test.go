@@ -0,0 +1,84 @@
+package conns
+
+import (
+	"context"
+	"net/http/httptrace"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAPICallMetricsSummary(t *testing.T) {
+	t.Parallel()
+
+	m := newAPICallMetrics()
+
+	m.recordCall("ECR", "ListTagsForResource", 0)
+	m.recordCall("ECS", "DescribeTaskSets", 0)
+	m.recordCall("ECR", "ListTagsForResource", 2)
+	m.recordCall("ECR", "DescribeRepositories", 0)
+	m.recordCall("ECR", "ListTagsForResource", 1)
+	m.recordCall("ECR", "DescribeRepositories", 0)
+
+	trace := m.clientTrace(context.Background())
+	trace.GotConn(httptrace.GotConnInfo{})
+	trace.GotConn(httptrace.GotConnInfo{Reused: true})
+	trace.GotConn(httptrace.GotConnInfo{Reused: true})
+
+	expected := []string{
+		"6 AWS API calls (3 retries) to 3 operations using 1 new and 2 reused HTTP connections",
+		"ECR ListTagsForResource: 3 calls (3 retries)",
+		"ECR DescribeRepositories: 2 calls (0 retries)",
+		"ECS DescribeTaskSets: 1 calls (0 retries)",
+	}
+
+	if diff := cmp.Diff(m.summary(), expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAWSClientWithAPICallMetrics(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{apiCallMetrics: newAPICallMetrics()}
+
+	client.apiCallMetrics.recordCallContext(context.Background(), "ECS", "DescribeTaskSets", 0)
+
+	ctx, logAPICallMetrics := client.WithAPICallMetrics(context.Background(), "aws_ecr_repository Read")
+	defer logAPICallMetrics()
+
+	client.apiCallMetrics.recordCallContext(ctx, "ECR", "DescribeRepositories", 1)
+	client.apiCallMetrics.clientTrace(ctx).GotConn(httptrace.GotConnInfo{})
+
+	m, ok := ctx.Value(apiCallMetricsContextKey{}).(*apiCallMetrics)
+
+	if !ok {
+		t.Fatal("expected operation metrics in context")
+	}
+
+	expected := []string{
+		"1 AWS API calls (1 retries) to 1 operations using 1 new and 0 reused HTTP connections",
+		"ECR DescribeRepositories: 1 calls (1 retries)",
+	}
+
+	if diff := cmp.Diff(m.summary(), expected); diff != "" {
+		t.Errorf("operation: unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if got, expected := client.apiCallMetrics.summary()[0], "2 AWS API calls (1 retries) to 2 operations using 1 new and 0 reused HTTP connections"; got != expected {
+		t.Errorf("provider: got %q, expected %q", got, expected)
+	}
+}
+
+func TestAWSClientWithAPICallMetricsDisabled(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	// Must not panic when api_call_metrics isn't set.
+	client := &AWSClient{}
+	ctx, logAPICallMetrics := client.WithAPICallMetrics(context.Background(), "aws_ecr_repository Read")
+	logAPICallMetrics()
+
+	if ctx.Value(apiCallMetricsContextKey{}) != nil {
+		t.Error("unexpected operation metrics in context")
+	}
+}
@@ -0,0 +1,203 @@
+package conns
+
+import (
+	"context"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+// ServiceRetryConfig overrides the provider-level retry settings for the API clients of a single service.
+// Zero values leave the corresponding provider-level setting in effect.
+type ServiceRetryConfig struct {
+	MaxBackoff time.Duration
+	MaxRetries int
+	// RetryMode only applies to AWS SDK for Go v2 clients.
+	RetryMode aws_sdkv2.RetryMode
+}
+
+// sdkv1Config returns the AWS SDK for Go v1 client configuration for the specified service.
+// Any retryer configured on the session keeps deciding which requests are retried.
+func (c *Config) sdkv1Config(pkg string, sess *session.Session) *aws.Config {
+	config := c.sdkv1EndpointOptions(pkg, &aws.Config{
+		Endpoint: aws.String(c.Endpoints[pkg]),
+	})
+
+	v, ok := c.ServiceRetries[pkg]
+
+	if !ok {
+		return config
+	}
+
+	maxRetries := c.MaxRetries
+	if v.MaxRetries > 0 {
+		maxRetries = v.MaxRetries
+	}
+
+	config.MaxRetries = aws.Int(maxRetries)
+
+	if retryer, ok := sess.Config.Retryer.(request.Retryer); ok {
+		config.Retryer = sdkv1Retryer{
+			Retryer:    retryer,
+			maxRetries: maxRetries,
+			maxBackoff: v.MaxBackoff,
+		}
+	} else if v.MaxBackoff > 0 {
+		config.Retryer = client.DefaultRetryer{
+			NumMaxRetries:    maxRetries,
+			MaxRetryDelay:    v.MaxBackoff,
+			MaxThrottleDelay: v.MaxBackoff,
+		}
+	}
+
+	return config
+}
+
+// sdkv1Retryer overrides the maximum number of retries and caps the retry delay of an AWS SDK for Go v1 retryer.
+type sdkv1Retryer struct {
+	request.Retryer
+	maxRetries int
+	maxBackoff time.Duration
+}
+
+func (r sdkv1Retryer) MaxRetries() int {
+	return r.maxRetries
+}
+
+func (r sdkv1Retryer) RetryRules(req *request.Request) time.Duration {
+	delay := r.Retryer.RetryRules(req)
+
+	if r.maxBackoff > 0 && delay > r.maxBackoff {
+		return r.maxBackoff
+	}
+
+	return delay
+}
+
+// sdkv2Retryer returns the AWS SDK for Go v2 retryer for the specified service.
+// The specified retryer is wrapped, not replaced, so that it keeps deciding which errors are retried.
+func (c *Config) sdkv2Retryer(pkg string, retryer aws_sdkv2.Retryer) aws_sdkv2.Retryer {
+	v, ok := c.ServiceRetries[pkg]
+
+	if !ok {
+		return retryer
+	}
+
+	if retryer == nil {
+		retryer = retry.NewStandard()
+	}
+
+	if v.RetryMode == aws_sdkv2.RetryModeAdaptive {
+		retryer = &adaptiveRetryer{
+			RetryerV2: asRetryerV2(retryer),
+			adaptive:  retry.NewAdaptiveMode(),
+		}
+	}
+
+	if v.MaxRetries > 0 {
+		retryer = retry.AddWithMaxAttempts(retryer, v.MaxRetries+1)
+	}
+
+	if v.MaxBackoff > 0 {
+		retryer = &maxBackoffDelayRetryer{
+			RetryerV2: asRetryerV2(retryer),
+			backoff:   retry.NewExponentialJitterBackoff(v.MaxBackoff),
+		}
+	}
+
+	return retryer
+}
+
+// adaptiveRetryer adds the client-side rate limiting of the adaptive retry mode to an AWS SDK for Go v2 retryer.
+type adaptiveRetryer struct {
+	aws_sdkv2.RetryerV2
+	adaptive *retry.AdaptiveMode
+}
+
+func (r *adaptiveRetryer) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	releaseRateLimit, err := r.adaptive.GetAttemptToken(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := r.RetryerV2.GetAttemptToken(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return func(opErr error) error {
+		if err := releaseRateLimit(opErr); err != nil {
+			return err
+		}
+
+		return release(opErr)
+	}, nil
+}
+
+// maxBackoffDelayRetryer caps the retry delay of an AWS SDK for Go v2 retryer.
+// Unlike retry.AddWithMaxBackoffDelay, the wrapped retryer can still stop retrying from RetryDelay,
+// e.g. after repeated networking errors.
+type maxBackoffDelayRetryer struct {
+	aws_sdkv2.RetryerV2
+	backoff *retry.ExponentialJitterBackoff
+}
+
+func (r *maxBackoffDelayRetryer) RetryDelay(attempt int, err error) (time.Duration, error) {
+	if _, err := r.RetryerV2.RetryDelay(attempt, err); err != nil {
+		return 0, err
+	}
+
+	return r.backoff.BackoffDelay(attempt, err)
+}
+
+// asRetryerV2 returns the specified AWS SDK for Go v2 retryer as a RetryerV2.
+func asRetryerV2(r aws_sdkv2.Retryer) aws_sdkv2.RetryerV2 {
+	if v, ok := r.(aws_sdkv2.RetryerV2); ok {
+		return v
+	}
+
+	return retryerV2{Retryer: r}
+}
+
+type retryerV2 struct {
+	aws_sdkv2.Retryer
+}
+
+func (r retryerV2) GetAttemptToken(context.Context) (func(error) error, error) {
+	return r.GetInitialToken(), nil
+}
+
+// withIsErrorRetryables returns a retryer constructor whose retryers consult the specified checks
+// of whether an error is retryable before those of the retryer returned by newRetryer.
+func withIsErrorRetryables(newRetryer func() aws_sdkv2.Retryer, retryables retry.IsErrorRetryables) func() aws_sdkv2.Retryer {
+	if newRetryer == nil {
+		newRetryer = func() aws_sdkv2.Retryer { return retry.NewStandard() }
+	}
+
+	return func() aws_sdkv2.Retryer {
+		return &isErrorRetryableRetryer{
+			RetryerV2:  asRetryerV2(newRetryer()),
+			retryables: retryables,
+		}
+	}
+}
+
+type isErrorRetryableRetryer struct {
+	aws_sdkv2.RetryerV2
+	retryables retry.IsErrorRetryables
+}
+
+func (r *isErrorRetryableRetryer) IsErrorRetryable(err error) bool {
+	if v := r.retryables.IsErrorRetryable(err); v != aws_sdkv2.UnknownTernary {
+		return v.Bool()
+	}
+
+	return r.RetryerV2.IsErrorRetryable(err)
+}
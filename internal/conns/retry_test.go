@@ -0,0 +1,245 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestConfigSDKV1Config(t *testing.T) {
+	t.Parallel()
+
+	c := &Config{
+		Endpoints:  map[string]string{names.ECS: "https://ecs.example.com"},
+		MaxRetries: 25,
+		ServiceRetries: map[string]*ServiceRetryConfig{
+			names.ECS: {MaxRetries: 50},
+			names.SSM: {MaxBackoff: 30 * time.Second},
+		},
+	}
+	sess := &session.Session{Config: aws.NewConfig()}
+
+	config := c.sdkv1Config(names.EC2, sess)
+
+	if got := aws.StringValue(config.Endpoint); got != "" {
+		t.Errorf("EC2 endpoint: got %q, expected empty", got)
+	}
+	if config.MaxRetries != nil {
+		t.Errorf("EC2 max retries: got %d, expected unset", aws.IntValue(config.MaxRetries))
+	}
+
+	config = c.sdkv1Config(names.ECS, sess)
+
+	if got, expected := aws.StringValue(config.Endpoint), "https://ecs.example.com"; got != expected {
+		t.Errorf("ECS endpoint: got %q, expected %q", got, expected)
+	}
+	if got, expected := aws.IntValue(config.MaxRetries), 50; got != expected {
+		t.Errorf("ECS max retries: got %d, expected %d", got, expected)
+	}
+	if config.Retryer != nil {
+		t.Errorf("ECS retryer: got %T, expected unset", config.Retryer)
+	}
+
+	config = c.sdkv1Config(names.SSM, sess)
+
+	retryer, ok := config.Retryer.(client.DefaultRetryer)
+	if !ok {
+		t.Fatalf("SSM retryer: got %T, expected client.DefaultRetryer", config.Retryer)
+	}
+	if got, expected := retryer.NumMaxRetries, 25; got != expected {
+		t.Errorf("SSM max retries: got %d, expected %d", got, expected)
+	}
+	if got, expected := retryer.MaxThrottleDelay, 30*time.Second; got != expected {
+		t.Errorf("SSM max throttle delay: got %s, expected %s", got, expected)
+	}
+}
+
+type testSDKV1Retryer struct {
+	client.DefaultRetryer
+	delay time.Duration
+}
+
+func (r testSDKV1Retryer) ShouldRetry(*request.Request) bool {
+	return true
+}
+
+func (r testSDKV1Retryer) RetryRules(*request.Request) time.Duration {
+	return r.delay
+}
+
+func TestConfigSDKV1ConfigSessionRetryer(t *testing.T) {
+	t.Parallel()
+
+	c := &Config{
+		MaxRetries: 25,
+		ServiceRetries: map[string]*ServiceRetryConfig{
+			names.ECS: {MaxRetries: 50},
+			names.SSM: {MaxBackoff: 30 * time.Second},
+		},
+	}
+	sess := &session.Session{Config: aws.NewConfig().WithMaxRetries(25)}
+	sess.Config.Retryer = testSDKV1Retryer{
+		DefaultRetryer: client.DefaultRetryer{NumMaxRetries: 25},
+		delay:          5 * time.Minute,
+	}
+	req := &request.Request{Error: errors.New("test")}
+
+	if got := c.sdkv1Config(names.EC2, sess).Retryer; got != nil {
+		t.Errorf("EC2 retryer: got %T, expected unset", got)
+	}
+
+	retryer, ok := c.sdkv1Config(names.ECS, sess).Retryer.(request.Retryer)
+	if !ok {
+		t.Fatal("ECS retryer: expected request.Retryer")
+	}
+	if got, expected := retryer.MaxRetries(), 50; got != expected {
+		t.Errorf("ECS max retries: got %d, expected %d", got, expected)
+	}
+	if !retryer.ShouldRetry(req) {
+		t.Error("ECS should retry: got false, expected the session retryer's true")
+	}
+	if got, expected := retryer.RetryRules(req), 5*time.Minute; got != expected {
+		t.Errorf("ECS retry delay: got %s, expected %s", got, expected)
+	}
+
+	retryer, ok = c.sdkv1Config(names.SSM, sess).Retryer.(request.Retryer)
+	if !ok {
+		t.Fatal("SSM retryer: expected request.Retryer")
+	}
+	if got, expected := retryer.MaxRetries(), 25; got != expected {
+		t.Errorf("SSM max retries: got %d, expected %d", got, expected)
+	}
+	if !retryer.ShouldRetry(req) {
+		t.Error("SSM should retry: got false, expected the session retryer's true")
+	}
+	if got, expected := retryer.RetryRules(req), 30*time.Second; got != expected {
+		t.Errorf("SSM retry delay: got %s, expected %s", got, expected)
+	}
+}
+
+type testSDKV2Retryer struct {
+	aws_sdkv2.RetryerV2
+	err error
+}
+
+func (r testSDKV2Retryer) RetryDelay(int, error) (time.Duration, error) {
+	return time.Hour, r.err
+}
+
+func TestConfigSDKV2Retryer(t *testing.T) {
+	t.Parallel()
+
+	errCustom := errors.New("custom")
+	errNoMoreRetries := errors.New("no more retries")
+
+	c := &Config{
+		ServiceRetries: map[string]*ServiceRetryConfig{
+			names.ECR: {MaxBackoff: time.Second},
+			names.ECS: {MaxRetries: 9},
+			names.SSM: {RetryMode: aws_sdkv2.RetryModeAdaptive},
+		},
+	}
+
+	newRetryer := func() aws_sdkv2.RetryerV2 {
+		return retry.NewStandard(func(o *retry.StandardOptions) {
+			o.MaxAttempts = 26
+			o.Retryables = append(o.Retryables, retry.IsErrorRetryableFunc(func(err error) aws_sdkv2.Ternary {
+				return aws_sdkv2.BoolTernary(errors.Is(err, errCustom))
+			}))
+		})
+	}
+
+	if got, expected := c.sdkv2Retryer(names.EC2, newRetryer()).MaxAttempts(), 26; got != expected {
+		t.Errorf("EC2 max attempts: got %d, expected %d", got, expected)
+	}
+
+	retryer := c.sdkv2Retryer(names.ECS, newRetryer())
+
+	if got, expected := retryer.MaxAttempts(), 10; got != expected {
+		t.Errorf("ECS max attempts: got %d, expected %d", got, expected)
+	}
+	if !retryer.IsErrorRetryable(errCustom) {
+		t.Error("ECS custom error retryable: got false, expected true")
+	}
+
+	retryer = c.sdkv2Retryer(names.SSM, newRetryer())
+
+	if _, ok := retryer.(*adaptiveRetryer); !ok {
+		t.Errorf("SSM retryer: got %T, expected *adaptiveRetryer", retryer)
+	}
+	if got, expected := retryer.MaxAttempts(), 26; got != expected {
+		t.Errorf("SSM max attempts: got %d, expected %d", got, expected)
+	}
+	if !retryer.IsErrorRetryable(errCustom) {
+		t.Error("SSM custom error retryable: got false, expected true")
+	}
+
+	release, err := retryer.(aws_sdkv2.RetryerV2).GetAttemptToken(context.Background())
+	if err != nil {
+		t.Fatalf("SSM attempt token: unexpected error: %s", err)
+	}
+	if err := release(nil); err != nil {
+		t.Errorf("SSM attempt token release: unexpected error: %s", err)
+	}
+
+	retryer = c.sdkv2Retryer(names.ECR, testSDKV2Retryer{RetryerV2: newRetryer()})
+
+	if !retryer.IsErrorRetryable(errCustom) {
+		t.Error("ECR custom error retryable: got false, expected true")
+	}
+	if delay, err := retryer.RetryDelay(10, errCustom); err != nil {
+		t.Errorf("ECR retry delay: unexpected error: %s", err)
+	} else if delay > time.Second {
+		t.Errorf("ECR retry delay: got %s, expected at most %s", delay, time.Second)
+	}
+
+	retryer = c.sdkv2Retryer(names.ECR, testSDKV2Retryer{RetryerV2: newRetryer(), err: errNoMoreRetries})
+
+	if _, err := retryer.RetryDelay(1, errCustom); !errors.Is(err, errNoMoreRetries) {
+		t.Errorf("ECR retry delay: got error %v, expected %v", err, errNoMoreRetries)
+	}
+}
+
+func TestWithIsErrorRetryables(t *testing.T) {
+	t.Parallel()
+
+	errCustom := errors.New("custom")
+	errNotRetryable := errors.New("not retryable")
+
+	newRetryer := withIsErrorRetryables(func() aws_sdkv2.Retryer {
+		return retry.NewStandard(func(o *retry.StandardOptions) {
+			o.Retryables = append(o.Retryables, retry.IsErrorRetryableFunc(func(err error) aws_sdkv2.Ternary {
+				return aws_sdkv2.BoolTernary(errors.Is(err, errCustom) || errors.Is(err, errNotRetryable))
+			}))
+		})
+	}, retry.IsErrorRetryables{
+		retry.IsErrorRetryableFunc(func(err error) aws_sdkv2.Ternary {
+			if errors.Is(err, errNotRetryable) {
+				return aws_sdkv2.FalseTernary
+			}
+
+			return aws_sdkv2.UnknownTernary
+		}),
+	})
+
+	retryer := newRetryer()
+
+	if !retryer.IsErrorRetryable(errCustom) {
+		t.Error("custom error retryable: got false, expected true")
+	}
+	if retryer.IsErrorRetryable(errNotRetryable) {
+		t.Error("not retryable error retryable: got true, expected false")
+	}
+	if got, expected := retryer.MaxAttempts(), retry.DefaultMaxAttempts; got != expected {
+		t.Errorf("max attempts: got %d, expected %d", got, expected)
+	}
+}
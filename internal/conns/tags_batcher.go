@@ -0,0 +1,173 @@
+package conns
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+const (
+	// Maximum number of ARNs in a Resource Groups Tagging API GetResources call.
+	tagBatchMaxResources = 100
+	// How long tag reads are collected before a batch is sent.
+	tagBatchWindow = 100 * time.Millisecond
+	// How long a batch's GetResources calls may take.
+	tagBatchTimeout = 2 * time.Minute
+)
+
+// tagBatcher combines concurrent tag reads into Resource Groups Tagging API GetResources calls.
+type tagBatcher struct {
+	conn   resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+	window time.Duration
+
+	mu    sync.Mutex
+	batch *tagBatch
+}
+
+// tagBatch is a set of resources whose tags are listed together.
+// done is closed once tags or err are set.
+type tagBatch struct {
+	arns []string
+	sent bool
+	done chan struct{}
+	tags map[string]map[string]string
+	err  error
+}
+
+func newTagBatcher(conn resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI) *tagBatcher {
+	return &tagBatcher{
+		conn:   conn,
+		window: tagBatchWindow,
+	}
+}
+
+// listTags adds the resource with the specified ARN to the current batch and returns its tags once the batch has been sent.
+// The boolean result is false if the resource wasn't returned by GetResources.
+func (b *tagBatcher) listTags(ctx context.Context, arn string) (map[string]string, bool, error) {
+	b.mu.Lock()
+	batch := b.batch
+	if batch == nil {
+		batch = &tagBatch{done: make(chan struct{})}
+		b.batch = batch
+		time.AfterFunc(b.window, func() { b.send(batch) })
+	}
+	if !batch.contains(arn) {
+		batch.arns = append(batch.arns, arn)
+		if len(batch.arns) == tagBatchMaxResources {
+			// Later reads start a new batch.
+			b.batch = nil
+			go b.send(batch)
+		}
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	case <-batch.done:
+	}
+
+	if batch.err != nil {
+		return nil, false, batch.err
+	}
+
+	tags, ok := batch.tags[arn]
+
+	return tags, ok, nil
+}
+
+// send lists the tags of the resources in the specified batch, unless the batch has already been sent.
+func (b *tagBatcher) send(batch *tagBatch) {
+	b.mu.Lock()
+	if batch.sent {
+		b.mu.Unlock()
+		return
+	}
+	batch.sent = true
+	if b.batch == batch {
+		b.batch = nil
+	}
+	b.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), tagBatchTimeout)
+	defer cancel()
+
+	batch.tags, batch.err = b.getResources(ctx, batch.arns)
+	close(batch.done)
+}
+
+func (b *tagBatcher) getResources(ctx context.Context, arns []string) (map[string]map[string]string, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceARNList: aws.StringSlice(arns),
+	}
+	tags := make(map[string]map[string]string)
+
+	for {
+		output, err := b.conn.GetResourcesWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range output.ResourceTagMappingList {
+			m := make(map[string]string, len(v.Tags))
+
+			for _, tag := range v.Tags {
+				m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+
+			tags[aws.StringValue(v.ResourceARN)] = m
+		}
+
+		if aws.StringValue(output.PaginationToken) == "" {
+			break
+		}
+
+		input.PaginationToken = output.PaginationToken
+	}
+
+	return tags, nil
+}
+
+func (batch *tagBatch) contains(arn string) bool {
+	for _, v := range batch.arns {
+		if v == arn {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BatchListTags returns the tags of the resource with the specified ARN.
+// If the provider's batch_tag_reads argument is set, concurrent calls are combined into Resource Groups Tagging API
+// GetResources calls of up to 100 resources. listTags, the resource's own tag listing function, is called instead if
+// batching is disabled, if the resource's tags may have just been written (the Resource Groups Tagging API is eventually
+// consistent), if the batched call fails or if the resource isn't returned by it (e.g. resources that have never been tagged).
+func (client *AWSClient) BatchListTags(ctx context.Context, arn string, written bool, listTags func() (tftags.KeyValueTags, error)) (tftags.KeyValueTags, error) {
+	if client.tagBatcher == nil || written {
+		return listTags()
+	}
+
+	tags, ok, err := client.tagBatcher.listTags(ctx, arn)
+
+	if err != nil {
+		log.Printf("[WARN] batch listing tags for %s, listing individually: %s", arn, err)
+
+		return listTags()
+	}
+
+	if !ok {
+		log.Printf("[DEBUG] %s not returned by batch tag listing, listing individually", arn)
+
+		return listTags()
+	}
+
+	return tftags.New(ctx, tags), nil
+}
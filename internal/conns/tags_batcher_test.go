@@ -0,0 +1,208 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi/resourcegroupstaggingapiiface"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+type mockResourceGroupsTaggingAPI struct {
+	resourcegroupstaggingapiiface.ResourceGroupsTaggingAPIAPI
+
+	calls   int32
+	maxARNs int32
+	err     error
+	tags    map[string]map[string]string
+}
+
+func (m *mockResourceGroupsTaggingAPI) GetResourcesWithContext(_ context.Context, input *resourcegroupstaggingapi.GetResourcesInput, _ ...request.Option) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	atomic.AddInt32(&m.calls, 1)
+
+	for n := int32(len(input.ResourceARNList)); ; {
+		if v := atomic.LoadInt32(&m.maxARNs); n <= v || atomic.CompareAndSwapInt32(&m.maxARNs, v, n) {
+			break
+		}
+	}
+
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	output := &resourcegroupstaggingapi.GetResourcesOutput{}
+
+	for _, arn := range aws.StringValueSlice(input.ResourceARNList) {
+		v, ok := m.tags[arn]
+
+		if !ok {
+			continue
+		}
+
+		mapping := &resourcegroupstaggingapi.ResourceTagMapping{ResourceARN: aws.String(arn)}
+
+		for k, v := range v {
+			mapping.Tags = append(mapping.Tags, &resourcegroupstaggingapi.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+
+		output.ResourceTagMappingList = append(output.ResourceTagMappingList, mapping)
+	}
+
+	return output, nil
+}
+
+func TestTagBatcherListTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := &mockResourceGroupsTaggingAPI{tags: make(map[string]map[string]string)}
+
+	for i := 0; i < 10; i++ {
+		conn.tags[fmt.Sprintf("arn:aws:ecr:us-west-2:123456789012:repository/tagged%d", i)] = map[string]string{"Name": fmt.Sprintf("tagged%d", i)} //lintignore:AWSAT003,AWSAT005
+	}
+
+	b := newTagBatcher(conn)
+	b.window = 50 * time.Millisecond
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		for _, name := range []string{"tagged", "untagged"} {
+			arn := fmt.Sprintf("arn:aws:ecr:us-west-2:123456789012:repository/%s%d", name, i) //lintignore:AWSAT003,AWSAT005
+			expected, expectedOK := conn.tags[arn]
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				got, ok, err := b.listTags(ctx, arn)
+
+				if err != nil {
+					t.Errorf("%s: unexpected error: %s", arn, err)
+				}
+				if ok != expectedOK {
+					t.Errorf("%s: got found %t, expected %t", arn, ok, expectedOK)
+				}
+				if len(got) != len(expected) || got["Name"] != expected["Name"] {
+					t.Errorf("%s: got %v, expected %v", arn, got, expected)
+				}
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	if got, expected := atomic.LoadInt32(&conn.calls), int32(1); got != expected {
+		t.Errorf("GetResources calls: got %d, expected %d", got, expected)
+	}
+}
+
+func TestTagBatcherListTagsMaxResources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conn := &mockResourceGroupsTaggingAPI{}
+	b := newTagBatcher(conn)
+	b.window = 50 * time.Millisecond
+
+	var wg sync.WaitGroup
+
+	// A full batch is sent without waiting for the window to elapse and the remaining resource starts a new batch.
+	for i := 0; i < tagBatchMaxResources+1; i++ {
+		arn := fmt.Sprintf("arn:aws:ecr:us-west-2:123456789012:repository/r%d", i) //lintignore:AWSAT003,AWSAT005
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, _, err := b.listTags(ctx, arn); err != nil {
+				t.Errorf("%s: unexpected error: %s", arn, err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got, expected := atomic.LoadInt32(&conn.calls), int32(2); got != expected {
+		t.Errorf("GetResources calls: got %d, expected %d", got, expected)
+	}
+	if got, expected := atomic.LoadInt32(&conn.maxARNs), int32(tagBatchMaxResources); got != expected {
+		t.Errorf("GetResources ARNs: got %d, expected %d", got, expected)
+	}
+}
+
+func TestAWSClientBatchListTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	arn := "arn:aws:ecr:us-west-2:123456789012:repository/test" //lintignore:AWSAT003,AWSAT005
+	individual := tftags.New(ctx, map[string]string{"Source": "individual"})
+	listTags := func() (tftags.KeyValueTags, error) {
+		return individual, nil
+	}
+
+	testCases := []struct {
+		name     string
+		conn     *mockResourceGroupsTaggingAPI
+		written  bool
+		expected string
+	}{
+		{
+			name:     "disabled",
+			expected: "individual",
+		},
+		{
+			name:     "batched",
+			conn:     &mockResourceGroupsTaggingAPI{tags: map[string]map[string]string{arn: {"Source": "batched"}}},
+			expected: "batched",
+		},
+		{
+			name:     "written",
+			conn:     &mockResourceGroupsTaggingAPI{tags: map[string]map[string]string{arn: {"Source": "batched"}}},
+			written:  true,
+			expected: "individual",
+		},
+		{
+			name:     "not returned",
+			conn:     &mockResourceGroupsTaggingAPI{tags: map[string]map[string]string{}},
+			expected: "individual",
+		},
+		{
+			name:     "batch error",
+			conn:     &mockResourceGroupsTaggingAPI{err: errors.New("AccessDeniedException")},
+			expected: "individual",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{}
+			if testCase.conn != nil {
+				client.tagBatcher = newTagBatcher(testCase.conn)
+				client.tagBatcher.window = time.Millisecond
+			}
+
+			tags, err := client.BatchListTags(ctx, arn, testCase.written, listTags)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := aws.StringValue(tags.KeyValue("Source")); got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
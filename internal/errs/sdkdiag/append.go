@@ -14,8 +14,14 @@ func AppendWarningf(diags diag.Diagnostics, format string, a ...any) diag.Diagno
 	})
 }
 
+// AppendErrorf appends an error diagnostic with the formatted summary.
+// If an argument is an AWS API error, its error code, HTTP status code and request ID are added as detail.
 func AppendErrorf(diags diag.Diagnostics, format string, a ...any) diag.Diagnostics {
-	return append(diags, diag.Errorf(format, a...)...)
+	return append(diags, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf(format, a...),
+		Detail:   AWSErrorDetail(lastError(a)),
+	})
 }
 
 func AppendFromErr(diags diag.Diagnostics, err error) diag.Diagnostics {
@@ -0,0 +1,61 @@
+package sdkdiag
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// AWSErrorDetail returns the AWS error code, HTTP status code and request ID of err, one per line,
+// or an empty string if err isn't an AWS API error.
+// Both AWS SDK for Go v1 and v2 errors are supported.
+func AWSErrorDetail(err error) string {
+	var code, requestID string
+	var statusCode int
+
+	var v1Err awserr.RequestFailure
+	var apiErr interface{ ErrorCode() string }
+	var requestIDErr interface{ ServiceRequestID() string }
+	var statusCodeErr interface{ HTTPStatusCode() int }
+
+	if errors.As(err, &v1Err) {
+		code, requestID, statusCode = v1Err.Code(), v1Err.RequestID(), v1Err.StatusCode()
+	} else {
+		if errors.As(err, &apiErr) {
+			code = apiErr.ErrorCode()
+		}
+		if errors.As(err, &requestIDErr) {
+			requestID = requestIDErr.ServiceRequestID()
+		}
+		if errors.As(err, &statusCodeErr) {
+			statusCode = statusCodeErr.HTTPStatusCode()
+		}
+	}
+
+	var lines []string
+
+	if code != "" {
+		lines = append(lines, fmt.Sprintf("Error code: %s", code))
+	}
+	if statusCode != 0 {
+		lines = append(lines, fmt.Sprintf("HTTP status code: %d", statusCode))
+	}
+	if requestID != "" {
+		lines = append(lines, fmt.Sprintf("Request ID: %s", requestID))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// lastError returns the last argument that is an error.
+func lastError(a []any) error {
+	for i := len(a) - 1; i >= 0; i-- {
+		if err, ok := a[i].(error); ok {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package sdkdiag
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestAWSErrorDetail(t *testing.T) {
+	t.Parallel()
+
+	v1Err := awserr.NewRequestFailure(awserr.New("ThrottlingException", "Rate exceeded", nil), http.StatusBadRequest, "v1-request-id")
+	v2Err := &smithy.OperationError{
+		ServiceID:     "ECS",
+		OperationName: "CreateTaskSet",
+		Err: &awshttp.ResponseError{
+			ResponseError: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusConflict}},
+				Err:      &smithy.GenericAPIError{Code: "ConflictException", Message: "conflict"},
+			},
+			RequestID: "v2-request-id",
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "not an AWS error",
+			err:  errors.New("test"),
+		},
+		{
+			name:     "SDK v1",
+			err:      v1Err,
+			expected: "Error code: ThrottlingException\nHTTP status code: 400\nRequest ID: v1-request-id",
+		},
+		{
+			name:     "SDK v1 wrapped",
+			err:      fmt.Errorf("wrapped: %w", v1Err),
+			expected: "Error code: ThrottlingException\nHTTP status code: 400\nRequest ID: v1-request-id",
+		},
+		{
+			name:     "SDK v2",
+			err:      v2Err,
+			expected: "Error code: ConflictException\nHTTP status code: 409\nRequest ID: v2-request-id",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := AWSErrorDetail(testCase.err); got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAppendErrorfDetail(t *testing.T) {
+	t.Parallel()
+
+	err := awserr.NewRequestFailure(awserr.New("AccessDeniedException", "denied", nil), http.StatusForbidden, "request-id")
+
+	diags := AppendErrorf(nil, "creating thing (%s): %s", "name", err)
+
+	if len(diags) != 1 || diags[0].Severity != diag.Error {
+		t.Fatalf("expected one error diagnostic, got %#v", diags)
+	}
+	if expected := "creating thing (name): " + err.Error(); diags[0].Summary != expected {
+		t.Errorf("summary: got %q, expected %q", diags[0].Summary, expected)
+	}
+	if expected := "Error code: AccessDeniedException\nHTTP status code: 403\nRequest ID: request-id"; diags[0].Detail != expected {
+		t.Errorf("detail: got %q, expected %q", diags[0].Detail, expected)
+	}
+}
@@ -0,0 +1,363 @@
+package flex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AutoFlex expands and flattens AWS API structures by reflection.
+// An exported AWS API structure field named e.g. `TargetGroupArn` is matched to the Terraform attribute `target_group_arn`.
+// AWS SDK for Go v1 and v2 structures are supported.
+
+// AutoFlexOptions controls the behavior of Expand and Flatten.
+type AutoFlexOptions struct {
+	// OmitEmpty causes zero-valued primitives and empty collections to be skipped.
+	OmitEmpty bool
+}
+
+type AutoFlexOptionsFunc func(*AutoFlexOptions)
+
+// WithOmitEmpty causes zero-valued primitives and empty collections to be skipped.
+func WithOmitEmpty() AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.OmitEmpty = true
+	}
+}
+
+func newAutoFlexOptions(optFns []AutoFlexOptionsFunc) *AutoFlexOptions {
+	opts := &AutoFlexOptions{}
+
+	for _, optFn := range optFns {
+		optFn(opts)
+	}
+
+	return opts
+}
+
+// Expand populates the AWS API structure pointed to by apiObject from the Terraform Plugin SDK value tfObject.
+// tfObject is typically the value of a configuration block, i.e. []interface{}, *schema.Set or map[string]interface{}.
+func Expand(tfObject, apiObject any, optFns ...AutoFlexOptionsFunc) error {
+	to := reflect.ValueOf(apiObject)
+
+	if to.Kind() != reflect.Pointer || to.IsNil() {
+		return fmt.Errorf("AutoFlex: target must be a non-nil pointer, got %T", apiObject)
+	}
+
+	return expandSDK(tfObject, to.Elem(), newAutoFlexOptions(optFns))
+}
+
+func expandSDK(from any, to reflect.Value, opts *AutoFlexOptions) error {
+	if from == nil {
+		return nil
+	}
+
+	if v, ok := from.(*schema.Set); ok {
+		from = v.List()
+	}
+
+	if opts.OmitEmpty && isEmpty(reflect.ValueOf(from)) {
+		return nil
+	}
+
+	switch to.Kind() {
+	case reflect.Pointer:
+		// A configuration block with no elements doesn't allocate a structure.
+		if v, ok := from.([]interface{}); ok && (len(v) == 0 || v[0] == nil) && to.Type().Elem().Kind() == reflect.Struct {
+			return nil
+		}
+
+		v := reflect.New(to.Type().Elem())
+
+		if err := expandSDK(from, v.Elem(), opts); err != nil {
+			return err
+		}
+
+		to.Set(v)
+
+		return nil
+
+	case reflect.Struct:
+		tfMap, ok := from.(map[string]interface{})
+
+		if !ok {
+			// A configuration block with MaxItems: 1.
+			if v, isList := from.([]interface{}); isList {
+				if len(v) == 0 || v[0] == nil {
+					return nil
+				}
+
+				tfMap, ok = v[0].(map[string]interface{})
+			}
+
+			if !ok {
+				return fmt.Errorf("AutoFlex: cannot expand %T into %s", from, to.Type())
+			}
+		}
+
+		for i, typ := 0, to.Type(); i < typ.NumField(); i++ {
+			field := typ.Field(i)
+
+			if !field.IsExported() {
+				continue
+			}
+
+			name := fieldNameToAttributeName(field.Name)
+			v, ok := tfMap[name]
+
+			if !ok {
+				continue
+			}
+
+			if err := expandSDK(v, to.Field(i), opts); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+
+		return nil
+
+	case reflect.Slice:
+		tfList, ok := from.([]interface{})
+
+		if !ok {
+			return fmt.Errorf("AutoFlex: cannot expand %T into %s", from, to.Type())
+		}
+
+		v := reflect.MakeSlice(to.Type(), len(tfList), len(tfList))
+
+		for i, tfElem := range tfList {
+			if err := expandSDK(tfElem, v.Index(i), opts); err != nil {
+				return err
+			}
+		}
+
+		to.Set(v)
+
+		return nil
+
+	case reflect.String:
+		if v, ok := from.(string); ok {
+			to.SetString(v)
+
+			return nil
+		}
+
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		if v, ok := from.(int); ok {
+			to.SetInt(int64(v))
+
+			return nil
+		}
+
+	case reflect.Bool:
+		if v, ok := from.(bool); ok {
+			to.SetBool(v)
+
+			return nil
+		}
+
+	case reflect.Float32, reflect.Float64:
+		if v, ok := from.(float64); ok {
+			to.SetFloat(v)
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("AutoFlex: cannot expand %T into %s", from, to.Type())
+}
+
+// Flatten returns the Terraform Plugin SDK value for the AWS API structure, or slice of structures or primitives, apiObject.
+// A structure is flattened to a configuration block, i.e. a single element list. A nil structure is flattened to an empty list.
+func Flatten(apiObject any, optFns ...AutoFlexOptionsFunc) ([]interface{}, error) {
+	from := reflect.ValueOf(apiObject)
+
+	for from.Kind() == reflect.Pointer {
+		if from.IsNil() {
+			return []interface{}{}, nil
+		}
+
+		from = from.Elem()
+	}
+
+	opts := newAutoFlexOptions(optFns)
+
+	switch from.Kind() {
+	case reflect.Struct:
+		tfMap, err := flattenSDKStruct(from, opts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []interface{}{tfMap}, nil
+
+	case reflect.Slice:
+		v, _, err := flattenSDK(from, opts)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if v == nil {
+			return []interface{}{}, nil
+		}
+
+		return v.([]interface{}), nil
+	}
+
+	return nil, fmt.Errorf("AutoFlex: cannot flatten %T", apiObject)
+}
+
+func flattenSDKStruct(from reflect.Value, opts *AutoFlexOptions) (map[string]interface{}, error) {
+	tfMap := map[string]interface{}{}
+
+	for i, typ := 0, from.Type(); i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name := fieldNameToAttributeName(field.Name)
+		v, ok, err := flattenSDK(from.Field(i), opts)
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		if ok {
+			tfMap[name] = v
+		}
+	}
+
+	return tfMap, nil
+}
+
+// flattenSDK returns the Terraform Plugin SDK value for from and whether a value should be set.
+func flattenSDK(from reflect.Value, opts *AutoFlexOptions) (any, bool, error) {
+	if from.Kind() == reflect.Pointer {
+		if from.IsNil() {
+			return nil, false, nil
+		}
+
+		if from.Elem().Kind() == reflect.Struct {
+			tfMap, err := flattenSDKStruct(from.Elem(), opts)
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			return []interface{}{tfMap}, true, nil
+		}
+
+		from = from.Elem()
+	}
+
+	if opts.OmitEmpty && isEmpty(from) {
+		return nil, false, nil
+	}
+
+	switch from.Kind() {
+	case reflect.Struct:
+		tfMap, err := flattenSDKStruct(from, opts)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		return []interface{}{tfMap}, true, nil
+
+	case reflect.Slice:
+		if from.IsNil() {
+			return nil, false, nil
+		}
+
+		tfList := make([]interface{}, 0, from.Len())
+
+		for i := 0; i < from.Len(); i++ {
+			elem := from.Index(i)
+
+			if elem.Kind() == reflect.Pointer {
+				if elem.IsNil() {
+					continue
+				}
+
+				elem = elem.Elem()
+			}
+
+			if elem.Kind() == reflect.Struct {
+				tfMap, err := flattenSDKStruct(elem, opts)
+
+				if err != nil {
+					return nil, false, err
+				}
+
+				tfList = append(tfList, tfMap)
+
+				continue
+			}
+
+			v, _, err := flattenSDK(elem, &AutoFlexOptions{})
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			tfList = append(tfList, v)
+		}
+
+		return tfList, true, nil
+
+	case reflect.String:
+		return from.String(), true, nil
+
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return int(from.Int()), true, nil
+
+	case reflect.Bool:
+		return from.Bool(), true, nil
+
+	case reflect.Float32, reflect.Float64:
+		return from.Float(), true, nil
+	}
+
+	return nil, false, fmt.Errorf("AutoFlex: cannot flatten %s", from.Type())
+}
+
+// fieldNameToAttributeName converts an AWS API structure field name to a Terraform attribute name,
+// e.g. `EcrImagePullerRole` to `ecr_image_puller_role` and `VPCId` to `vpc_id`.
+func fieldNameToAttributeName(name string) string {
+	var sb strings.Builder
+	runes := []rune(name)
+
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				prev := runes[i-1]
+
+				if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+					sb.WriteRune('_')
+				}
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice:
+		return v.Len() == 0
+	}
+
+	return v.IsZero()
+}
@@ -0,0 +1,481 @@
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Terraform Plugin Framework variants of AutoFlex.
+
+// ExpandFramework populates the AWS API structure pointed to by apiObject from the Terraform Plugin Framework value tfObject.
+// tfObject is either an attr.Value or a resource model structure with `tfsdk` struct tags.
+func ExpandFramework(ctx context.Context, tfObject, apiObject any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	to := reflect.ValueOf(apiObject)
+
+	if to.Kind() != reflect.Pointer || to.IsNil() {
+		diags.AddError("AutoFlex", fmt.Sprintf("target must be a non-nil pointer, got %T", apiObject))
+
+		return diags
+	}
+
+	if v, ok := tfObject.(attr.Value); ok {
+		return expandFramework(ctx, v, to.Elem())
+	}
+
+	attributes, err := modelAttributes(tfObject)
+
+	if err != nil {
+		diags.AddError("AutoFlex", err.Error())
+
+		return diags
+	}
+
+	return expandFrameworkAttributes(ctx, attributes, to.Elem())
+}
+
+func expandFramework(ctx context.Context, from attr.Value, to reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if from.IsNull() || from.IsUnknown() {
+		return diags
+	}
+
+	if to.Kind() == reflect.Pointer {
+		// A nested block with no elements doesn't allocate a structure.
+		if elements, ok := frameworkElements(ctx, from); ok && len(elements) == 0 && to.Type().Elem().Kind() == reflect.Struct {
+			return diags
+		}
+
+		v := reflect.New(to.Type().Elem())
+
+		diags.Append(expandFramework(ctx, from, v.Elem())...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		to.Set(v)
+
+		return diags
+	}
+
+	switch v := from.(type) {
+	case basetypes.StringValuable:
+		s, d := v.ToStringValue(ctx)
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if to.Kind() == reflect.String {
+			to.SetString(s.ValueString())
+
+			return diags
+		}
+
+	case basetypes.Int64Valuable:
+		i, d := v.ToInt64Value(ctx)
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		switch to.Kind() {
+		case reflect.Int, reflect.Int32, reflect.Int64:
+			to.SetInt(i.ValueInt64())
+
+			return diags
+		}
+
+	case basetypes.BoolValuable:
+		b, d := v.ToBoolValue(ctx)
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if to.Kind() == reflect.Bool {
+			to.SetBool(b.ValueBool())
+
+			return diags
+		}
+
+	case basetypes.Float64Valuable:
+		f, d := v.ToFloat64Value(ctx)
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		switch to.Kind() {
+		case reflect.Float32, reflect.Float64:
+			to.SetFloat(f.ValueFloat64())
+
+			return diags
+		}
+
+	case basetypes.ObjectValuable:
+		o, d := v.ToObjectValue(ctx)
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return diags
+		}
+
+		if to.Kind() == reflect.Struct {
+			return expandFrameworkAttributes(ctx, o.Attributes(), to)
+		}
+
+	default:
+		elements, ok := frameworkElements(ctx, from)
+
+		if !ok {
+			break
+		}
+
+		switch to.Kind() {
+		case reflect.Slice:
+			s := reflect.MakeSlice(to.Type(), len(elements), len(elements))
+
+			for i, element := range elements {
+				diags.Append(expandFramework(ctx, element, s.Index(i))...)
+
+				if diags.HasError() {
+					return diags
+				}
+			}
+
+			to.Set(s)
+
+			return diags
+
+		case reflect.Struct:
+			// A nested block with MaxItems: 1.
+			if len(elements) == 0 {
+				return diags
+			}
+
+			return expandFramework(ctx, elements[0], to)
+		}
+	}
+
+	diags.AddError("AutoFlex", fmt.Sprintf("cannot expand %T into %s", from, to.Type()))
+
+	return diags
+}
+
+func expandFrameworkAttributes(ctx context.Context, attributes map[string]attr.Value, to reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if to.Kind() != reflect.Struct {
+		diags.AddError("AutoFlex", fmt.Sprintf("cannot expand object into %s", to.Type()))
+
+		return diags
+	}
+
+	for i, typ := 0, to.Type(); i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		v, ok := attributes[fieldNameToAttributeName(field.Name)]
+
+		if !ok {
+			continue
+		}
+
+		diags.Append(expandFramework(ctx, v, to.Field(i))...)
+
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// frameworkElements returns the elements of a list or set value.
+func frameworkElements(ctx context.Context, v attr.Value) ([]attr.Value, bool) {
+	switch v := v.(type) {
+	case basetypes.ListValuable:
+		l, diags := v.ToListValue(ctx)
+
+		if diags.HasError() {
+			return nil, false
+		}
+
+		return l.Elements(), true
+
+	case basetypes.SetValuable:
+		s, diags := v.ToSetValue(ctx)
+
+		if diags.HasError() {
+			return nil, false
+		}
+
+		return s.Elements(), true
+	}
+
+	return nil, false
+}
+
+// modelAttributes returns the attribute values of a resource model structure, keyed by `tfsdk` struct tag.
+func modelAttributes(model any) (map[string]attr.Value, error) {
+	v := reflect.Indirect(reflect.ValueOf(model))
+
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot expand %T", model)
+	}
+
+	attributes := make(map[string]attr.Value)
+
+	for i, typ := 0, v.Type(); i < typ.NumField(); i++ {
+		name := typ.Field(i).Tag.Get("tfsdk")
+
+		if name == "" || name == "-" {
+			continue
+		}
+
+		if value, ok := v.Field(i).Interface().(attr.Value); ok {
+			attributes[name] = value
+		}
+	}
+
+	return attributes, nil
+}
+
+// FlattenFramework sets the Terraform Plugin Framework value pointed to by tfObject, of type attrType, from the AWS API structure apiObject.
+// A nil pointer, or an empty slice, is flattened to a null value.
+func FlattenFramework(ctx context.Context, apiObject any, attrType attr.Type, tfObject any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	to := reflect.ValueOf(tfObject)
+
+	if to.Kind() != reflect.Pointer || to.IsNil() {
+		diags.AddError("AutoFlex", fmt.Sprintf("target must be a non-nil pointer, got %T", tfObject))
+
+		return diags
+	}
+
+	v, d := flattenFramework(ctx, reflect.ValueOf(apiObject), attrType)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	value := reflect.ValueOf(v)
+
+	if !value.Type().AssignableTo(to.Elem().Type()) {
+		diags.AddError("AutoFlex", fmt.Sprintf("cannot assign %T to %s", v, to.Elem().Type()))
+
+		return diags
+	}
+
+	to.Elem().Set(value)
+
+	return diags
+}
+
+func flattenFramework(ctx context.Context, from reflect.Value, attrType attr.Type) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	isPointer := from.Kind() == reflect.Pointer
+
+	if isPointer {
+		if from.IsNil() {
+			return nullValue(ctx, attrType)
+		}
+
+		from = from.Elem()
+	}
+
+	if !from.IsValid() {
+		return nullValue(ctx, attrType)
+	}
+
+	switch t := attrType.(type) {
+	case basetypes.ListType:
+		elements, d := flattenFrameworkElements(ctx, from, t.ElemType)
+		diags.Append(d...)
+
+		if diags.HasError() || elements == nil {
+			return types.ListNull(t.ElemType), diags
+		}
+
+		v, d := types.ListValue(t.ElemType, elements)
+		diags.Append(d...)
+
+		return v, diags
+
+	case basetypes.SetType:
+		elements, d := flattenFrameworkElements(ctx, from, t.ElemType)
+		diags.Append(d...)
+
+		if diags.HasError() || elements == nil {
+			return types.SetNull(t.ElemType), diags
+		}
+
+		v, d := types.SetValue(t.ElemType, elements)
+		diags.Append(d...)
+
+		return v, diags
+
+	case basetypes.ObjectType:
+		if from.Kind() != reflect.Struct {
+			break
+		}
+
+		fields := make(map[string]reflect.Value)
+
+		for i, typ := 0, from.Type(); i < typ.NumField(); i++ {
+			if field := typ.Field(i); field.IsExported() {
+				fields[fieldNameToAttributeName(field.Name)] = from.Field(i)
+			}
+		}
+
+		attributes := make(map[string]attr.Value, len(t.AttrTypes))
+
+		for name, attrType := range t.AttrTypes {
+			var v attr.Value
+			var d diag.Diagnostics
+
+			if field, ok := fields[name]; ok {
+				v, d = flattenFramework(ctx, field, attrType)
+			} else {
+				v, d = nullValue(ctx, attrType)
+			}
+
+			diags.Append(d...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			attributes[name] = v
+		}
+
+		v, d := types.ObjectValue(t.AttrTypes, attributes)
+		diags.Append(d...)
+
+		return v, diags
+
+	case basetypes.StringTypable:
+		if from.Kind() != reflect.String {
+			break
+		}
+
+		s := types.StringValue(from.String())
+
+		// Mirror StringValueToFramework.
+		if !isPointer && from.String() == "" {
+			s = types.StringNull()
+		}
+
+		v, d := t.ValueFromString(ctx, s)
+		diags.Append(d...)
+
+		return v, diags
+
+	case basetypes.Int64Typable:
+		switch from.Kind() {
+		case reflect.Int, reflect.Int32, reflect.Int64:
+			v, d := t.ValueFromInt64(ctx, types.Int64Value(from.Int()))
+			diags.Append(d...)
+
+			return v, diags
+		}
+
+	case basetypes.BoolTypable:
+		if from.Kind() != reflect.Bool {
+			break
+		}
+
+		v, d := t.ValueFromBool(ctx, types.BoolValue(from.Bool()))
+		diags.Append(d...)
+
+		return v, diags
+
+	case basetypes.Float64Typable:
+		switch from.Kind() {
+		case reflect.Float32, reflect.Float64:
+			v, d := t.ValueFromFloat64(ctx, types.Float64Value(from.Float()))
+			diags.Append(d...)
+
+			return v, diags
+		}
+	}
+
+	diags.AddError("AutoFlex", fmt.Sprintf("cannot flatten %s into %s", from.Type(), attrType))
+
+	return nil, diags
+}
+
+// flattenFrameworkElements returns the list or set elements for a slice or a single (nested block) structure.
+// A nil result indicates a null value.
+func flattenFrameworkElements(ctx context.Context, from reflect.Value, elemType attr.Type) ([]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch from.Kind() {
+	case reflect.Struct:
+		v, d := flattenFramework(ctx, from, elemType)
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return []attr.Value{v}, diags
+
+	case reflect.Slice:
+		if from.Len() == 0 {
+			return nil, diags
+		}
+
+		elements := make([]attr.Value, 0, from.Len())
+
+		for i := 0; i < from.Len(); i++ {
+			v, d := flattenFramework(ctx, from.Index(i), elemType)
+			diags.Append(d...)
+
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			elements = append(elements, v)
+		}
+
+		return elements, diags
+	}
+
+	diags.AddError("AutoFlex", fmt.Sprintf("cannot flatten %s into elements of %s", from.Type(), elemType))
+
+	return nil, diags
+}
+
+func nullValue(ctx context.Context, attrType attr.Type) (attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+
+	if err != nil {
+		diags.AddError("AutoFlex", err.Error())
+	}
+
+	return v, diags
+}
@@ -0,0 +1,210 @@
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type autoFlexTestLoadBalancer struct {
+	ContainerName    *string
+	ContainerPort    *int32
+	LoadBalancerName *string
+	TargetGroupArn   *string
+
+	noSmithyDocumentSerde struct{}
+}
+
+var autoFlexTestLoadBalancerAttrTypes = map[string]attr.Type{
+	"container_name":     types.StringType,
+	"container_port":     types.Int64Type,
+	"load_balancer_name": types.StringType,
+	"target_group_arn":   types.StringType,
+}
+
+var autoFlexTestLoadBalancerSetType = types.SetType{ElemType: types.ObjectType{AttrTypes: autoFlexTestLoadBalancerAttrTypes}}
+
+func TestExpandFramework(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	elementType := autoFlexTestLoadBalancerSetType.ElemType.(types.ObjectType)
+
+	testCases := map[string]struct {
+		input    types.Set
+		expected []autoFlexTestLoadBalancer
+	}{
+		"null": {
+			input:    types.SetNull(elementType),
+			expected: nil,
+		},
+		"unknown": {
+			input:    types.SetUnknown(elementType),
+			expected: nil,
+		},
+		"one element": {
+			input: types.SetValueMust(elementType, []attr.Value{
+				types.ObjectValueMust(autoFlexTestLoadBalancerAttrTypes, map[string]attr.Value{
+					"container_name":     types.StringValue("app"),
+					"container_port":     types.Int64Value(8080),
+					"load_balancer_name": types.StringNull(),
+					"target_group_arn":   types.StringValue("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/test/1234567890123456"),
+				}),
+			}),
+			expected: []autoFlexTestLoadBalancer{{
+				ContainerName:  aws.String("app"),
+				ContainerPort:  aws.Int32(8080),
+				TargetGroupArn: aws.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/test/1234567890123456"),
+			}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []autoFlexTestLoadBalancer
+
+			if diags := ExpandFramework(ctx, testCase.input, &got); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(autoFlexTestLoadBalancer{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandFrameworkModel(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		ContainerName types.String `tfsdk:"container_name"`
+		ContainerPort types.Int64  `tfsdk:"container_port"`
+		Ignored       string
+	}
+
+	var got autoFlexTestLoadBalancer
+
+	input := model{
+		ContainerName: types.StringValue("app"),
+		ContainerPort: types.Int64Value(80),
+	}
+
+	if diags := ExpandFramework(context.Background(), input, &got); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := autoFlexTestLoadBalancer{
+		ContainerName: aws.String("app"),
+		ContainerPort: aws.Int32(80),
+	}
+
+	if diff := cmp.Diff(got, expected, cmp.AllowUnexported(autoFlexTestLoadBalancer{})); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFlattenFramework(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	elementType := autoFlexTestLoadBalancerSetType.ElemType.(types.ObjectType)
+
+	testCases := map[string]struct {
+		input    []autoFlexTestLoadBalancer
+		expected types.Set
+	}{
+		"nil": {
+			input:    nil,
+			expected: types.SetNull(elementType),
+		},
+		"one element": {
+			input: []autoFlexTestLoadBalancer{{
+				ContainerName: aws.String("app"),
+				ContainerPort: aws.Int32(8080),
+			}},
+			expected: types.SetValueMust(elementType, []attr.Value{
+				types.ObjectValueMust(autoFlexTestLoadBalancerAttrTypes, map[string]attr.Value{
+					"container_name":     types.StringValue("app"),
+					"container_port":     types.Int64Value(8080),
+					"load_balancer_name": types.StringNull(),
+					"target_group_arn":   types.StringNull(),
+				}),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got types.Set
+
+			if diags := FlattenFramework(ctx, testCase.input, autoFlexTestLoadBalancerSetType, &got); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestFlattenFrameworkNestedBlock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	attrTypes := map[string]attr.Type{
+		"base":   types.Int64Type,
+		"name":   types.StringType,
+		"active": types.BoolType,
+		"value":  types.Float64Type,
+	}
+	listType := types.ListType{ElemType: types.ObjectType{AttrTypes: attrTypes}}
+
+	type apiObject struct {
+		Base  int32
+		Name  string
+		Value float64
+	}
+
+	var got types.List
+
+	if diags := FlattenFramework(ctx, &apiObject{Base: 1, Value: 50}, listType, &got); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := types.ListValueMust(listType.ElemType, []attr.Value{
+		types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"base":   types.Int64Value(1),
+			"name":   types.StringNull(),
+			"active": types.BoolNull(),
+			"value":  types.Float64Value(50),
+		}),
+	})
+
+	if !got.Equal(expected) {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	var gotNull types.List
+
+	if diags := FlattenFramework(ctx, (*apiObject)(nil), listType, &gotNull); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !gotNull.IsNull() {
+		t.Errorf("expected null, got %s", gotNull)
+	}
+}
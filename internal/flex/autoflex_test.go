@@ -0,0 +1,243 @@
+package flex
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type autoFlexTestEnum string
+
+type autoFlexTestNested struct {
+	IsActive     *bool
+	PrincipalArn *string
+
+	_ struct{}
+}
+
+type autoFlexTestObject struct {
+	AllowCredentials *bool
+	AllowHeaders     []*string
+	CoolingPeriod    *int64
+	Items            []autoFlexTestNested
+	MaxAge           *int64
+	Mode             autoFlexTestEnum
+	Nested           *autoFlexTestNested
+	Port             int32
+	Weight           float64
+
+	noSmithyDocumentSerde struct{}
+}
+
+var autoFlexTestIgnoreUnexported = cmpopts.IgnoreUnexported(autoFlexTestObject{}, autoFlexTestNested{})
+
+func TestFieldNameToAttributeName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Name":               "name",
+		"AllowCredentials":   "allow_credentials",
+		"EcrImagePullerRole": "ecr_image_puller_role",
+		"TargetGroupArn":     "target_group_arn",
+		"VPCId":              "vpc_id",
+		"Ipv6Address":        "ipv6_address",
+		"ARN":                "arn",
+	}
+
+	for input, expected := range testCases {
+		input, expected := input, expected
+
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+
+			if got := fieldNameToAttributeName(input); got != expected {
+				t.Errorf("got %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	t.Parallel()
+
+	hash := schema.HashString
+
+	testCases := map[string]struct {
+		input    any
+		optFns   []AutoFlexOptionsFunc
+		expected *autoFlexTestObject
+	}{
+		"empty block": {
+			input:    []interface{}{},
+			expected: nil,
+		},
+		"nil block element": {
+			input:    []interface{}{nil},
+			expected: nil,
+		},
+		"full block": {
+			input: []interface{}{map[string]interface{}{
+				"allow_credentials": false,
+				"allow_headers":     schema.NewSet(hash, []interface{}{"Authorization"}),
+				"cooling_period":    0,
+				"items": []interface{}{
+					map[string]interface{}{"is_active": true},
+				},
+				"max_age": 300,
+				"mode":    "ENABLED",
+				"nested": []interface{}{map[string]interface{}{
+					"is_active":     true,
+					"principal_arn": "arn:aws:iam::123456789012:role/test",
+				}},
+				"port":   8080,
+				"weight": 1.5,
+			}},
+			expected: &autoFlexTestObject{
+				AllowCredentials: aws.Bool(false),
+				AllowHeaders:     []*string{aws.String("Authorization")},
+				CoolingPeriod:    aws.Int64(0),
+				Items:            []autoFlexTestNested{{IsActive: aws.Bool(true)}},
+				MaxAge:           aws.Int64(300),
+				Mode:             autoFlexTestEnum("ENABLED"),
+				Nested: &autoFlexTestNested{
+					IsActive:     aws.Bool(true),
+					PrincipalArn: aws.String("arn:aws:iam::123456789012:role/test"),
+				},
+				Port:   8080,
+				Weight: 1.5,
+			},
+		},
+		"empty set": {
+			input: []interface{}{map[string]interface{}{
+				"allow_headers": schema.NewSet(hash, nil),
+			}},
+			expected: &autoFlexTestObject{
+				AllowHeaders: []*string{},
+			},
+		},
+		"omit empty": {
+			input: []interface{}{map[string]interface{}{
+				"allow_credentials": false,
+				"allow_headers":     schema.NewSet(hash, nil),
+				"cooling_period":    0,
+				"max_age":           300,
+				"nested":            []interface{}{},
+			}},
+			optFns: []AutoFlexOptionsFunc{WithOmitEmpty()},
+			expected: &autoFlexTestObject{
+				MaxAge: aws.Int64(300),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got *autoFlexTestObject
+
+			if err := Expand(testCase.input, &got, testCase.optFns...); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, autoFlexTestIgnoreUnexported); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandErrors(t *testing.T) {
+	t.Parallel()
+
+	var apiObject autoFlexTestObject
+
+	if err := Expand([]interface{}{}, apiObject); err == nil {
+		t.Error("expected error for non-pointer target")
+	}
+
+	if err := Expand(map[string]interface{}{"max_age": "300"}, &apiObject); err == nil {
+		t.Error("expected error for mismatched type")
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    any
+		optFns   []AutoFlexOptionsFunc
+		expected []interface{}
+	}{
+		"nil structure": {
+			input:    (*autoFlexTestObject)(nil),
+			expected: []interface{}{},
+		},
+		"full structure": {
+			input: &autoFlexTestObject{
+				AllowCredentials: aws.Bool(true),
+				AllowHeaders:     []*string{aws.String("Authorization"), nil},
+				Items:            []autoFlexTestNested{{IsActive: aws.Bool(false)}},
+				MaxAge:           aws.Int64(300),
+				Mode:             autoFlexTestEnum("ENABLED"),
+				Nested: &autoFlexTestNested{
+					PrincipalArn: aws.String("arn:aws:iam::123456789012:role/test"),
+				},
+				Weight: 1.5,
+			},
+			expected: []interface{}{map[string]interface{}{
+				"allow_credentials": true,
+				"allow_headers":     []interface{}{"Authorization"},
+				"items": []interface{}{
+					map[string]interface{}{"is_active": false},
+				},
+				"max_age": 300,
+				"mode":    "ENABLED",
+				"nested": []interface{}{map[string]interface{}{
+					"principal_arn": "arn:aws:iam::123456789012:role/test",
+				}},
+				"port":   0,
+				"weight": 1.5,
+			}},
+		},
+		"omit empty": {
+			input: &autoFlexTestObject{
+				CoolingPeriod: aws.Int64(0),
+				MaxAge:        aws.Int64(300),
+			},
+			optFns: []AutoFlexOptionsFunc{WithOmitEmpty()},
+			expected: []interface{}{map[string]interface{}{
+				"max_age": 300,
+			}},
+		},
+		"slice of structures": {
+			input: []*autoFlexTestNested{{IsActive: aws.Bool(true)}},
+			expected: []interface{}{
+				map[string]interface{}{"is_active": true},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Flatten(testCase.input, testCase.optFns...)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
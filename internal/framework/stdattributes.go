@@ -1,9 +1,15 @@
 package framework
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwboolplanmodifier "github.com/hashicorp/terraform-provider-aws/internal/framework/boolplanmodifier"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func IDAttribute() schema.StringAttribute {
@@ -23,3 +29,39 @@ func ARNAttributeComputedOnly() schema.StringAttribute {
 		},
 	}
 }
+
+// NetworkConfigurationBlock returns the schema of an optional awsvpc network_configuration block, as used by ECS services and task sets.
+// It is the Plugin Framework equivalent of sdkv2.NetworkConfigurationSchema.
+func NetworkConfigurationBlock(planModifiers ...planmodifier.List) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrAssignPublicIP: schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.Bool{
+						fwboolplanmodifier.DefaultValue(false),
+					},
+				},
+				names.AttrSecurityGroups: schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtMost(5),
+					},
+				},
+				names.AttrSubnets: schema.SetAttribute{
+					ElementType: types.StringType,
+					Required:    true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtMost(16),
+					},
+				},
+			},
+		},
+		PlanModifiers: planModifiers,
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type nonNegativeDurationValidator struct{}
+
+func (validator nonNegativeDurationValidator) Description(_ context.Context) string {
+	return "value must be a valid, non-negative duration"
+}
+
+func (validator nonNegativeDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator nonNegativeDurationValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateNonNegativeDuration(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			err.Error(),
+		))
+		return
+	}
+}
+
+// NonNegativeDuration returns a string validator which ensures that the value can be parsed
+// by time.ParseDuration and is not negative.
+func NonNegativeDuration() validator.String {
+	return nonNegativeDurationValidator{}
+}
+
+func validateNonNegativeDuration(value string) error {
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return fmt.Errorf("cannot be parsed as a duration: %w", err)
+	}
+
+	if duration < 0 {
+		return fmt.Errorf("must be greater than or equal to zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+type ConstantDatum struct {
+	Constant  string
+	Attribute string
+}
+
+type TemplateData struct {
+	Constants []ConstantDatum
+}
+
+var (
+	attributeRegexp = regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)
+	constantRegexp  = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+)
+
+func main() {
+	const (
+		filename      = `attr_consts_gen.go`
+		attrsDataFile = "attr_consts.csv"
+	)
+	g := common.NewGenerator()
+
+	g.Infof("Generating names/%s", filename)
+
+	data, err := common.ReadAllCSVData(attrsDataFile)
+
+	if err != nil {
+		g.Fatalf("error reading %s: %s", attrsDataFile, err)
+	}
+
+	td := TemplateData{}
+	constants := make(map[string]bool)
+
+	for i, l := range data {
+		if i < 1 { // no header
+			continue
+		}
+
+		datum := ConstantDatum{
+			Constant:  l[0],
+			Attribute: l[1],
+		}
+
+		// Keep the list consistent so that constants are predictable from attribute names.
+		if !attributeRegexp.MatchString(datum.Attribute) {
+			g.Fatalf("%s line %d: attribute (%s) is not snake case", attrsDataFile, i+1, datum.Attribute)
+		}
+
+		if !constantRegexp.MatchString(datum.Constant) || !strings.EqualFold(datum.Constant, strings.ReplaceAll(datum.Attribute, "_", "")) {
+			g.Fatalf("%s line %d: constant (%s) does not match attribute (%s)", attrsDataFile, i+1, datum.Constant, datum.Attribute)
+		}
+
+		if constants[datum.Constant] {
+			g.Fatalf("%s line %d: duplicate constant (%s)", attrsDataFile, i+1, datum.Constant)
+		}
+		constants[datum.Constant] = true
+
+		if n := len(td.Constants); n > 0 && td.Constants[n-1].Attribute >= datum.Attribute {
+			g.Fatalf("%s line %d: attribute (%s) is not in alphabetical order", attrsDataFile, i+1, datum.Attribute)
+		}
+
+		td.Constants = append(td.Constants, datum)
+	}
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.WriteTemplate("attrconsts", tmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+//go:embed file.tmpl
+var tmpl string
@@ -31,6 +31,7 @@ var (
 	contextOnly        = flag.Bool("ContextOnly", false, "whether to only generate Context-aware functions")
 
 	getTagFunc            = flag.String("GetTagFunc", "GetTag", "getTagFunc")
+	keyValueTagsFunc      = flag.String("KeyValueTagsFunc", "KeyValueTags", "keyValueTagsFunc (AWS SDK for Go v2 only)")
 	listTagsFunc          = flag.String("ListTagsFunc", "ListTags", "listTagsFunc")
 	listTagsInFiltIDName  = flag.String("ListTagsInFiltIDName", "", "listTagsInFiltIDName")
 	listTagsInIDElem      = flag.String("ListTagsInIDElem", "ResourceArn", "listTagsInIDElem")
@@ -55,6 +56,7 @@ var (
 	untagInNeedTagKeyType = flag.String("UntagInNeedTagKeyType", "", "untagInNeedTagKeyType")
 	untagInTagsElem       = flag.String("UntagInTagsElem", "TagKeys", "untagInTagsElem")
 	untagOp               = flag.String("UntagOp", "UntagResource", "untagOp")
+	tagsFunc              = flag.String("TagsFunc", "Tags", "tagsFunc (AWS SDK for Go v2 only)")
 	updateTagsFunc        = flag.String("UpdateTagsFunc", "UpdateTags", "updateTagsFunc")
 
 	parentNotFoundErrCode = flag.String("ParentNotFoundErrCode", "", "Parent 'NotFound' Error Code")
@@ -123,6 +125,7 @@ type TemplateData struct {
 	ServicePackage         string
 
 	GetTagFunc              string
+	KeyValueTagsFunc        string
 	ListTagsFunc            string
 	ListTagsInFiltIDName    string
 	ListTagsInIDElem        string
@@ -148,6 +151,7 @@ type TemplateData struct {
 	TagTypeIDElem           string
 	TagTypeKeyElem          string
 	TagTypeValElem          string
+	TagsFunc                string
 	UntagInCustomVal        string
 	UntagInNeedTagKeyType   string
 	UntagInNeedTagType      bool
@@ -229,6 +233,7 @@ func main() {
 		TfResourcePkg:   *getTag,
 
 		GetTagFunc:              *getTagFunc,
+		KeyValueTagsFunc:        *keyValueTagsFunc,
 		ListTagsFunc:            *listTagsFunc,
 		ListTagsInFiltIDName:    *listTagsInFiltIDName,
 		ListTagsInIDElem:        *listTagsInIDElem,
@@ -253,6 +258,7 @@ func main() {
 		TagTypeIDElem:           *tagTypeIDElem,
 		TagTypeKeyElem:          *tagTypeKeyElem,
 		TagTypeValElem:          *tagTypeValElem,
+		TagsFunc:                *tagsFunc,
 		UntagInCustomVal:        *untagInCustomVal,
 		UntagInNeedTagKeyType:   *untagInNeedTagKeyType,
 		UntagInNeedTagType:      *untagInNeedTagType,
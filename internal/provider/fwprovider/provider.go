@@ -48,6 +48,14 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"api_call_metrics": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Count the AWS API calls made by the provider, by service and operation, and log a summary at DEBUG level after each resource and data source operation.",
+			},
+			"batch_tag_reads": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Combine concurrent tag reads of supported resources into Resource Groups Tagging API calls. Requires the tag:GetResources permission.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -125,6 +133,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Skip the AWS Metadata API check. Used for AWS API implementations that do not have a metadata api endpoint.",
 			},
+			"skip_refresh_propagation_retries": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip retrying reads of existing resources that are not found. Speeds up refreshing large states where resources are known to exist. Currently only honored by the SQS queue, queue policy, redrive allow policy and redrive policy resources.",
+			},
 			"skip_region_validation": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Skip static validation of region name. Used by users of alternative AWS-like APIs or users w/ access to regions that are not public (yet).",
@@ -133,10 +145,22 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Skip requesting the account ID. Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 			},
+			"skip_tagging": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Report tagging operations that fail because tagging is unsupported as warnings instead of errors. Used in partitions or AWS-like APIs where tagging isn't supported for all resources. Currently only honored by aws_ecr_repository, aws_ecs_task_set and the aws_ecr_repository data source.",
+			},
+			"strict_tagging": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Fail instead of warn when a tagging operation fails in a partition where tagging may be unsupported. Has no effect when skip_tagging is set. Currently only honored by aws_ecr_repository, aws_ecs_task_set and the aws_ecr_repository data source.",
+			},
 			"sts_region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS STS operations will take place. Examples\nare us-east-1 and us-west-2.", // lintignore:AWSAT003
 			},
+			"timeouts_multiplier": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Factor by which the default create, read, update and delete timeouts of all resources are scaled. Timeouts configured in a resource's timeouts block are not scaled.",
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -149,12 +173,13 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"wait_for_stabilization": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether resources that support optional stabilization waits wait by default. A resource's own wait arguments take precedence.",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -270,6 +295,16 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Optional:    true,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_patterns": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"key_values": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Map of resource tag keys to regular expressions matching the tag values to ignore across all resources.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -278,6 +313,30 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"service_retry": schema.SetNestedBlock{
+				Description: "Configuration blocks with settings to override the retry behavior of individual services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_backoff": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The maximum delay between retries of a request. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"max_retries": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times an AWS API request is being executed. Overrides the provider-level `max_retries`.",
+						},
+						"retry_mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "The retry strategy of AWS SDK for Go v2 clients. Valid values are `standard` and `adaptive`.",
+						},
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "The service to override the retry behavior of. Valid values are the service names used in the `endpoints` block.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -362,6 +421,17 @@ func endpointsBlock() schema.SetNestedBlock {
 		}
 	}
 
+	endpointsAttributes["use_dualstack_endpoint"] = schema.SetAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Services for which to resolve endpoints with DualStack capability",
+	}
+	endpointsAttributes["use_fips_endpoint"] = schema.SetAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Services for which to resolve endpoints with FIPS capability",
+	}
+
 	return schema.SetNestedBlock{
 		NestedObject: schema.NestedBlockObject{
 			Attributes: endpointsAttributes,
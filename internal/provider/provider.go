@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
@@ -214,8 +215,20 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				ConflictsWith: []string{"forbidden_account_ids"},
 				Set:           schema.HashString,
 			},
+			"api_call_metrics": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Count the AWS API calls made by the provider, by service and operation, " +
+					"and log a summary at DEBUG level after each resource and data source operation.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"batch_tag_reads": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Combine concurrent tag reads of supported resources into Resource Groups Tagging API calls. " +
+					"Requires the tag:GetResources permission.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -286,6 +299,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_patterns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Set:         schema.HashString,
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"key_values": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Map of resource tag keys to regular expressions matching the tag values to ignore across all resources.",
+						},
 					},
 				},
 			},
@@ -337,6 +366,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_retry": serviceRetrySchema(),
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -377,6 +407,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Skip the AWS Metadata API check. " +
 					"Used for AWS API implementations that do not have a metadata api endpoint.",
 			},
+			"skip_refresh_propagation_retries": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Skip retrying reads of existing resources that are not found. " +
+					"Speeds up refreshing large states where resources are known to exist. " +
+					"Currently only honored by the SQS queue, queue policy, redrive allow policy and redrive policy resources.",
+			},
 			"skip_region_validation": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -389,12 +426,33 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Skip requesting the account ID. " +
 					"Used for AWS API implementations that do not have IAM/STS API and/or metadata API.",
 			},
+			"skip_tagging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Report tagging operations that fail because tagging is unsupported as warnings instead of errors. " +
+					"Used in partitions or AWS-like APIs where tagging isn't supported for all resources. " +
+					"Currently only honored by aws_ecr_repository, aws_ecs_task_set and the aws_ecr_repository data source.",
+			},
+			"strict_tagging": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Fail instead of warn when a tagging operation fails in a partition where tagging may be unsupported. " +
+					"Has no effect when skip_tagging is set. " +
+					"Currently only honored by aws_ecr_repository, aws_ecs_task_set and the aws_ecr_repository data source.",
+			},
 			"sts_region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"timeouts_multiplier": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Description: "Factor by which the default create, read, update and delete timeouts of all resources are scaled. " +
+					"Timeouts configured in a resource's timeouts block are not scaled.",
+				ValidateFunc: validation.FloatAtLeast(1),
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -411,6 +469,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"wait_for_stabilization": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether resources that support optional stabilization waits wait by default. " +
+					"A resource's own wait arguments take precedence.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -493,6 +557,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_cognito_user_pool_signing_certificate": cognitoidp.DataSourceUserPoolSigningCertificate(),
 			"aws_cognito_user_pools":                    cognitoidp.DataSourceUserPools(),
 
+			"aws_config_aggregate_discovered_resources":       configservice.DataSourceAggregateDiscoveredResources(),
+			"aws_config_aggregate_resource_query":             configservice.DataSourceAggregateResourceQuery(),
+			"aws_config_compliance_by_config_rule":            configservice.DataSourceComplianceByConfigRule(),
+			"aws_config_compliance_summary":                   configservice.DataSourceComplianceSummary(),
+			"aws_config_config_rules":                         configservice.DataSourceConfigRules(),
+			"aws_config_configuration_aggregator":             configservice.DataSourceConfigurationAggregator(),
+			"aws_config_organization_conformance_pack_status": configservice.DataSourceOrganizationConformancePackStatus(),
+
 			"aws_connect_bot_association":             connect.DataSourceBotAssociation(),
 			"aws_connect_contact_flow":                connect.DataSourceContactFlow(),
 			"aws_connect_contact_flow_module":         connect.DataSourceContactFlowModule(),
@@ -636,9 +708,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_elasticache_subnet_group":      elasticache.DataSourceSubnetGroup(),
 			"aws_elasticache_user":              elasticache.DataSourceUser(),
 
-			"aws_elastic_beanstalk_application":    elasticbeanstalk.DataSourceApplication(),
-			"aws_elastic_beanstalk_hosted_zone":    elasticbeanstalk.DataSourceHostedZone(),
-			"aws_elastic_beanstalk_solution_stack": elasticbeanstalk.DataSourceSolutionStack(),
+			"aws_elastic_beanstalk_application":     elasticbeanstalk.DataSourceApplication(),
+			"aws_elastic_beanstalk_environment":     elasticbeanstalk.DataSourceEnvironment(),
+			"aws_elastic_beanstalk_hosted_zone":     elasticbeanstalk.DataSourceHostedZone(),
+			"aws_elastic_beanstalk_solution_stack":  elasticbeanstalk.DataSourceSolutionStack(),
+			"aws_elastic_beanstalk_solution_stacks": elasticbeanstalk.DataSourceSolutionStacks(),
 
 			"aws_elasticsearch_domain": elasticsearch.DataSourceDomain(),
 
@@ -806,6 +880,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_qldb_ledger": qldb.DataSourceLedger(),
 
+			"aws_ram_permission":     ram.DataSourcePermission(),
+			"aws_ram_permissions":    ram.DataSourcePermissions(),
 			"aws_ram_resource_share": ram.DataSourceResourceShare(),
 
 			"aws_ses_active_receipt_rule_set": ses.DataSourceActiveReceiptRuleSet(),
@@ -839,8 +915,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_resourcegroupstaggingapi_resources": resourcegroupstaggingapi.DataSourceResources(),
 
 			"aws_route53_delegation_set":          route53.DataSourceDelegationSet(),
+			"aws_route53_health_check_status":     route53.DataSourceHealthCheckStatus(),
+			"aws_route53_records":                 route53.DataSourceRecords(),
 			"aws_route53_traffic_policy_document": route53.DataSourceTrafficPolicyDocument(),
 			"aws_route53_zone":                    route53.DataSourceZone(),
+			"aws_route53_zones":                   route53.DataSourceZones(),
 
 			"aws_route53_resolver_endpoint":                        route53resolver.DataSourceEndpoint(),
 			"aws_route53_resolver_firewall_config":                 route53resolver.DataSourceFirewallConfig(),
@@ -895,10 +974,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_ssm_document":            ssm.DataSourceDocument(),
 			"aws_ssm_instances":           ssm.DataSourceInstances(),
+			"aws_ssm_inventory":           ssm.DataSourceInventory(),
+			"aws_ssm_inventory_entries":   ssm.DataSourceInventoryEntries(),
 			"aws_ssm_maintenance_windows": ssm.DataSourceMaintenanceWindows(),
+			"aws_ssm_managed_instances":   ssm.DataSourceManagedInstances(),
 			"aws_ssm_parameter":           ssm.DataSourceParameter(),
 			"aws_ssm_parameters_by_path":  ssm.DataSourceParametersByPath(),
 			"aws_ssm_patch_baseline":      ssm.DataSourcePatchBaseline(),
+			"aws_ssm_patch_baselines":     ssm.DataSourcePatchBaselines(),
+			"aws_ssm_patch_group_state":   ssm.DataSourcePatchGroupState(),
 
 			"aws_ssoadmin_instances":      ssoadmin.DataSourceInstances(),
 			"aws_ssoadmin_permission_set": ssoadmin.DataSourcePermissionSet(),
@@ -1190,17 +1274,21 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_comprehend_document_classifier": comprehend.ResourceDocumentClassifier(),
 			"aws_comprehend_entity_recognizer":   comprehend.ResourceEntityRecognizer(),
 
-			"aws_config_aggregate_authorization":       configservice.ResourceAggregateAuthorization(),
-			"aws_config_config_rule":                   configservice.ResourceConfigRule(),
-			"aws_config_configuration_aggregator":      configservice.ResourceConfigurationAggregator(),
-			"aws_config_configuration_recorder":        configservice.ResourceConfigurationRecorder(),
-			"aws_config_configuration_recorder_status": configservice.ResourceConfigurationRecorderStatus(),
-			"aws_config_conformance_pack":              configservice.ResourceConformancePack(),
-			"aws_config_delivery_channel":              configservice.ResourceDeliveryChannel(),
-			"aws_config_organization_conformance_pack": configservice.ResourceOrganizationConformancePack(),
-			"aws_config_organization_custom_rule":      configservice.ResourceOrganizationCustomRule(),
-			"aws_config_organization_managed_rule":     configservice.ResourceOrganizationManagedRule(),
-			"aws_config_remediation_configuration":     configservice.ResourceRemediationConfiguration(),
+			"aws_config_aggregate_authorization":         configservice.ResourceAggregateAuthorization(),
+			"aws_config_config_rule":                     configservice.ResourceConfigRule(),
+			"aws_config_configuration_aggregator":        configservice.ResourceConfigurationAggregator(),
+			"aws_config_configuration_recorder":          configservice.ResourceConfigurationRecorder(),
+			"aws_config_configuration_recorder_status":   configservice.ResourceConfigurationRecorderStatus(),
+			"aws_config_conformance_pack":                configservice.ResourceConformancePack(),
+			"aws_config_delivery_channel":                configservice.ResourceDeliveryChannel(),
+			"aws_config_organization_conformance_pack":   configservice.ResourceOrganizationConformancePack(),
+			"aws_config_organization_custom_policy_rule": configservice.ResourceOrganizationCustomPolicyRule(),
+			"aws_config_organization_custom_rule":        configservice.ResourceOrganizationCustomRule(),
+			"aws_config_organization_managed_rule":       configservice.ResourceOrganizationManagedRule(),
+			"aws_config_remediation_configuration":       configservice.ResourceRemediationConfiguration(),
+			"aws_config_remediation_exception":           configservice.ResourceRemediationException(),
+			"aws_config_retention_configuration":         configservice.ResourceRetentionConfiguration(),
+			"aws_config_stored_query":                    configservice.ResourceStoredQuery(),
 
 			"aws_connect_bot_association":             connect.ResourceBotAssociation(),
 			"aws_connect_contact_flow":                connect.ResourceContactFlow(),
@@ -1444,7 +1532,6 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_ecs_service":                    ecs.ResourceService(),
 			"aws_ecs_tag":                        ecs.ResourceTag(),
 			"aws_ecs_task_definition":            ecs.ResourceTaskDefinition(),
-			"aws_ecs_task_set":                   ecs.ResourceTaskSet(),
 
 			"aws_efs_access_point":              efs.ResourceAccessPoint(),
 			"aws_efs_backup_policy":             efs.ResourceBackupPolicy(),
@@ -1473,6 +1560,8 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_elastic_beanstalk_application_version":    elasticbeanstalk.ResourceApplicationVersion(),
 			"aws_elastic_beanstalk_configuration_template": elasticbeanstalk.ResourceConfigurationTemplate(),
 			"aws_elastic_beanstalk_environment":            elasticbeanstalk.ResourceEnvironment(),
+			"aws_elastic_beanstalk_environment_swap":       elasticbeanstalk.ResourceEnvironmentSwap(),
+			"aws_elastic_beanstalk_saved_configuration":    elasticbeanstalk.ResourceSavedConfiguration(),
 
 			"aws_elasticsearch_domain":              elasticsearch.ResourceDomain(),
 			"aws_elasticsearch_domain_policy":       elasticsearch.ResourceDomainPolicy(),
@@ -1860,6 +1949,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_quicksight_group_membership": quicksight.ResourceGroupMembership(),
 			"aws_quicksight_user":             quicksight.ResourceUser(),
 
+			"aws_ram_permission_association":  ram.ResourcePermissionAssociation(),
 			"aws_ram_principal_association":   ram.ResourcePrincipalAssociation(),
 			"aws_ram_resource_association":    ram.ResourceResourceAssociation(),
 			"aws_ram_resource_share":          ram.ResourceResourceShare(),
@@ -1927,11 +2017,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_route53_key_signing_key":               route53.ResourceKeySigningKey(),
 			"aws_route53_query_log":                     route53.ResourceQueryLog(),
 			"aws_route53_record":                        route53.ResourceRecord(),
+			"aws_route53_records":                       route53.ResourceRecords(),
 			"aws_route53_traffic_policy":                route53.ResourceTrafficPolicy(),
 			"aws_route53_traffic_policy_instance":       route53.ResourceTrafficPolicyInstance(),
 			"aws_route53_vpc_association_authorization": route53.ResourceVPCAssociationAuthorization(),
 			"aws_route53_zone":                          route53.ResourceZone(),
 			"aws_route53_zone_association":              route53.ResourceZoneAssociation(),
+			"aws_route53_zone_associations":             route53.ResourceZoneAssociations(),
 
 			"aws_route53domains_registered_domain": route53domains.ResourceRegisteredDomain(),
 
@@ -2105,16 +2197,21 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			"aws_ssm_activation":                ssm.ResourceActivation(),
 			"aws_ssm_association":               ssm.ResourceAssociation(),
+			"aws_ssm_automation_execution":      ssm.ResourceAutomationExecution(),
+			"aws_ssm_command":                   ssm.ResourceCommand(),
 			"aws_ssm_default_patch_baseline":    ssm.ResourceDefaultPatchBaseline(),
 			"aws_ssm_document":                  ssm.ResourceDocument(),
 			"aws_ssm_maintenance_window":        ssm.ResourceMaintenanceWindow(),
 			"aws_ssm_maintenance_window_target": ssm.ResourceMaintenanceWindowTarget(),
 			"aws_ssm_maintenance_window_task":   ssm.ResourceMaintenanceWindowTask(),
+			"aws_ssm_ops_item":                  ssm.ResourceOpsItem(),
 			"aws_ssm_parameter":                 ssm.ResourceParameter(),
+			"aws_ssm_parameter_label":           ssm.ResourceParameterLabel(),
 			"aws_ssm_patch_baseline":            ssm.ResourcePatchBaseline(),
 			"aws_ssm_patch_group":               ssm.ResourcePatchGroup(),
 			"aws_ssm_resource_data_sync":        ssm.ResourceResourceDataSync(),
 			"aws_ssm_service_setting":           ssm.ResourceServiceSetting(),
+			"aws_ssm_session_preferences":       ssm.ResourceSessionPreferences(),
 
 			"aws_ssoadmin_account_assignment":                 ssoadmin.ResourceAccountAssignment(),
 			"aws_ssoadmin_customer_managed_policy_attachment": ssoadmin.ResourceCustomerManagedPolicyAttachment(),
@@ -2201,7 +2298,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		},
 	}
 
+	// Resources' default timeouts before scaling by timeouts_multiplier, keyed by resource type name.
+	defaultTimeouts := make(map[string]schema.ResourceTimeout)
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		scaleResourceTimeouts(provider, defaultTimeouts, d.Get("timeouts_multiplier").(float64))
+
 		return configure(ctx, provider, d)
 	}
 
@@ -2218,7 +2320,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			ds := v()
 
 			if v := ds.ReadWithoutTimeout; v != nil {
-				ds.ReadWithoutTimeout = wrappedReadContextFunc(v)
+				ds.ReadWithoutTimeout = wrappedReadContextFunc("data."+typeName, v)
 			}
 
 			provider.DataSourcesMap[typeName] = ds
@@ -2233,28 +2335,28 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			r := v()
 
 			if v := r.CreateWithoutTimeout; v != nil {
-				r.CreateWithoutTimeout = wrappedCreateContextFunc(v)
+				r.CreateWithoutTimeout = wrappedCreateContextFunc(typeName, v)
 			}
 			if v := r.ReadWithoutTimeout; v != nil {
-				r.ReadWithoutTimeout = wrappedReadContextFunc(v)
+				r.ReadWithoutTimeout = wrappedReadContextFunc(typeName, v)
 			}
 			if v := r.UpdateWithoutTimeout; v != nil {
-				r.UpdateWithoutTimeout = wrappedUpdateContextFunc(v)
+				r.UpdateWithoutTimeout = wrappedUpdateContextFunc(typeName, v)
 			}
 			if v := r.DeleteWithoutTimeout; v != nil {
-				r.DeleteWithoutTimeout = wrappedDeleteContextFunc(v)
+				r.DeleteWithoutTimeout = wrappedDeleteContextFunc(typeName, v)
 			}
 			if v := r.Importer; v != nil {
 				if v := v.StateContext; v != nil {
-					r.Importer.StateContext = wrappedStateContextFunc(v)
+					r.Importer.StateContext = wrappedStateContextFunc(typeName, v)
 				}
 			}
 			if v := r.CustomizeDiff; v != nil {
-				r.CustomizeDiff = wrappedCustomizeDiffFunc(v)
+				r.CustomizeDiff = wrappedCustomizeDiffFunc(typeName, v)
 			}
 			for _, stateUpgrader := range r.StateUpgraders {
 				if v := stateUpgrader.Upgrade; v != nil {
-					stateUpgrader.Upgrade = wrappedStateUpgradeFunc(v)
+					stateUpgrader.Upgrade = wrappedStateUpgradeFunc(typeName, v)
 				}
 			}
 
@@ -2266,6 +2368,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		return nil, err
 	}
 
+	for typeName, r := range provider.ResourcesMap {
+		if r.Timeouts != nil {
+			defaultTimeouts[typeName] = *r.Timeouts
+		}
+	}
+
 	// Set the provider Meta (instance data) here.
 	// It will be overwritten by the result of the call to ConfigureContextFunc,
 	// but can be used pre-configuration by other (non-primary) provider servers.
@@ -2281,6 +2389,34 @@ func New(ctx context.Context) (*schema.Provider, error) {
 	return provider, nil
 }
 
+// scaleResourceTimeouts sets the default timeouts of the provider's resources to the specified defaults scaled by multiplier.
+// The defaults are kept separately so that configuring the provider more than once doesn't compound the scaling.
+func scaleResourceTimeouts(provider *schema.Provider, defaults map[string]schema.ResourceTimeout, multiplier float64) {
+	if multiplier <= 0 {
+		multiplier = 1
+	}
+
+	scale := func(d *time.Duration) *time.Duration {
+		if d == nil {
+			return nil
+		}
+
+		v := time.Duration(float64(*d) * multiplier)
+
+		return &v
+	}
+
+	for typeName, v := range defaults {
+		provider.ResourcesMap[typeName].Timeouts = &schema.ResourceTimeout{
+			Create:  scale(v.Create),
+			Read:    scale(v.Read),
+			Update:  scale(v.Update),
+			Delete:  scale(v.Delete),
+			Default: scale(v.Default),
+		}
+	}
+}
+
 // configure ensures that the provider is fully configured.
 func configure(ctx context.Context, provider *schema.Provider, d *schema.ResourceData) (*conns.AWSClient, diag.Diagnostics) {
 	terraformVersion := provider.TerraformVersion
@@ -2292,6 +2428,8 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		APICallMetrics:                 d.Get("api_call_metrics").(bool),
+		BatchTagReads:                  d.Get("batch_tag_reads").(bool),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -2305,22 +2443,35 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		SecretKey:                      d.Get("secret_key").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:            d.Get("skip_get_ec2_platforms").(bool),
+		SkipRefreshPropagationRetries:  d.Get("skip_refresh_propagation_retries").(bool),
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
+		SkipTagging:                    d.Get("skip_tagging").(bool),
+		StrictTagging:                  d.Get("strict_tagging").(bool),
 		STSRegion:                      d.Get("sts_region").(string),
 		TerraformVersion:               terraformVersion,
 		Token:                          d.Get("token").(string),
 		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
+		WaitForStabilization:           d.Get("wait_for_stabilization").(bool),
 	}
 
 	if v, ok := d.GetOk("allowed_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, SourceIdentity: %q)", config.AssumeRole.RoleARN, config.AssumeRole.SessionName, config.AssumeRole.ExternalID, config.AssumeRole.SourceIdentity)
+	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 {
+		assumeRoles, err := expandAssumeRoles(ctx, v.([]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.AssumeRole = assumeRoles
+
+		for _, assumeRole := range config.AssumeRole {
+			log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, SourceIdentity: %q)", assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID, assumeRole.SourceIdentity)
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -2340,6 +2491,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		}
 
 		config.Endpoints = endpoints
+
+		serviceEndpointOptions, err := expandServiceEndpointOptions(ctx, v.(*schema.Set).List())
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.ServiceEndpointOptions = serviceEndpointOptions
 	}
 
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
@@ -2347,13 +2506,29 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		ignoreTagsConfig, err := expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.IgnoreTagsConfig = ignoreTagsConfig
 	}
 
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_retry"); ok && v.(*schema.Set).Len() > 0 {
+		serviceRetries, err := expandServiceRetries(ctx, v.(*schema.Set).List())
+
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		config.ServiceRetries = serviceRetries
+	}
+
 	if v, ok := d.GetOk("shared_credentials_file"); ok {
 		config.SharedCredentialsFiles = []string{v.(string)}
 	} else if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
@@ -2391,23 +2566,20 @@ func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
-					Type:          schema.TypeString,
-					Optional:      true,
-					Description:   "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
-					ValidateFunc:  validAssumeRoleDuration,
-					ConflictsWith: []string{"assume_role.0.duration_seconds"},
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: validAssumeRoleDuration,
 				},
 				"duration_seconds": {
-					Type:          schema.TypeInt,
-					Optional:      true,
-					Deprecated:    "Use assume_role.duration instead",
-					Description:   "The duration, in seconds, of the role session.",
-					ValidateFunc:  validation.IntBetween(900, 43200),
-					ConflictsWith: []string{"assume_role.0.duration"},
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Deprecated:   "Use assume_role.duration instead",
+					Description:  "The duration, in seconds, of the role session.",
+					ValidateFunc: validation.IntBetween(900, 43200),
 				},
 				"external_id": {
 					Type:        schema.TypeString,
@@ -2536,6 +2708,25 @@ func endpointsSchema() *schema.Schema {
 		}
 	}
 
+	endpointsAttributes["use_dualstack_endpoint"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Services for which to resolve endpoints with DualStack capability",
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+		},
+	}
+	endpointsAttributes["use_fips_endpoint"] = &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Services for which to resolve endpoints with FIPS capability",
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+		},
+	}
+
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
@@ -2545,6 +2736,70 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func serviceRetrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Configuration blocks with settings to override the retry behavior of individual services.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The maximum delay between retries of a request. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: verify.ValidDuration,
+				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  "The maximum number of times an AWS API request is being executed. Overrides the provider-level `max_retries`.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"retry_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The retry strategy of AWS SDK for Go v2 clients. Valid values are `standard` and `adaptive`.",
+					ValidateFunc: validation.StringInSlice([]string{string(aws_sdkv2.RetryModeStandard), string(aws_sdkv2.RetryModeAdaptive)}, false),
+				},
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The service to override the retry behavior of. Valid values are the service names used in the `endpoints` block.",
+					ValidateFunc: validation.StringInSlice(names.Aliases(), false),
+				},
+			},
+		},
+	}
+}
+
+// expandAssumeRoles returns the assume_role blocks in the order in which the roles are to be assumed.
+func expandAssumeRoles(ctx context.Context, tfList []interface{}) ([]*awsbase.AssumeRole, error) {
+	var assumeRoles []*awsbase.AssumeRole
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		// ConflictsWith can't be used as it only addresses a single list element.
+		if v1, v2 := tfMap["duration"].(string), tfMap["duration_seconds"].(int); v1 != "" && v2 != 0 {
+			return nil, fmt.Errorf(`assume_role[%d]: only one of "duration" or "duration_seconds" can be specified`, i)
+		}
+
+		assumeRole := expandAssumeRole(ctx, tfMap)
+
+		if i > 0 && assumeRole.RoleARN == "" {
+			return nil, fmt.Errorf(`assume_role[%d]: "role_arn" is required when chaining roles`, i)
+		}
+
+		assumeRoles = append(assumeRoles, assumeRole)
+	}
+
+	return assumeRoles, nil
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -2649,9 +2904,9 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return defaultConfig
 }
 
-func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
+func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) (*tftags.IgnoreConfig, error) {
 	if tfMap == nil {
-		return nil
+		return nil, nil
 	}
 
 	ignoreConfig := &tftags.IgnoreConfig{}
@@ -2664,7 +2919,78 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 		ignoreConfig.KeyPrefixes = tftags.New(ctx, v.List())
 	}
 
-	return ignoreConfig
+	if v, ok := tfMap["key_patterns"].(*schema.Set); ok {
+		for _, v := range v.List() {
+			pattern, err := regexp.Compile(v.(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("ignore_tags key_patterns (%s): %w", v, err)
+			}
+
+			ignoreConfig.KeyPatterns = append(ignoreConfig.KeyPatterns, pattern)
+		}
+	}
+
+	if v, ok := tfMap["key_values"].(map[string]interface{}); ok && len(v) > 0 {
+		ignoreConfig.KeyValues = make(map[string]*regexp.Regexp, len(v))
+
+		for k, v := range v {
+			pattern, err := regexp.Compile(v.(string))
+
+			if err != nil {
+				return nil, fmt.Errorf("ignore_tags key_values (%s): %w", k, err)
+			}
+
+			ignoreConfig.KeyValues[k] = pattern
+		}
+	}
+
+	return ignoreConfig, nil
+}
+
+func expandServiceRetries(_ context.Context, tfList []interface{}) (map[string]*conns.ServiceRetryConfig, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	serviceRetries := make(map[string]*conns.ServiceRetryConfig)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		alias := tfMap["service"].(string)
+		pkg, err := names.ProviderPackageForAlias(alias)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign retry configuration (%s): %w", alias, err)
+		}
+
+		if _, ok := serviceRetries[pkg]; ok {
+			return nil, fmt.Errorf("duplicate retry configuration for service (%s)", alias)
+		}
+
+		serviceRetry := &conns.ServiceRetryConfig{}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			serviceRetry.MaxBackoff, _ = time.ParseDuration(v)
+		}
+
+		if v, ok := tfMap["max_retries"].(int); ok {
+			serviceRetry.MaxRetries = v
+		}
+
+		if v, ok := tfMap["retry_mode"].(string); ok {
+			serviceRetry.RetryMode = aws_sdkv2.RetryMode(v)
+		}
+
+		serviceRetries[pkg] = serviceRetry
+	}
+
+	return serviceRetries, nil
 }
 
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
@@ -2720,57 +3046,133 @@ func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string
 	return endpoints, nil
 }
 
-func wrappedCreateContextFunc(f schema.CreateContextFunc) schema.CreateContextFunc {
+func expandServiceEndpointOptions(_ context.Context, tfList []interface{}) (map[string]*conns.ServiceEndpointOptions, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	serviceEndpointOptions := make(map[string]*conns.ServiceEndpointOptions)
+	options := func(alias string) (*conns.ServiceEndpointOptions, error) {
+		pkg, err := names.ProviderPackageForAlias(alias)
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign endpoint options (%s): %w", alias, err)
+		}
+
+		v, ok := serviceEndpointOptions[pkg]
+
+		if !ok {
+			v = &conns.ServiceEndpointOptions{}
+			serviceEndpointOptions[pkg] = v
+		}
+
+		return v, nil
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["use_dualstack_endpoint"].(*schema.Set); ok {
+			for _, alias := range flex.ExpandStringValueSet(v) {
+				o, err := options(alias)
+
+				if err != nil {
+					return nil, err
+				}
+
+				o.UseDualStackEndpoint = true
+			}
+		}
+
+		if v, ok := tfMap["use_fips_endpoint"].(*schema.Set); ok {
+			for _, alias := range flex.ExpandStringValueSet(v) {
+				o, err := options(alias)
+
+				if err != nil {
+					return nil, err
+				}
+
+				o.UseFIPSEndpoint = true
+			}
+		}
+	}
+
+	if len(serviceEndpointOptions) == 0 {
+		return nil, nil
+	}
+
+	return serviceEndpointOptions, nil
+}
+
+func wrappedCreateContextFunc(typeName string, f schema.CreateContextFunc) schema.CreateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+		ctx, logAPICallMetrics := meta.(*conns.AWSClient).WithAPICallMetrics(ctx, typeName+" Create")
+		defer logAPICallMetrics()
 
 		return f(ctx, d, meta)
 	}
 }
 
-func wrappedReadContextFunc(f schema.ReadContextFunc) schema.ReadContextFunc {
+func wrappedReadContextFunc(typeName string, f schema.ReadContextFunc) schema.ReadContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+		ctx, logAPICallMetrics := meta.(*conns.AWSClient).WithAPICallMetrics(ctx, typeName+" Read")
+		defer logAPICallMetrics()
 
 		return f(ctx, d, meta)
 	}
 }
 
-func wrappedUpdateContextFunc(f schema.UpdateContextFunc) schema.UpdateContextFunc {
+func wrappedUpdateContextFunc(typeName string, f schema.UpdateContextFunc) schema.UpdateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+		ctx, logAPICallMetrics := meta.(*conns.AWSClient).WithAPICallMetrics(ctx, typeName+" Update")
+		defer logAPICallMetrics()
 
 		return f(ctx, d, meta)
 	}
 }
 
-func wrappedDeleteContextFunc(f schema.DeleteContextFunc) schema.DeleteContextFunc {
+func wrappedDeleteContextFunc(typeName string, f schema.DeleteContextFunc) schema.DeleteContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+		ctx, logAPICallMetrics := meta.(*conns.AWSClient).WithAPICallMetrics(ctx, typeName+" Delete")
+		defer logAPICallMetrics()
 
 		return f(ctx, d, meta)
 	}
 }
 
-func wrappedStateContextFunc(f schema.StateContextFunc) schema.StateContextFunc {
+func wrappedStateContextFunc(typeName string, f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+		ctx, logAPICallMetrics := meta.(*conns.AWSClient).WithAPICallMetrics(ctx, typeName+" Import")
+		defer logAPICallMetrics()
 
 		return f(ctx, d, meta)
 	}
 }
 
-func wrappedCustomizeDiffFunc(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+func wrappedCustomizeDiffFunc(typeName string, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+		ctx, logAPICallMetrics := meta.(*conns.AWSClient).WithAPICallMetrics(ctx, typeName+" CustomizeDiff")
+		defer logAPICallMetrics()
 
 		return f(ctx, d, meta)
 	}
 }
 
-func wrappedStateUpgradeFunc(f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
+func wrappedStateUpgradeFunc(typeName string, f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
 	return func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
 		ctx = meta.(*conns.AWSClient).InitContext(ctx)
+		ctx, logAPICallMetrics := meta.(*conns.AWSClient).WithAPICallMetrics(ctx, typeName+" UpgradeState")
+		defer logAPICallMetrics()
 
 		return f(ctx, rawState, meta)
 	}
@@ -5,6 +5,9 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -25,6 +28,131 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestScaleResourceTimeouts(t *testing.T) {
+	t.Parallel()
+
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_test": {
+				Timeouts: &schema.ResourceTimeout{
+					Create: schema.DefaultTimeout(10 * time.Minute),
+					Delete: schema.DefaultTimeout(5 * time.Minute),
+				},
+			},
+			"aws_test_no_timeouts": {},
+		},
+	}
+	defaults := map[string]schema.ResourceTimeout{
+		"aws_test": *provider.ResourcesMap["aws_test"].Timeouts,
+	}
+
+	testCases := []struct {
+		multiplier     float64
+		expectedCreate time.Duration
+		expectedDelete time.Duration
+	}{
+		{multiplier: 2, expectedCreate: 20 * time.Minute, expectedDelete: 10 * time.Minute},
+		{multiplier: 1.5, expectedCreate: 15 * time.Minute, expectedDelete: 450 * time.Second},
+		{multiplier: 0, expectedCreate: 10 * time.Minute, expectedDelete: 5 * time.Minute},
+	}
+
+	// Scaling is applied to the defaults on every call, not compounded.
+	for _, testCase := range testCases {
+		scaleResourceTimeouts(provider, defaults, testCase.multiplier)
+
+		timeouts := provider.ResourcesMap["aws_test"].Timeouts
+
+		if got := *timeouts.Create; got != testCase.expectedCreate {
+			t.Errorf("multiplier %g: create timeout got %s, expected %s", testCase.multiplier, got, testCase.expectedCreate)
+		}
+		if got := *timeouts.Delete; got != testCase.expectedDelete {
+			t.Errorf("multiplier %g: delete timeout got %s, expected %s", testCase.multiplier, got, testCase.expectedDelete)
+		}
+		if timeouts.Update != nil {
+			t.Errorf("multiplier %g: update timeout got %s, expected unset", testCase.multiplier, *timeouts.Update)
+		}
+		if provider.ResourcesMap["aws_test_no_timeouts"].Timeouts != nil {
+			t.Errorf("multiplier %g: timeouts set on resource without timeouts", testCase.multiplier)
+		}
+	}
+}
+
+func TestExpandAssumeRoles(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		input         []interface{}
+		expectedARNs  []string
+		expectedError bool
+	}{
+		{
+			name: "single role",
+			input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::123456789012:role/a", "duration_seconds": 0, "duration": ""}, //lintignore:AWSAT005
+			},
+			expectedARNs: []string{"arn:aws:iam::123456789012:role/a"}, //lintignore:AWSAT005
+		},
+		{
+			name: "chained roles",
+			input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::123456789012:role/a", "duration_seconds": 0, "duration": ""},                       //lintignore:AWSAT005
+				map[string]interface{}{"role_arn": "arn:aws:iam::210987654321:role/b", "duration_seconds": 0, "duration": "1h"},                     //lintignore:AWSAT005
+				map[string]interface{}{"role_arn": "arn:aws:iam::111122223333:role/c", "duration_seconds": 900, "duration": "", "external_id": "x"}, //lintignore:AWSAT005
+			},
+			expectedARNs: []string{"arn:aws:iam::123456789012:role/a", "arn:aws:iam::210987654321:role/b", "arn:aws:iam::111122223333:role/c"}, //lintignore:AWSAT005
+		},
+		{
+			name: "both durations",
+			input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::123456789012:role/a", "duration_seconds": 900, "duration": "1h"}, //lintignore:AWSAT005
+			},
+			expectedError: true,
+		},
+		{
+			name: "chained role without ARN",
+			input: []interface{}{
+				map[string]interface{}{"role_arn": "arn:aws:iam::123456789012:role/a", "duration_seconds": 0, "duration": ""}, //lintignore:AWSAT005
+				map[string]interface{}{"role_arn": "", "duration_seconds": 0, "duration": ""},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := expandAssumeRoles(ctx, testCase.input)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != len(testCase.expectedARNs) {
+				t.Fatalf("got %d roles, expected %d", len(got), len(testCase.expectedARNs))
+			}
+
+			for i, v := range got {
+				if v.RoleARN != testCase.expectedARNs[i] {
+					t.Errorf("role %d: got %q, expected %q", i, v.RoleARN, testCase.expectedARNs[i])
+				}
+			}
+		})
+	}
+}
+
 func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	oldEnv := stashEnv()
 	defer popEnv(oldEnv)
@@ -50,6 +178,60 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceEndpointOptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tfList := []interface{}{
+		map[string]interface{}{
+			"ecs":                    "",
+			"use_dualstack_endpoint": schema.NewSet(schema.HashString, []interface{}{"ecr", "ssm"}),
+			"use_fips_endpoint":      schema.NewSet(schema.HashString, []interface{}{"ecs", "ssm"}),
+		},
+	}
+
+	results, err := expandServiceEndpointOptions(ctx, tfList)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if a, e := len(results), 3; a != e {
+		t.Fatalf("Expected %d services, got %d", e, a)
+	}
+
+	for _, testcase := range []struct {
+		service           string
+		expectedDualStack bool
+		expectedFIPS      bool
+	}{
+		{names.ECR, true, false},
+		{names.ECS, false, true},
+		{names.SSM, true, true},
+	} {
+		v, ok := results[testcase.service]
+		if !ok {
+			t.Errorf("Expected endpoint options for %s", testcase.service)
+			continue
+		}
+
+		if v.UseDualStackEndpoint != testcase.expectedDualStack {
+			t.Errorf("Expected %s UseDualStackEndpoint to be %t, got %t", testcase.service, testcase.expectedDualStack, v.UseDualStackEndpoint)
+		}
+		if v.UseFIPSEndpoint != testcase.expectedFIPS {
+			t.Errorf("Expected %s UseFIPSEndpoint to be %t, got %t", testcase.service, testcase.expectedFIPS, v.UseFIPSEndpoint)
+		}
+	}
+
+	results, err = expandServiceEndpointOptions(ctx, []interface{}{map[string]interface{}{"ecs": "https://ecs.fake.test"}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if results != nil {
+		t.Errorf("Expected no endpoint options, got %v", results)
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
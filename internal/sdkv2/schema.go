@@ -0,0 +1,57 @@
+// Package sdkv2 contains shared Terraform Plugin SDK v2 schema constructors.
+// Attributes that are common to many resources should be defined here so that
+// their types and validation don't diverge between services.
+package sdkv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ARNSchemaComputed returns the schema of a computed ARN attribute, e.g. a resource's own arn.
+func ARNSchemaComputed() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+// ARNSchemaOptionalComputedForceNew returns the schema of an optional, computed ARN attribute whose change forces a new resource, e.g. a KMS key ARN defaulted by the service.
+func ARNSchemaOptionalComputedForceNew() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: verify.ValidARN,
+	}
+}
+
+// NetworkConfigurationSchema returns the schema of an optional awsvpc network_configuration block, as used by ECS services.
+func NetworkConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrAssignPublicIP: {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				names.AttrSecurityGroups: {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				names.AttrSubnets: {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestARNSchemaOptionalComputedForceNew(t *testing.T) {
+	t.Parallel()
+
+	s := ARNSchemaOptionalComputedForceNew()
+
+	if _, errs := s.ValidateFunc("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", "kms_key_id"); len(errs) > 0 { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("valid ARN: unexpected errors: %v", errs)
+	}
+
+	if _, errs := s.ValidateFunc("1234abcd-12ab-34cd-56ef-1234567890ab", "kms_key_id"); len(errs) == 0 {
+		t.Error("invalid ARN: expected errors")
+	}
+}
+
+func TestNetworkConfigurationSchema(t *testing.T) {
+	t.Parallel()
+
+	s := NetworkConfigurationSchema()
+
+	if err := s.Elem.(*schema.Resource).InternalValidate(nil, true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
@@ -173,7 +173,9 @@ func resourceAPICreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		req.ApiKeySelectionExpression = aws.String(v.(string))
 	}
 	if v, ok := d.GetOk("cors_configuration"); ok {
-		req.CorsConfiguration = expandCORSConfiguration(v.([]interface{}))
+		if err := flex.Expand(v, &req.CorsConfiguration); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating API Gateway v2 API (%s): %s", d.Get("name").(string), err)
+		}
 	}
 	if v, ok := d.GetOk("credentials_arn"); ok {
 		req.CredentialsArn = aws.String(v.(string))
@@ -239,7 +241,11 @@ func resourceAPIRead(ctx context.Context, d *schema.ResourceData, meta interface
 		Resource:  fmt.Sprintf("/apis/%s", d.Id()),
 	}.String()
 	d.Set("arn", apiArn)
-	if err := d.Set("cors_configuration", flattenCORSConfiguration(resp.CorsConfiguration)); err != nil {
+	corsConfiguration, err := flex.Flatten(resp.CorsConfiguration)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway v2 API (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("cors_configuration", corsConfiguration); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting cors_configuration: %s", err)
 	}
 	d.Set("description", resp.Description)
@@ -301,7 +307,9 @@ func resourceAPIUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 			req.ApiKeySelectionExpression = aws.String(d.Get("api_key_selection_expression").(string))
 		}
 		if d.HasChange("cors_configuration") {
-			req.CorsConfiguration = expandCORSConfiguration(d.Get("cors_configuration").([]interface{}))
+			if err := flex.Expand(d.Get("cors_configuration"), &req.CorsConfiguration); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating API Gateway v2 API (%s): %s", d.Id(), err)
+			}
 		}
 		if d.HasChange("description") {
 			req.Description = aws.String(d.Get("description").(string))
@@ -407,7 +415,9 @@ func resourceImportOpenAPI(ctx context.Context, d *schema.ResourceData, meta int
 					return fmt.Errorf("deleting CORS configuration for API Gateway v2 API (%s): %w", d.Id(), err)
 				}
 			} else {
-				inputU.CorsConfiguration = expandCORSConfiguration(corsConfiguration.([]interface{}))
+				if err := flex.Expand(corsConfiguration, &inputU.CorsConfiguration); err != nil {
+					return fmt.Errorf("updating API Gateway v2 API (%s): %w", d.Id(), err)
+				}
 			}
 		}
 
@@ -424,48 +434,3 @@ func resourceImportOpenAPI(ctx context.Context, d *schema.ResourceData, meta int
 
 	return nil
 }
-
-func expandCORSConfiguration(vConfiguration []interface{}) *apigatewayv2.Cors {
-	configuration := &apigatewayv2.Cors{}
-
-	if len(vConfiguration) == 0 || vConfiguration[0] == nil {
-		return configuration
-	}
-	mConfiguration := vConfiguration[0].(map[string]interface{})
-
-	if vAllowCredentials, ok := mConfiguration["allow_credentials"].(bool); ok {
-		configuration.AllowCredentials = aws.Bool(vAllowCredentials)
-	}
-	if vAllowHeaders, ok := mConfiguration["allow_headers"].(*schema.Set); ok {
-		configuration.AllowHeaders = flex.ExpandStringSet(vAllowHeaders)
-	}
-	if vAllowMethods, ok := mConfiguration["allow_methods"].(*schema.Set); ok {
-		configuration.AllowMethods = flex.ExpandStringSet(vAllowMethods)
-	}
-	if vAllowOrigins, ok := mConfiguration["allow_origins"].(*schema.Set); ok {
-		configuration.AllowOrigins = flex.ExpandStringSet(vAllowOrigins)
-	}
-	if vExposeHeaders, ok := mConfiguration["expose_headers"].(*schema.Set); ok {
-		configuration.ExposeHeaders = flex.ExpandStringSet(vExposeHeaders)
-	}
-	if vMaxAge, ok := mConfiguration["max_age"].(int); ok {
-		configuration.MaxAge = aws.Int64(int64(vMaxAge))
-	}
-
-	return configuration
-}
-
-func flattenCORSConfiguration(configuration *apigatewayv2.Cors) []interface{} {
-	if configuration == nil {
-		return []interface{}{}
-	}
-
-	return []interface{}{map[string]interface{}{
-		"allow_credentials": aws.BoolValue(configuration.AllowCredentials),
-		"allow_headers":     flattenCaseInsensitiveStringSet(configuration.AllowHeaders),
-		"allow_methods":     flattenCaseInsensitiveStringSet(configuration.AllowMethods),
-		"allow_origins":     flattenCaseInsensitiveStringSet(configuration.AllowOrigins),
-		"expose_headers":    flattenCaseInsensitiveStringSet(configuration.ExposeHeaders),
-		"max_age":           int(aws.Int64Value(configuration.MaxAge)),
-	}}
-}
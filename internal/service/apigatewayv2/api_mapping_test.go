@@ -10,11 +10,9 @@ import (
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfacm "github.com/hashicorp/terraform-provider-aws/internal/service/acm"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
@@ -24,7 +22,7 @@ func TestAccAPIGatewayV2APIMapping_basic(t *testing.T) {
 	t.Parallel()
 
 	var certificateArn string
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	// Create an ACM certificate to be used by all the tests.
 	// It is created outside the Terraform configurations because deletion
@@ -49,7 +47,7 @@ func TestAccAPIGatewayV2APIMapping_basic(t *testing.T) {
 
 func testAccAPIMapping_createCertificate(t *testing.T, rName string, certificateArn *string) {
 	ctx := acctest.Context(t)
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -75,16 +73,16 @@ func testAccAPIMapping_basic(t *testing.T, rName string, certificateArn *string)
 	domainNameResourceName := "aws_apigatewayv2_domain_name.test"
 	stageResourceName := "aws_apigatewayv2_stage.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckAPIMappingDestroy(ctx),
+		CheckDestroy:             testAccCheckAPIMappingDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAPIMappingConfig_basic(rName, *certificateArn),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAPIMappingExists(ctx, resourceName, &domainName, &v),
+					testAccCheckAPIMappingExists(ctx, t, resourceName, &domainName, &v),
 					resource.TestCheckResourceAttrPair(resourceName, "domain_name", domainNameResourceName, "domain_name"),
 					resource.TestCheckResourceAttrPair(resourceName, "stage", stageResourceName, "name")),
 			},
@@ -104,17 +102,17 @@ func testAccAPIMapping_disappears(t *testing.T, rName string, certificateArn *st
 	var v apigatewayv2.GetApiMappingOutput
 	resourceName := "aws_apigatewayv2_api_mapping.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckAPIMappingDestroy(ctx),
+		CheckDestroy:             testAccCheckAPIMappingDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAPIMappingConfig_basic(rName, *certificateArn),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAPIMappingExists(ctx, resourceName, &domainName, &v),
-					testAccCheckAPIMappingDisappears(ctx, &domainName, &v),
+					testAccCheckAPIMappingExists(ctx, t, resourceName, &domainName, &v),
+					testAccCheckAPIMappingDisappears(ctx, t, &domainName, &v),
 				),
 				ExpectNonEmptyPlan: true,
 			},
@@ -130,16 +128,16 @@ func testAccAPIMapping_key(t *testing.T, rName string, certificateArn *string) {
 	domainNameResourceName := "aws_apigatewayv2_domain_name.test"
 	stageResourceName := "aws_apigatewayv2_stage.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckAPIMappingDestroy(ctx),
+		CheckDestroy:             testAccCheckAPIMappingDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAPIMappingConfig_key(rName, *certificateArn, "$context.domainName"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAPIMappingExists(ctx, resourceName, &domainName, &v),
+					testAccCheckAPIMappingExists(ctx, t, resourceName, &domainName, &v),
 					resource.TestCheckResourceAttr(resourceName, "api_mapping_key", "$context.domainName"),
 					resource.TestCheckResourceAttrPair(resourceName, "domain_name", domainNameResourceName, "domain_name"),
 					resource.TestCheckResourceAttrPair(resourceName, "stage", stageResourceName, "name")),
@@ -147,7 +145,7 @@ func testAccAPIMapping_key(t *testing.T, rName string, certificateArn *string) {
 			{
 				Config: testAccAPIMappingConfig_key(rName, *certificateArn, "$context.apiId"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAPIMappingExists(ctx, resourceName, &domainName, &v),
+					testAccCheckAPIMappingExists(ctx, t, resourceName, &domainName, &v),
 					resource.TestCheckResourceAttr(resourceName, "api_mapping_key", "$context.apiId"),
 					resource.TestCheckResourceAttrPair(resourceName, "domain_name", domainNameResourceName, "domain_name"),
 					resource.TestCheckResourceAttrPair(resourceName, "stage", stageResourceName, "name")),
@@ -167,7 +165,7 @@ func testAccCheckAPIMappingCreateCertificate(ctx context.Context, t *testing.T,
 		privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
 		certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKey, fmt.Sprintf("%s.example.com", rName))
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ACMConn()
+		conn := acctest.ProviderMeta(t).ACMConn()
 
 		output, err := conn.ImportCertificateWithContext(ctx, &acm.ImportCertificateInput{
 			Certificate: []byte(certificate),
@@ -186,9 +184,9 @@ func testAccCheckAPIMappingCreateCertificate(ctx context.Context, t *testing.T,
 	}
 }
 
-func testAccCheckAPIMappingDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckAPIMappingDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn()
+		conn := acctest.ProviderMeta(t).APIGatewayV2Conn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_apigatewayv2_api_mapping" {
@@ -213,9 +211,9 @@ func testAccCheckAPIMappingDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
-func testAccCheckAPIMappingDisappears(ctx context.Context, domainName *string, v *apigatewayv2.GetApiMappingOutput) resource.TestCheckFunc {
+func testAccCheckAPIMappingDisappears(ctx context.Context, t *testing.T, domainName *string, v *apigatewayv2.GetApiMappingOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn()
+		conn := acctest.ProviderMeta(t).APIGatewayV2Conn()
 
 		_, err := conn.DeleteApiMappingWithContext(ctx, &apigatewayv2.DeleteApiMappingInput{
 			ApiMappingId: v.ApiMappingId,
@@ -226,7 +224,7 @@ func testAccCheckAPIMappingDisappears(ctx context.Context, domainName *string, v
 	}
 }
 
-func testAccCheckAPIMappingExists(ctx context.Context, n string, vDomainName *string, v *apigatewayv2.GetApiMappingOutput) resource.TestCheckFunc {
+func testAccCheckAPIMappingExists(ctx context.Context, t *testing.T, n string, vDomainName *string, v *apigatewayv2.GetApiMappingOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -237,7 +235,7 @@ func testAccCheckAPIMappingExists(ctx context.Context, n string, vDomainName *st
 			return fmt.Errorf("No API Gateway v2 API mapping ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn()
+		conn := acctest.ProviderMeta(t).APIGatewayV2Conn()
 
 		domainName := aws.String(rs.Primary.Attributes["domain_name"])
 		resp, err := conn.GetApiMappingWithContext(ctx, &apigatewayv2.GetApiMappingInput{
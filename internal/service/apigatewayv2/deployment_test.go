@@ -8,11 +8,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 func TestAccAPIGatewayV2Deployment_basic(t *testing.T) {
@@ -20,18 +18,18 @@ func TestAccAPIGatewayV2Deployment_basic(t *testing.T) {
 	var apiId string
 	var v apigatewayv2.GetDeploymentOutput
 	resourceName := "aws_apigatewayv2_deployment.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDeploymentConfig_basic(rName, "Test description"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentExists(ctx, resourceName, &apiId, &v),
+					testAccCheckDeploymentExists(ctx, t, resourceName, &apiId, &v),
 					resource.TestCheckResourceAttr(resourceName, "auto_deployed", "false"),
 					resource.TestCheckResourceAttr(resourceName, "description", "Test description"),
 				),
@@ -45,7 +43,7 @@ func TestAccAPIGatewayV2Deployment_basic(t *testing.T) {
 			{
 				Config: testAccDeploymentConfig_basic(rName, "Test description updated"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentExists(ctx, resourceName, &apiId, &v),
+					testAccCheckDeploymentExists(ctx, t, resourceName, &apiId, &v),
 					resource.TestCheckResourceAttr(resourceName, "auto_deployed", "false"),
 					resource.TestCheckResourceAttr(resourceName, "description", "Test description updated"),
 				),
@@ -59,19 +57,19 @@ func TestAccAPIGatewayV2Deployment_disappears(t *testing.T) {
 	var apiId string
 	var v apigatewayv2.GetDeploymentOutput
 	resourceName := "aws_apigatewayv2_deployment.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDeploymentConfig_basic(rName, "Test description"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentExists(ctx, resourceName, &apiId, &v),
-					testAccCheckDeploymentDisappears(ctx, &apiId, &v),
+					testAccCheckDeploymentExists(ctx, t, resourceName, &apiId, &v),
+					testAccCheckDeploymentDisappears(ctx, t, &apiId, &v),
 				),
 				ExpectNonEmptyPlan: true,
 			},
@@ -84,18 +82,18 @@ func TestAccAPIGatewayV2Deployment_triggers(t *testing.T) {
 	var apiId string
 	var deployment1, deployment2, deployment3, deployment4 apigatewayv2.GetDeploymentOutput
 	resourceName := "aws_apigatewayv2_deployment.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, apigatewayv2.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDeploymentConfig_triggers(rName, false),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentExists(ctx, resourceName, &apiId, &deployment1),
+					testAccCheckDeploymentExists(ctx, t, resourceName, &apiId, &deployment1),
 				),
 				// Due to how the Terraform state is handled for resources during creation,
 				// any SHA1 of whole resources will change after first apply, then stabilize.
@@ -104,14 +102,14 @@ func TestAccAPIGatewayV2Deployment_triggers(t *testing.T) {
 			{
 				Config: testAccDeploymentConfig_triggers(rName, false),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentExists(ctx, resourceName, &apiId, &deployment2),
+					testAccCheckDeploymentExists(ctx, t, resourceName, &apiId, &deployment2),
 					testAccCheckDeploymentRecreated(&deployment1, &deployment2),
 				),
 			},
 			{
 				Config: testAccDeploymentConfig_triggers(rName, false),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentExists(ctx, resourceName, &apiId, &deployment3),
+					testAccCheckDeploymentExists(ctx, t, resourceName, &apiId, &deployment3),
 					testAccCheckDeploymentNotRecreated(&deployment2, &deployment3),
 				),
 			},
@@ -125,7 +123,7 @@ func TestAccAPIGatewayV2Deployment_triggers(t *testing.T) {
 			{
 				Config: testAccDeploymentConfig_triggers(rName, true),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentExists(ctx, resourceName, &apiId, &deployment4),
+					testAccCheckDeploymentExists(ctx, t, resourceName, &apiId, &deployment4),
 					testAccCheckDeploymentRecreated(&deployment3, &deployment4),
 				),
 			},
@@ -133,9 +131,9 @@ func TestAccAPIGatewayV2Deployment_triggers(t *testing.T) {
 	})
 }
 
-func testAccCheckDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckDeploymentDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn()
+		conn := acctest.ProviderMeta(t).APIGatewayV2Conn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_apigatewayv2_deployment" {
@@ -160,9 +158,9 @@ func testAccCheckDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
-func testAccCheckDeploymentDisappears(ctx context.Context, apiId *string, v *apigatewayv2.GetDeploymentOutput) resource.TestCheckFunc {
+func testAccCheckDeploymentDisappears(ctx context.Context, t *testing.T, apiId *string, v *apigatewayv2.GetDeploymentOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn()
+		conn := acctest.ProviderMeta(t).APIGatewayV2Conn()
 
 		_, err := conn.DeleteDeploymentWithContext(ctx, &apigatewayv2.DeleteDeploymentInput{
 			ApiId:        apiId,
@@ -173,7 +171,7 @@ func testAccCheckDeploymentDisappears(ctx context.Context, apiId *string, v *api
 	}
 }
 
-func testAccCheckDeploymentExists(ctx context.Context, n string, vApiId *string, v *apigatewayv2.GetDeploymentOutput) resource.TestCheckFunc {
+func testAccCheckDeploymentExists(ctx context.Context, t *testing.T, n string, vApiId *string, v *apigatewayv2.GetDeploymentOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -184,7 +182,7 @@ func testAccCheckDeploymentExists(ctx context.Context, n string, vApiId *string,
 			return fmt.Errorf("No API Gateway v2 deployment ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayV2Conn()
+		conn := acctest.ProviderMeta(t).APIGatewayV2Conn()
 
 		apiId := aws.String(rs.Primary.Attributes["api_id"])
 		resp, err := conn.GetDeploymentWithContext(ctx, &apigatewayv2.GetDeploymentInput{
@@ -0,0 +1,159 @@
+package configservice
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceAggregateDiscoveredResources() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAggregateDiscoveredResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"configuration_aggregator_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 768),
+			},
+			"resource_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(configservice.ResourceType_Values(), false),
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAggregateDiscoveredResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	name := d.Get("configuration_aggregator_name").(string)
+	resourceType := d.Get("resource_type").(string)
+	input := &configservice.ListAggregateDiscoveredResourcesInput{
+		ConfigurationAggregatorName: aws.String(name),
+		ResourceType:                aws.String(resourceType),
+	}
+
+	filters := &configservice.ResourceFilters{}
+	hasFilters := false
+
+	if v, ok := d.GetOk("account_id"); ok {
+		filters.AccountId = aws.String(v.(string))
+		hasFilters = true
+	}
+
+	if v, ok := d.GetOk("region"); ok {
+		filters.Region = aws.String(v.(string))
+		hasFilters = true
+	}
+
+	if v, ok := d.GetOk("resource_id"); ok {
+		filters.ResourceId = aws.String(v.(string))
+		hasFilters = true
+	}
+
+	if v, ok := d.GetOk("resource_name"); ok {
+		filters.ResourceName = aws.String(v.(string))
+		hasFilters = true
+	}
+
+	if hasFilters {
+		input.Filters = filters
+	}
+
+	resources, err := FindAggregateDiscoveredResources(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Config Configuration Aggregator (%s) discovered resources: %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", name, resourceType))
+
+	if err := d.Set("resources", flattenAggregateResourceIdentifiers(resources)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resources: %s", err)
+	}
+
+	return diags
+}
+
+func flattenAggregateResourceIdentifiers(apiObjects []*configservice.AggregateResourceIdentifier) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"key":               aggregateResourceIdentifierKey(apiObject),
+			"resource_id":       aws.StringValue(apiObject.ResourceId),
+			"resource_name":     aws.StringValue(apiObject.ResourceName),
+			"resource_type":     aws.StringValue(apiObject.ResourceType),
+			"source_account_id": aws.StringValue(apiObject.SourceAccountId),
+			"source_region":     aws.StringValue(apiObject.SourceRegion),
+		})
+	}
+
+	return tfList
+}
+
+// aggregateResourceIdentifierKey returns a key that is unique across the aggregator, suitable for use with for_each.
+func aggregateResourceIdentifierKey(apiObject *configservice.AggregateResourceIdentifier) string {
+	return fmt.Sprintf("%s/%s/%s", aws.StringValue(apiObject.SourceAccountId), aws.StringValue(apiObject.SourceRegion), aws.StringValue(apiObject.ResourceId))
+}
@@ -0,0 +1,45 @@
+package configservice_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccConfigServiceAggregateDiscoveredResourcesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_aggregate_discovered_resources.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationAggregatorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAggregateDiscoveredResourcesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "configuration_aggregator_name", "aws_config_configuration_aggregator.test", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_type", "AWS::S3::Bucket"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAggregateDiscoveredResourcesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConfigurationAggregatorConfig_account(rName), `
+data "aws_caller_identity" "current" {}
+
+data "aws_config_aggregate_discovered_resources" "test" {
+  configuration_aggregator_name = aws_config_configuration_aggregator.test.name
+  resource_type                 = "AWS::S3::Bucket"
+  account_id                    = data.aws_caller_identity.current.account_id
+}
+`)
+}
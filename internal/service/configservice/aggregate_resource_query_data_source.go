@@ -0,0 +1,95 @@
+package configservice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceAggregateResourceQuery() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAggregateResourceQueryRead,
+
+		Schema: map[string]*schema.Schema{
+			"configuration_aggregator_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"expression": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"selected_fields": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAggregateResourceQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	name := d.Get("configuration_aggregator_name").(string)
+	input := &configservice.SelectAggregateResourceConfigInput{
+		ConfigurationAggregatorName: aws.String(name),
+		Expression:                  aws.String(d.Get("expression").(string)),
+	}
+	maxResults := d.Get("max_results").(int)
+
+	var results, selectedFields []string
+
+	err := conn.SelectAggregateResourceConfigPagesWithContext(ctx, input, func(page *configservice.SelectAggregateResourceConfigOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		if selectedFields == nil && page.QueryInfo != nil {
+			for _, v := range page.QueryInfo.SelectFields {
+				if v != nil {
+					selectedFields = append(selectedFields, aws.StringValue(v.Name))
+				}
+			}
+		}
+
+		for _, v := range page.Results {
+			if maxResults > 0 && len(results) >= maxResults {
+				return false
+			}
+
+			results = append(results, aws.StringValue(v))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "querying Config Configuration Aggregator (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("results", results)
+	d.Set("selected_fields", selectedFields)
+
+	return diags
+}
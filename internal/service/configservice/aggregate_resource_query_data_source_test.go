@@ -0,0 +1,44 @@
+package configservice_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccConfigServiceAggregateResourceQueryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_aggregate_resource_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationAggregatorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAggregateResourceQueryDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "selected_fields.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "selected_fields.0", "resourceId"),
+					resource.TestCheckResourceAttr(dataSourceName, "selected_fields.1", "resourceType"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "results.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAggregateResourceQueryDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConfigurationAggregatorConfig_account(rName), `
+data "aws_config_aggregate_resource_query" "test" {
+  configuration_aggregator_name = aws_config_configuration_aggregator.test.name
+  expression                    = "SELECT resourceId, resourceType WHERE resourceType = 'AWS::S3::Bucket'"
+  max_results                   = 10
+}
+`)
+}
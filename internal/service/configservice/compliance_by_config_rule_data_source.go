@@ -0,0 +1,139 @@
+package configservice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceComplianceByConfigRule() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceComplianceByConfigRuleRead,
+
+		Schema: map[string]*schema.Schema{
+			"compliance": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compliance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"config_rule_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"non_compliant_resource_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"non_compliant_resource_count_cap_exceeded": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"compliance_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						configservice.ComplianceTypeCompliant,
+						configservice.ComplianceTypeInsufficientData,
+						configservice.ComplianceTypeNonCompliant,
+					}, false),
+				},
+			},
+			"config_rule_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 25,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
+			"non_compliant_config_rule_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceComplianceByConfigRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	input := &configservice.DescribeComplianceByConfigRuleInput{}
+
+	if v, ok := d.GetOk("compliance_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.ComplianceTypes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("config_rule_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.ConfigRuleNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	compliance, err := FindComplianceByConfigRules(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Config Compliance By Config Rule: %s", err)
+	}
+
+	var nonCompliantRuleNames []string
+
+	for _, v := range compliance {
+		if v.Compliance != nil && aws.StringValue(v.Compliance.ComplianceType) == configservice.ComplianceTypeNonCompliant {
+			nonCompliantRuleNames = append(nonCompliantRuleNames, aws.StringValue(v.ConfigRuleName))
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if err := d.Set("compliance", flattenComplianceByConfigRules(compliance)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting compliance: %s", err)
+	}
+
+	d.Set("non_compliant_config_rule_names", nonCompliantRuleNames)
+
+	return diags
+}
+
+func flattenComplianceByConfigRules(apiObjects []*configservice.ComplianceByConfigRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"config_rule_name": aws.StringValue(apiObject.ConfigRuleName),
+		}
+
+		if v := apiObject.Compliance; v != nil {
+			tfMap["compliance_type"] = aws.StringValue(v.ComplianceType)
+
+			if v := v.ComplianceContributorCount; v != nil {
+				tfMap["non_compliant_resource_count"] = aws.Int64Value(v.CappedCount)
+				tfMap["non_compliant_resource_count_cap_exceeded"] = aws.BoolValue(v.CapExceeded)
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
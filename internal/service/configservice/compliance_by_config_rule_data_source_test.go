@@ -0,0 +1,42 @@
+package configservice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccComplianceByConfigRuleDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_compliance_by_config_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComplianceByConfigRuleDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliance.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "non_compliant_config_rule_names.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComplianceByConfigRuleDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConfigRuleConfig_basic(rName), fmt.Sprintf(`
+data "aws_config_compliance_by_config_rule" "test" {
+  config_rule_names = [aws_config_config_rule.test.name]
+  compliance_types  = [%[1]q, %[2]q, %[3]q]
+}
+`, configservice.ComplianceTypeCompliant, configservice.ComplianceTypeInsufficientData, configservice.ComplianceTypeNonCompliant))
+}
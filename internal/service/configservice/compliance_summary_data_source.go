@@ -0,0 +1,162 @@
+package configservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceComplianceSummary() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceComplianceSummaryRead,
+
+		Schema: map[string]*schema.Schema{
+			"compliant_rule_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"compliant_rule_count_cap_exceeded": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"non_compliant_rule_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"non_compliant_rule_count_cap_exceeded": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"resource_type_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"compliant_resource_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"compliant_resource_count_cap_exceeded": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"non_compliant_resource_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"non_compliant_resource_count_cap_exceeded": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"summary_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"resource_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+			},
+			"summary_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComplianceSummaryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	byRule, err := conn.GetComplianceSummaryByConfigRuleWithContext(ctx, &configservice.GetComplianceSummaryByConfigRuleInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Config Compliance Summary by Config Rule: %s", err)
+	}
+
+	input := &configservice.GetComplianceSummaryByResourceTypeInput{}
+
+	if v, ok := d.GetOk("resource_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceTypes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	byResourceType, err := conn.GetComplianceSummaryByResourceTypeWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Config Compliance Summary by Resource Type: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+
+	if summary := byRule.ComplianceSummary; summary != nil {
+		if v := summary.CompliantResourceCount; v != nil {
+			d.Set("compliant_rule_count", v.CappedCount)
+			d.Set("compliant_rule_count_cap_exceeded", v.CapExceeded)
+		}
+		if v := summary.NonCompliantResourceCount; v != nil {
+			d.Set("non_compliant_rule_count", v.CappedCount)
+			d.Set("non_compliant_rule_count_cap_exceeded", v.CapExceeded)
+		}
+		if v := summary.ComplianceSummaryTimestamp; v != nil {
+			d.Set("summary_time", aws.TimeValue(v).Format(time.RFC3339))
+		}
+	}
+
+	if err := d.Set("resource_type_summary", flattenComplianceSummariesByResourceType(byResourceType.ComplianceSummariesByResourceType)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resource_type_summary: %s", err)
+	}
+
+	return diags
+}
+
+func flattenComplianceSummariesByResourceType(apiObjects []*configservice.ComplianceSummaryByResourceType) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"resource_type": aws.StringValue(apiObject.ResourceType),
+		}
+
+		if summary := apiObject.ComplianceSummary; summary != nil {
+			if v := summary.CompliantResourceCount; v != nil {
+				tfMap["compliant_resource_count"] = aws.Int64Value(v.CappedCount)
+				tfMap["compliant_resource_count_cap_exceeded"] = aws.BoolValue(v.CapExceeded)
+			}
+			if v := summary.NonCompliantResourceCount; v != nil {
+				tfMap["non_compliant_resource_count"] = aws.Int64Value(v.CappedCount)
+				tfMap["non_compliant_resource_count_cap_exceeded"] = aws.BoolValue(v.CapExceeded)
+			}
+			if v := summary.ComplianceSummaryTimestamp; v != nil {
+				tfMap["summary_time"] = aws.TimeValue(v).Format(time.RFC3339)
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
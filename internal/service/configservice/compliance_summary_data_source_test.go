@@ -0,0 +1,43 @@
+package configservice_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccComplianceSummaryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_compliance_summary.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComplianceSummaryDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliant_rule_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "non_compliant_rule_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resource_type_summary.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccComplianceSummaryDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConfigRuleConfig_basic(rName), `
+data "aws_config_compliance_summary" "test" {
+  resource_types = ["AWS::S3::Bucket"]
+
+  depends_on = [aws_config_config_rule.test]
+}
+`)
+}
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
-	"time"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
@@ -34,6 +34,12 @@ func ResourceConfigRule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(propagationTimeout),
+			Update: schema.DefaultTimeout(propagationTimeout),
+			Delete: schema.DefaultTimeout(ruleDeletedTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -123,15 +129,10 @@ func ResourceConfigRule() *schema.Resource {
 										),
 									},
 									"policy_text": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringLenBetween(0, 10000),
-										DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool { // policy_text always returns empty
-											if d.Id() != "" && old == "" {
-												return true
-											}
-											return false
-										},
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     validation.StringLenBetween(0, 10000),
+										DiffSuppressFunc: suppressEquivalentPolicyTextDiffs,
 									},
 								},
 							},
@@ -211,7 +212,12 @@ func resourceRulePutConfig(ctx context.Context, d *schema.ResourceData, meta int
 		Tags:       Tags(tags.IgnoreAWS()),
 	}
 	log.Printf("[DEBUG] Creating AWSConfig config rule: %s", input)
-	err := resource.RetryContext(ctx, propagationTimeout, func() *resource.RetryError {
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
 		_, err := conn.PutConfigRuleWithContext(ctx, &input)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, configservice.ErrCodeInsufficientPermissionsException) {
@@ -273,6 +279,19 @@ func resourceConfigRuleRead(ctx context.Context, d *schema.ResourceData, meta in
 		d.Set("scope", flattenRuleScope(rule.Scope))
 	}
 
+	// DescribeConfigRules doesn't return the policy text of custom policy rules.
+	if aws.StringValue(rule.Source.Owner) == configservice.OwnerCustomPolicy && rule.Source.CustomPolicyDetails != nil {
+		policy, err := conn.GetCustomRulePolicyWithContext(ctx, &configservice.GetCustomRulePolicyInput{
+			ConfigRuleName: rule.ConfigRuleName,
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Config Config Rule (%s) policy: %s", d.Id(), err)
+		}
+
+		rule.Source.CustomPolicyDetails.PolicyText = policy.PolicyText
+	}
+
 	d.Set("source", flattenRuleSource(rule.Source))
 
 	tags, err := ListTags(ctx, conn, arn)
@@ -305,7 +324,7 @@ func resourceConfigRuleDelete(ctx context.Context, d *schema.ResourceData, meta
 	input := &configservice.DeleteConfigRuleInput{
 		ConfigRuleName: aws.String(name),
 	}
-	err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, err := conn.DeleteConfigRuleWithContext(ctx, input)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, configservice.ErrCodeResourceInUseException) {
@@ -322,7 +341,7 @@ func resourceConfigRuleDelete(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "Deleting Config Rule failed: %s", err)
 	}
 
-	if _, err := waitRuleDeleted(ctx, conn, d.Id()); err != nil {
+	if _, err := waitRuleDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Config Service Rule (%s) to deleted: %s", d.Id(), err)
 	}
 
@@ -343,3 +362,21 @@ func ruleSourceDetailsHash(v interface{}) int {
 	}
 	return create.StringHashcode(buf.String())
 }
+
+// suppressEquivalentPolicyTextDiffs suppresses differences in Guard policy text that are
+// limited to line endings, indentation, trailing whitespace and blank lines.
+func suppressEquivalentPolicyTextDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return normalizePolicyText(old) == normalizePolicyText(new)
+}
+
+func normalizePolicyText(s string) string {
+	var lines []string
+
+	for _, line := range strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
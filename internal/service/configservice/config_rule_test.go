@@ -16,6 +16,61 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestNormalizePolicyText(t *testing.T) {
+	t.Parallel()
+
+	policy := "rule tableisactive when\n  resourceType == \"AWS::DynamoDB::Table\" {\n  configuration.tableStatus == ['ACTIVE']\n}"
+
+	testCases := []struct {
+		name     string
+		a, b     string
+		expected bool
+	}{
+		{
+			name:     "identical",
+			a:        policy,
+			b:        policy,
+			expected: true,
+		},
+		{
+			name:     "indentation and trailing whitespace",
+			a:        policy,
+			b:        "\t  rule tableisactive when   \n\t\tresourceType == \"AWS::DynamoDB::Table\" {\n\t\tconfiguration.tableStatus == ['ACTIVE']\n\t}\n",
+			expected: true,
+		},
+		{
+			name:     "line endings and blank lines",
+			a:        policy,
+			b:        "\r\nrule tableisactive when\r\n\r\nresourceType == \"AWS::DynamoDB::Table\" {\r\nconfiguration.tableStatus == ['ACTIVE']\r\n}\r\n",
+			expected: true,
+		},
+		{
+			name:     "whitespace within a line",
+			a:        policy,
+			b:        "rule tableisactive when\nresourceType==\"AWS::DynamoDB::Table\" {\nconfiguration.tableStatus == ['ACTIVE']\n}",
+			expected: false,
+		},
+		{
+			name:     "changed value",
+			a:        policy,
+			b:        "rule tableisactive when\nresourceType == \"AWS::DynamoDB::Table\" {\nconfiguration.tableStatus == ['DELETING']\n}",
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfconfig.NormalizePolicyText(testCase.a) == tfconfig.NormalizePolicyText(testCase.b); got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
 func testAccConfigRule_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cr configservice.ConfigRule
@@ -158,6 +213,7 @@ func testAccConfigRule_ownerPolicy(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "source.0.custom_policy_details.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "source.0.custom_policy_details.0.policy_runtime", "guard-2.x.x"),
 					resource.TestCheckResourceAttr(resourceName, "source.0.custom_policy_details.0.enable_debug_log_delivery", "false"),
+					resource.TestMatchResourceAttr(resourceName, "source.0.custom_policy_details.0.policy_text", regexp.MustCompile(`pointInTimeRecoveryStatus == "ENABLED"`)),
 					resource.TestCheckResourceAttr(resourceName, "scope.#", "0"),
 				),
 			},
@@ -166,6 +222,17 @@ func testAccConfigRule_ownerPolicy(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config:   testAccConfigRuleConfig_ownerPolicyReindented(rName),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccConfigRuleConfig_ownerPolicyUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRuleExists(ctx, resourceName, &cr),
+					resource.TestMatchResourceAttr(resourceName, "source.0.custom_policy_details.0.policy_text", regexp.MustCompile(`pointInTimeRecoveryStatus == "DISABLED"`)),
+				),
+			},
 		},
 	})
 }
@@ -503,6 +570,74 @@ EOF
 `, rName)
 }
 
+func testAccConfigRuleConfig_ownerPolicyReindented(rName string) string {
+	return testAccConfigRuleConfig_base(rName) + fmt.Sprintf(`
+resource "aws_config_config_rule" "test" {
+  name = %q
+
+  source {
+    owner = "CUSTOM_POLICY"
+
+    source_detail {
+      message_type = "ConfigurationItemChangeNotification"
+    }
+
+    custom_policy_details {
+      policy_runtime = "guard-2.x.x"
+      policy_text    = <<EOF
+rule tableisactive when
+  resourceType == "AWS::DynamoDB::Table" {
+  configuration.tableStatus == ['ACTIVE']
+}
+
+rule checkcompliance when
+  resourceType == "AWS::DynamoDB::Table"
+  tableisactive {
+    supplementaryConfiguration.ContinuousBackupsDescription.pointInTimeRecoveryDescription.pointInTimeRecoveryStatus == "ENABLED"
+}
+EOF
+    }
+  }
+
+  depends_on = [aws_config_configuration_recorder.test]
+}
+`, rName)
+}
+
+func testAccConfigRuleConfig_ownerPolicyUpdated(rName string) string {
+	return testAccConfigRuleConfig_base(rName) + fmt.Sprintf(`
+resource "aws_config_config_rule" "test" {
+  name = %q
+
+  source {
+    owner = "CUSTOM_POLICY"
+
+    source_detail {
+      message_type = "ConfigurationItemChangeNotification"
+    }
+
+    custom_policy_details {
+      policy_runtime = "guard-2.x.x"
+      policy_text    = <<EOF
+rule tableisactive when
+  resourceType == "AWS::DynamoDB::Table" {
+  configuration.tableStatus == ['ACTIVE']
+}
+
+rule checkcompliance when
+  resourceType == "AWS::DynamoDB::Table"
+  tableisactive {
+    supplementaryConfiguration.ContinuousBackupsDescription.pointInTimeRecoveryDescription.pointInTimeRecoveryStatus == "DISABLED"
+}
+EOF
+    }
+  }
+
+  depends_on = [aws_config_configuration_recorder.test]
+}
+`, rName)
+}
+
 func testAccConfigRuleConfig_customLambda(randInt int, path string) string {
 	return fmt.Sprintf(`
 resource "aws_config_config_rule" "test" {
@@ -0,0 +1,195 @@
+package configservice
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// Rules deployed by an organization config rule are created by this service principal in each member account.
+const organizationConfigRuleCreatedBy = "config-multiaccountsetup.amazonaws.com"
+
+func DataSourceConfigRules() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConfigRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"compliance_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						configservice.ComplianceTypeCompliant,
+						configservice.ComplianceTypeInsufficientData,
+						configservice.ComplianceTypeNonCompliant,
+					}, false),
+				},
+			},
+			"config_rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compliance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"organization_deployed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"service_linked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceConfigRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	rules, err := FindConfigRules(ctx, conn, &configservice.DescribeConfigRulesInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Config Rules: %s", err)
+	}
+
+	input := &configservice.DescribeComplianceByConfigRuleInput{}
+
+	if v, ok := d.GetOk("compliance_types"); ok && v.(*schema.Set).Len() > 0 {
+		input.ComplianceTypes = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	compliance, err := FindComplianceByConfigRules(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Config Compliance By Config Rule: %s", err)
+	}
+
+	complianceTypes := make(map[string]string)
+	for _, v := range compliance {
+		if v.Compliance != nil {
+			complianceTypes[aws.StringValue(v.ConfigRuleName)] = aws.StringValue(v.Compliance.ComplianceType)
+		}
+	}
+
+	namePrefix := d.Get("name_prefix").(string)
+	filterByCompliance := input.ComplianceTypes != nil
+
+	var serviceLinked, organizationDeployed *bool
+	if v := d.GetRawConfig().GetAttr("service_linked"); v.IsKnown() && !v.IsNull() {
+		serviceLinked = aws.Bool(v.True())
+	}
+	if v := d.GetRawConfig().GetAttr("organization_deployed"); v.IsKnown() && !v.IsNull() {
+		organizationDeployed = aws.Bool(v.True())
+	}
+
+	var arns, names []string
+	var tfList []interface{}
+
+	for _, rule := range rules {
+		name := aws.StringValue(rule.ConfigRuleName)
+		createdBy := aws.StringValue(rule.CreatedBy)
+		complianceType, ok := complianceTypes[name]
+
+		if !strings.HasPrefix(name, namePrefix) {
+			continue
+		}
+
+		if filterByCompliance && !ok {
+			continue
+		}
+
+		if serviceLinked != nil && aws.BoolValue(serviceLinked) != (createdBy != "") {
+			continue
+		}
+
+		if organizationDeployed != nil && aws.BoolValue(organizationDeployed) != (createdBy == organizationConfigRuleCreatedBy) {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"arn":             aws.StringValue(rule.ConfigRuleArn),
+			"compliance_type": complianceType,
+			"created_by":      createdBy,
+			"name":            name,
+			"rule_id":         aws.StringValue(rule.ConfigRuleId),
+			"state":           aws.StringValue(rule.ConfigRuleState),
+		}
+
+		if v := rule.Source; v != nil {
+			tfMap["source_identifier"] = aws.StringValue(v.SourceIdentifier)
+			tfMap["source_owner"] = aws.StringValue(v.Owner)
+		}
+
+		arns = append(arns, aws.StringValue(rule.ConfigRuleArn))
+		names = append(names, name)
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	d.Set("names", names)
+
+	if err := d.Set("config_rules", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting config_rules: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,52 @@
+package configservice_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccConfigRulesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_config_rules.test"
+	resourceName := "aws_config_config_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigRulesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "config_rules.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "config_rules.0.rule_id", resourceName, "rule_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "config_rules.0.created_by", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "config_rules.0.source_owner", "AWS"),
+					resource.TestCheckResourceAttr(dataSourceName, "config_rules.0.source_identifier", "S3_BUCKET_VERSIONING_ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigRulesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConfigRuleConfig_basic(rName), fmt.Sprintf(`
+data "aws_config_config_rules" "test" {
+  name_prefix           = %[1]q
+  service_linked        = false
+  organization_deployed = false
+
+  depends_on = [aws_config_config_rule.test]
+}
+`, rName))
+}
@@ -13,7 +13,6 @@ import (
 )
 
 const (
-	conformancePackCreateTimeout = 5 * time.Minute
 	conformancePackDeleteTimeout = 5 * time.Minute
 
 	conformancePackStatusNotFound = "NotFound"
@@ -220,11 +219,9 @@ func getOrganizationConfigRuleDetailedStatus(ctx context.Context, conn *configse
 	return statuses, nil
 }
 
-func getOrganizationConformancePackDetailedStatus(ctx context.Context, conn *configservice.ConfigService, name, status string) ([]*configservice.OrganizationConformancePackDetailedStatus, error) {
+func getOrganizationConformancePackDetailedStatus(ctx context.Context, conn *configservice.ConfigService, name string, filters *configservice.OrganizationResourceDetailedStatusFilters) ([]*configservice.OrganizationConformancePackDetailedStatus, error) {
 	input := &configservice.GetOrganizationConformancePackDetailedStatusInput{
-		Filters: &configservice.OrganizationResourceDetailedStatusFilters{
-			Status: aws.String(status),
-		},
+		Filters:                         filters,
 		OrganizationConformancePackName: aws.String(name),
 	}
 
@@ -364,7 +361,9 @@ func refreshOrganizationConformancePackStatus(ctx context.Context, conn *configs
 }
 
 func organizationConformancePackDetailedStatusError(ctx context.Context, conn *configservice.ConfigService, name, status string) error {
-	memberAccountStatuses, err := getOrganizationConformancePackDetailedStatus(ctx, conn, name, status)
+	memberAccountStatuses, err := getOrganizationConformancePackDetailedStatus(ctx, conn, name, &configservice.OrganizationResourceDetailedStatusFilters{
+		Status: aws.String(status),
+	})
 
 	if err != nil {
 		return fmt.Errorf("unable to get Config Organization Conformance Pack detailed status for showing member account errors: %w", err)
@@ -379,11 +378,11 @@ func organizationConformancePackDetailedStatusError(ctx context.Context, conn *c
 	return fmt.Errorf("Failed in %d account(s):\n\n%s", len(memberAccountStatuses), errBuilder.String())
 }
 
-func waitForConformancePackStateCreateComplete(ctx context.Context, conn *configservice.ConfigService, name string) error {
+func waitForConformancePackStateCreateComplete(ctx context.Context, conn *configservice.ConfigService, name string, timeout time.Duration) error {
 	stateChangeConf := resource.StateChangeConf{
 		Pending: []string{configservice.ConformancePackStateCreateInProgress},
 		Target:  []string{configservice.ConformancePackStateCreateComplete},
-		Timeout: conformancePackCreateTimeout,
+		Timeout: timeout,
 		Refresh: refreshConformancePackStatus(ctx, conn, name),
 	}
 
@@ -396,11 +395,11 @@ func waitForConformancePackStateCreateComplete(ctx context.Context, conn *config
 	return err
 }
 
-func waitForConformancePackStateDeleteComplete(ctx context.Context, conn *configservice.ConfigService, name string) error {
+func waitForConformancePackStateDeleteComplete(ctx context.Context, conn *configservice.ConfigService, name string, timeout time.Duration) error {
 	stateChangeConf := resource.StateChangeConf{
 		Pending: []string{configservice.ConformancePackStateDeleteInProgress},
 		Target:  []string{},
-		Timeout: conformancePackDeleteTimeout,
+		Timeout: timeout,
 		Refresh: refreshConformancePackStatus(ctx, conn, name),
 	}
 
@@ -10,6 +10,15 @@ func TestAccConfigService_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"ComplianceByConfigRuleDataSource": {
+			"basic": testAccComplianceByConfigRuleDataSource_basic,
+		},
+		"ComplianceSummaryDataSource": {
+			"basic": testAccComplianceSummaryDataSource_basic,
+		},
+		"ConfigRulesDataSource": {
+			"basic": testAccConfigRulesDataSource_basic,
+		},
 		"Config": {
 			"basic":            testAccConfigRule_basic,
 			"ownerAws":         testAccConfigRule_ownerAws,
@@ -22,14 +31,18 @@ func TestAccConfigService_serial(t *testing.T) {
 			"disappears":       testAccConfigRule_disappears,
 		},
 		"ConfigurationRecorderStatus": {
-			"basic":        testAccConfigurationRecorderStatus_basic,
-			"startEnabled": testAccConfigurationRecorderStatus_startEnabled,
-			"importBasic":  testAccConfigurationRecorderStatus_importBasic,
+			"basic":            testAccConfigurationRecorderStatus_basic,
+			"startEnabled":     testAccConfigurationRecorderStatus_startEnabled,
+			"importBasic":      testAccConfigurationRecorderStatus_importBasic,
+			"stoppedOutOfBand": testAccConfigurationRecorderStatus_stoppedOutOfBand,
 		},
 		"ConfigurationRecorder": {
-			"basic":       testAccConfigurationRecorder_basic,
-			"allParams":   testAccConfigurationRecorder_allParams,
-			"importBasic": testAccConfigurationRecorder_importBasic,
+			"basic":             testAccConfigurationRecorder_basic,
+			"allParams":         testAccConfigurationRecorder_allParams,
+			"importBasic":       testAccConfigurationRecorder_importBasic,
+			"exclusion":         testAccConfigurationRecorder_exclusionByResourceTypes,
+			"mode":              testAccConfigurationRecorder_recordingMode,
+			"serviceLinkedRole": testAccConfigurationRecorder_serviceLinkedRole,
 		},
 		"ConformancePack": {
 			"basic":                     testAccConformancePack_basic,
@@ -39,10 +52,12 @@ func TestAccConfigService_serial(t *testing.T) {
 			"S3Delivery":                testAccConformancePack_S3Delivery,
 			"S3Template":                testAccConformancePack_S3Template,
 			"S3TemplateAndTemplateBody": testAccConformancePack_S3TemplateAndTemplateBody,
+			"undeclaredInputParameter":  testAccConformancePack_undeclaredInputParameter,
 			"updateInputParameters":     testAccConformancePack_updateInputParameters,
 			"updateS3Delivery":          testAccConformancePack_updateS3Delivery,
 			"updateS3Template":          testAccConformancePack_updateS3Template,
 			"updateTemplateBody":        testAccConformancePack_updateTemplateBody,
+			"waitForCompliance":         testAccConformancePack_waitForCompliance,
 		},
 		"DeliveryChannel": {
 			"basic":       testAccDeliveryChannel_basic,
@@ -62,6 +77,15 @@ func TestAccConfigService_serial(t *testing.T) {
 			"updateS3Template":      testAccOrganizationConformancePack_updateS3Template,
 			"updateTemplateBody":    testAccOrganizationConformancePack_updateTemplateBody,
 		},
+		"OrganizationConformancePackStatusDataSource": {
+			"basic": testAccOrganizationConformancePackStatusDataSource_basic,
+		},
+		"OrganizationCustomPolicyRule": {
+			"basic":            testAccOrganizationCustomPolicyRule_basic,
+			"disappears":       testAccOrganizationCustomPolicyRule_disappears,
+			"DebugLogAccounts": testAccOrganizationCustomPolicyRule_debugLogDeliveryAccounts,
+			"PolicyText":       testAccOrganizationCustomPolicyRule_policyText,
+		},
 		"OrganizationCustomRule": {
 			"basic":                     testAccOrganizationCustomRule_basic,
 			"disappears":                testAccOrganizationCustomRule_disappears,
@@ -84,6 +108,7 @@ func TestAccConfigService_serial(t *testing.T) {
 			"Description":               testAccOrganizationManagedRule_Description,
 			"ExcludedAccounts":          testAccOrganizationManagedRule_ExcludedAccounts,
 			"InputParameters":           testAccOrganizationManagedRule_InputParameters,
+			"InputParametersInvalid":    testAccOrganizationManagedRule_InputParametersInvalid,
 			"MaximumExecutionFrequency": testAccOrganizationManagedRule_MaximumExecutionFrequency,
 			"ResourceIdScope":           testAccOrganizationManagedRule_ResourceIdScope,
 			"ResourceTypesScope":        testAccOrganizationManagedRule_ResourceTypesScope,
@@ -92,12 +117,22 @@ func TestAccConfigService_serial(t *testing.T) {
 			"TagValueScope":             testAccOrganizationManagedRule_TagValueScope,
 		},
 		"RemediationConfiguration": {
-			"basic":         testAccRemediationConfiguration_basic,
-			"basicBackward": testAccRemediationConfiguration_basicBackwardCompatible,
-			"disappears":    testAccRemediationConfiguration_disappears,
-			"recreates":     testAccRemediationConfiguration_recreates,
-			"updates":       testAccRemediationConfiguration_updates,
-			"values":        testAccRemediationConfiguration_values,
+			"basic":             testAccRemediationConfiguration_basic,
+			"basicBackward":     testAccRemediationConfiguration_basicBackwardCompatible,
+			"automaticAttempts": testAccRemediationConfiguration_automaticAttempts,
+			"disappears":        testAccRemediationConfiguration_disappears,
+			"recreates":         testAccRemediationConfiguration_recreates,
+			"updates":           testAccRemediationConfiguration_updates,
+			"values":            testAccRemediationConfiguration_values,
+		},
+		"RemediationException": {
+			"basic":                testAccRemediationException_basic,
+			"disappears":           testAccRemediationException_disappears,
+			"messageAndExpiration": testAccRemediationException_messageAndExpiration,
+		},
+		"RetentionConfiguration": {
+			"basic":      testAccRetentionConfiguration_basic,
+			"disappears": testAccRetentionConfiguration_disappears,
 		},
 	}
 
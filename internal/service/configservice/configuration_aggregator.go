@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -31,6 +34,11 @@ func ResourceConfigurationAggregator() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		CustomizeDiff: customdiff.Sequence(
 			// This is to prevent this error:
 			// All fields are ForceNew or Computed w/out Optional, Update is superfluous
@@ -114,8 +122,49 @@ func ResourceConfigurationAggregator() *schema.Resource {
 					},
 				},
 			},
+			"source_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_error_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_update_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_update_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"wait_for_aggregation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -139,12 +188,32 @@ func resourceConfigurationAggregatorPut(ctx context.Context, d *schema.ResourceD
 		req.OrganizationAggregationSource = expandOrganizationAggregationSource(v.([]interface{})[0].(map[string]interface{}))
 	}
 
-	resp, err := conn.PutConfigurationAggregatorWithContext(ctx, req)
+	// The role of an organization aggregator may not have propagated yet when it's created alongside the aggregator.
+	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout,
+		func() (interface{}, error) {
+			return conn.PutConfigurationAggregatorWithContext(ctx, req)
+		},
+		func(err error) (bool, error) {
+			if req.OrganizationAggregationSource == nil {
+				return false, err
+			}
+
+			if tfawserr.ErrCodeEquals(err, configservice.ErrCodeInvalidRoleException) {
+				return true, err
+			}
+
+			if tfawserr.ErrCodeEquals(err, configservice.ErrCodeInvalidParameterValueException) && strings.Contains(strings.ToLower(err.Error()), "role") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating aggregator: %s", err)
 	}
 
-	configAgg := resp.ConfigurationAggregator
+	configAgg := outputRaw.(*configservice.PutConfigurationAggregatorOutput).ConfigurationAggregator
 	d.SetId(aws.StringValue(configAgg.ConfigurationAggregatorName))
 
 	if !d.IsNewResource() && d.HasChange("tags_all") {
@@ -156,6 +225,25 @@ func resourceConfigurationAggregatorPut(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	if d.Get("wait_for_aggregation").(bool) {
+		action, timeout := create.ErrActionWaitingForCreation, d.Timeout(schema.TimeoutCreate)
+		if !d.IsNewResource() {
+			action, timeout = create.ErrActionWaitingForUpdate, d.Timeout(schema.TimeoutUpdate)
+		}
+
+		statuses, err := waitConfigurationAggregatorSourcesAggregated(ctx, conn, d.Id(), timeout)
+
+		if err != nil {
+			return create.DiagError(names.ConfigService, action, ResNameConfigurationAggregator, d.Id(), err)
+		}
+
+		for _, status := range statuses {
+			if aws.StringValue(status.LastUpdateStatus) == configservice.AggregatedSourceStatusTypeFailed {
+				diags = sdkdiag.AppendWarningf(diags, "Config Configuration Aggregator (%s) source %s (%s) failed to aggregate: %s: %s", d.Id(), aws.StringValue(status.SourceId), aws.StringValue(status.AwsRegion), aws.StringValue(status.LastErrorCode), aws.StringValue(status.LastErrorMessage))
+			}
+		}
+	}
+
 	return append(diags, resourceConfigurationAggregatorRead(ctx, d, meta)...)
 }
 
@@ -203,6 +291,22 @@ func resourceConfigurationAggregatorRead(ctx context.Context, d *schema.Resource
 		return sdkdiag.AppendErrorf(diags, "setting organization_aggregation_source: %s", err)
 	}
 
+	statuses, err := FindConfigurationAggregatorSourcesStatus(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Config Configuration Aggregator (%s) source status: %s", d.Id(), err)
+	}
+
+	if err := d.Set("source_status", flattenAggregatedSourceStatuses(statuses)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source_status: %s", err)
+	}
+
+	if v, ok := d.GetOk("wait_for_aggregation"); ok {
+		d.Set("wait_for_aggregation", v.(bool))
+	} else {
+		d.Set("wait_for_aggregation", false)
+	}
+
 	tags, err := ListTags(ctx, conn, arn)
 
 	if err != nil {
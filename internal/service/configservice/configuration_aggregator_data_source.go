@@ -0,0 +1,159 @@
+package configservice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceConfigurationAggregator() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConfigurationAggregatorRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_aggregation_source": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"all_regions": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"regions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"organization_aggregation_source": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"all_regions": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"regions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"role_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"source_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_error_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_update_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_update_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceConfigurationAggregatorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+	aggregator, err := FindConfigurationAggregatorByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Config Configuration Aggregator (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(aggregator.ConfigurationAggregatorName))
+	arn := aws.StringValue(aggregator.ConfigurationAggregatorArn)
+	d.Set("arn", arn)
+	d.Set("name", aggregator.ConfigurationAggregatorName)
+
+	if err := d.Set("account_aggregation_source", flattenAccountAggregationSources(aggregator.AccountAggregationSources)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting account_aggregation_source: %s", err)
+	}
+
+	if err := d.Set("organization_aggregation_source", flattenOrganizationAggregationSource(aggregator.OrganizationAggregationSource)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting organization_aggregation_source: %s", err)
+	}
+
+	statuses, err := FindConfigurationAggregatorSourcesStatus(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Config Configuration Aggregator (%s) source status: %s", d.Id(), err)
+	}
+
+	if err := d.Set("source_status", flattenAggregatedSourceStatuses(statuses)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source_status: %s", err)
+	}
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Config Configuration Aggregator (%s): %s", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,46 @@
+package configservice_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccConfigServiceConfigurationAggregatorDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_config_configuration_aggregator.test"
+	resourceName := "aws_config_configuration_aggregator.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationAggregatorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationAggregatorDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_aggregation_source.#", resourceName, "account_aggregation_source.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_aggregation_source.0.account_ids.0", resourceName, "account_aggregation_source.0.account_ids.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "organization_aggregation_source.#", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "source_status.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationAggregatorDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConfigurationAggregatorConfig_account(rName), `
+data "aws_config_configuration_aggregator" "test" {
+  name = aws_config_configuration_aggregator.test.name
+}
+`)
+}
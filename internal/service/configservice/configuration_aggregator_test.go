@@ -45,9 +45,10 @@ func TestAccConfigServiceConfigurationAggregator_account(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_status"},
 			},
 		},
 	})
@@ -77,9 +78,10 @@ func TestAccConfigServiceConfigurationAggregator_organization(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_status"},
 			},
 		},
 	})
@@ -146,9 +148,10 @@ func TestAccConfigServiceConfigurationAggregator_tags(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_status"},
 			},
 			{
 				Config: testAccConfigurationAggregatorConfig_tags1(rName, "key2", "value2"),
@@ -163,6 +166,34 @@ func TestAccConfigServiceConfigurationAggregator_tags(t *testing.T) {
 	})
 }
 
+func TestAccConfigServiceConfigurationAggregator_waitForAggregation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ca configservice.ConfigurationAggregator
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_config_configuration_aggregator.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationAggregatorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationAggregatorConfig_waitForAggregation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationAggregatorExists(ctx, resourceName, &ca),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_aggregation", "true"),
+					resource.TestCheckResourceAttr(resourceName, "source_status.#", "1"),
+					acctest.CheckResourceAttrAccountID(resourceName, "source_status.0.source_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_status.0.aws_region", "data.aws_region.current", "name"),
+					resource.TestCheckResourceAttr(resourceName, "source_status.0.source_type", configservice.AggregatedSourceTypeAccount),
+					resource.TestCheckResourceAttrSet(resourceName, "source_status.0.last_update_status"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccConfigServiceConfigurationAggregator_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ca configservice.ConfigurationAggregator
@@ -271,6 +302,24 @@ resource "aws_config_configuration_aggregator" "test" {
 `, rName)
 }
 
+func testAccConfigurationAggregatorConfig_waitForAggregation(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_config_configuration_aggregator" "test" {
+  name                 = %[1]q
+  wait_for_aggregation = true
+
+  account_aggregation_source {
+    account_ids = [data.aws_caller_identity.current.account_id]
+    regions     = [data.aws_region.current.name]
+  }
+}
+`, rName)
+}
+
 func testAccConfigurationAggregatorConfig_organization(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_organization" "test" {
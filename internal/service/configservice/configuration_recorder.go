@@ -3,20 +3,32 @@ package configservice
 import (
 	"context"
 	"errors"
+	"fmt"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/configservice"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/aws/aws-sdk-go-v2/service/configservice/types"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	serviceLinkedRoleName        = "AWSServiceRoleForConfig"
+	serviceLinkedRoleServiceName = "config.amazonaws.com"
+)
+
 func ResourceConfigurationRecorder() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceConfigurationRecorderPut,
@@ -28,6 +40,11 @@ func ResourceConfigurationRecorder() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(propagationTimeout),
+			Update: schema.DefaultTimeout(propagationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -36,9 +53,50 @@ func ResourceConfigurationRecorder() *schema.Resource {
 				Default:      "default",
 				ValidateFunc: validation.StringLenBetween(0, 256),
 			},
+			"recording_mode": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recording_frequency": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          types.RecordingFrequencyContinuous,
+							ValidateDiagFunc: enum.Validate[types.RecordingFrequency](),
+						},
+						"recording_mode_override": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"description": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 256),
+									},
+									"recording_frequency": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[types.RecordingFrequency](),
+									},
+									"resource_types": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"role_arn": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: verify.ValidARN,
 			},
 			"recording_group": {
@@ -53,10 +111,39 @@ func ResourceConfigurationRecorder() *schema.Resource {
 							Optional: true,
 							Default:  true,
 						},
+						"exclusion_by_resource_types": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
 						"include_global_resource_types": {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
+						"recording_strategy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"use_only": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[types.RecordingStrategyType](),
+									},
+								},
+							},
+						},
 						"resource_types": {
 							Type:     schema.TypeSet,
 							Set:      schema.HashString,
@@ -66,28 +153,127 @@ func ResourceConfigurationRecorder() *schema.Resource {
 					},
 				},
 			},
+			"use_service_linked_role": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
+
+		CustomizeDiff: resourceConfigurationRecorderCustomizeDiff,
 	}
 }
 
+func resourceConfigurationRecorderCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := serviceLinkedRoleCustomizeDiff(diff); err != nil {
+		return err
+	}
+
+	v, ok := diff.GetOk("recording_group")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	group := v.([]interface{})[0].(map[string]interface{})
+	allSupported := group["all_supported"].(bool)
+	hasExclusions := len(group["exclusion_by_resource_types"].([]interface{})) > 0
+	hasResourceTypes := group["resource_types"].(*schema.Set).Len() > 0
+
+	var useOnly string
+	if v := group["recording_strategy"].([]interface{}); len(v) > 0 && v[0] != nil && recordingStrategyConfigured(diff.GetRawConfig()) {
+		useOnly = v[0].(map[string]interface{})["use_only"].(string)
+	}
+
+	if allSupported && hasResourceTypes {
+		return errors.New("recording_group: resource_types can only be set when all_supported is false")
+	}
+
+	if allSupported && hasExclusions {
+		return errors.New("recording_group: exclusion_by_resource_types can only be set when all_supported is false")
+	}
+
+	if hasResourceTypes && hasExclusions {
+		return errors.New("recording_group: resource_types and exclusion_by_resource_types cannot both be set")
+	}
+
+	switch useOnly {
+	case string(types.RecordingStrategyTypeAllSupportedResourceTypes):
+		if !allSupported {
+			return fmt.Errorf("recording_group: all_supported must be true when recording_strategy use_only is %s", useOnly)
+		}
+	case string(types.RecordingStrategyTypeInclusionByResourceTypes):
+		if !hasResourceTypes {
+			return fmt.Errorf("recording_group: resource_types must be set when recording_strategy use_only is %s", useOnly)
+		}
+	case string(types.RecordingStrategyTypeExclusionByResourceTypes):
+		if !hasExclusions {
+			return fmt.Errorf("recording_group: exclusion_by_resource_types must be set when recording_strategy use_only is %s", useOnly)
+		}
+	default:
+		if hasExclusions {
+			return fmt.Errorf("recording_group: recording_strategy use_only must be %s when exclusion_by_resource_types is set", types.RecordingStrategyTypeExclusionByResourceTypes)
+		}
+	}
+
+	return nil
+}
+
 func resourceConfigurationRecorderPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+	conn := meta.(*conns.AWSClient).ConfigServiceClient()
 
 	name := d.Get("name").(string)
-	recorder := configservice.ConfigurationRecorder{
+	roleARN := d.Get("role_arn").(string)
+
+	if d.Get("use_service_linked_role").(bool) {
+		v, err := findOrCreateServiceLinkedRole(ctx, meta.(*conns.AWSClient).IAMConn())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IAM Service Linked Role (%s): %s", serviceLinkedRoleServiceName, err)
+		}
+
+		roleARN = v
+	}
+
+	recorder := types.ConfigurationRecorder{
 		Name:    aws.String(name),
-		RoleARN: aws.String(d.Get("role_arn").(string)),
+		RoleARN: aws.String(roleARN),
 	}
 
 	if g, ok := d.GetOk("recording_group"); ok {
 		recorder.RecordingGroup = expandRecordingGroup(g.([]interface{}))
+
+		if !recordingStrategyConfigured(d.GetRawConfig()) {
+			recorder.RecordingGroup.RecordingStrategy = nil
+		}
+	}
+
+	if v, ok := d.GetOk("recording_mode"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		recorder.RecordingMode = expandRecordingMode(v.([]interface{})[0].(map[string]interface{}))
 	}
 
 	input := configservice.PutConfigurationRecorderInput{
 		ConfigurationRecorder: &recorder,
 	}
-	_, err := conn.PutConfigurationRecorderWithContext(ctx, &input)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+
+	// A newly created role may not be assumable by AWS Config yet.
+	_, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.PutConfigurationRecorder(ctx, &input)
+		},
+		func(err error) (bool, error) {
+			if errs.IsA[*types.InsufficientPermissionsException](err) || errs.IsA[*types.InvalidRoleException](err) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "Creating Configuration Recorder failed: %s", err)
 	}
@@ -99,13 +285,13 @@ func resourceConfigurationRecorderPut(ctx context.Context, d *schema.ResourceDat
 
 func resourceConfigurationRecorderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+	conn := meta.(*conns.AWSClient).ConfigServiceClient()
 
 	input := configservice.DescribeConfigurationRecordersInput{
-		ConfigurationRecorderNames: []*string{aws.String(d.Id())},
+		ConfigurationRecorderNames: []string{d.Id()},
 	}
-	out, err := conn.DescribeConfigurationRecordersWithContext(ctx, &input)
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConfigurationRecorderException) {
+	out, err := conn.DescribeConfigurationRecorders(ctx, &input)
+	if !d.IsNewResource() && errs.IsA[*types.NoSuchConfigurationRecorderException](err) {
 		create.LogNotFoundRemoveState(names.ConfigService, create.ErrActionReading, ResNameConfigurationRecorder, d.Id())
 		d.SetId("")
 		return diags
@@ -136,6 +322,10 @@ func resourceConfigurationRecorderRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("name", recorder.Name)
 	d.Set("role_arn", recorder.RoleARN)
 
+	if _, ok := d.GetOk("use_service_linked_role"); !ok {
+		d.Set("use_service_linked_role", false)
+	}
+
 	if recorder.RecordingGroup != nil {
 		flattened := flattenRecordingGroup(recorder.RecordingGroup)
 		err = d.Set("recording_group", flattened)
@@ -144,20 +334,95 @@ func resourceConfigurationRecorderRead(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	if recorder.RecordingMode != nil {
+		if err := d.Set("recording_mode", []interface{}{flattenRecordingMode(recorder.RecordingMode)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "Failed to set recording_mode: %s", err)
+		}
+	} else {
+		d.Set("recording_mode", nil)
+	}
+
 	return diags
 }
 
 func resourceConfigurationRecorderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+	conn := meta.(*conns.AWSClient).ConfigServiceClient()
 	input := configservice.DeleteConfigurationRecorderInput{
 		ConfigurationRecorderName: aws.String(d.Id()),
 	}
-	_, err := conn.DeleteConfigurationRecorderWithContext(ctx, &input)
+	_, err := conn.DeleteConfigurationRecorder(ctx, &input)
 	if err != nil {
-		if !tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConfigurationRecorderException) {
+		if !errs.IsA[*types.NoSuchConfigurationRecorderException](err) {
 			return sdkdiag.AppendErrorf(diags, "Deleting Configuration Recorder failed: %s", err)
 		}
 	}
 	return diags
 }
+
+// serviceLinkedRoleCustomizeDiff requires exactly one of role_arn and use_service_linked_role.
+func serviceLinkedRoleCustomizeDiff(diff *schema.ResourceDiff) error {
+	rawConfig := diff.GetRawConfig()
+
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return nil
+	}
+
+	useServiceLinkedRole := diff.Get("use_service_linked_role").(bool)
+	roleARNConfigured := !rawConfig.GetAttr("role_arn").IsNull()
+
+	if useServiceLinkedRole && roleARNConfigured {
+		return errors.New("role_arn cannot be set when use_service_linked_role is true")
+	}
+
+	if !useServiceLinkedRole && !roleARNConfigured {
+		return errors.New("one of role_arn or use_service_linked_role must be set")
+	}
+
+	if useServiceLinkedRole && diff.HasChange("use_service_linked_role") {
+		return diff.SetNewComputed("role_arn")
+	}
+
+	return nil
+}
+
+// findOrCreateServiceLinkedRole returns the ARN of the AWS Config service-linked role, creating the role if it doesn't exist.
+func findOrCreateServiceLinkedRole(ctx context.Context, conn *iam.IAM) (string, error) {
+	role, err := tfiam.FindRoleByName(ctx, conn, serviceLinkedRoleName)
+
+	if err == nil {
+		return aws.ToString(role.Arn), nil
+	}
+
+	if !tfresource.NotFound(err) {
+		return "", err
+	}
+
+	output, err := conn.CreateServiceLinkedRoleWithContext(ctx, &iam.CreateServiceLinkedRoleInput{
+		AWSServiceName: aws.String(serviceLinkedRoleServiceName),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.Role.Arn), nil
+}
+
+// recordingStrategyConfigured reports whether recording_strategy is set in configuration.
+// The recording strategy is computed, so a value read back from the API must not be validated or sent once it's removed from configuration.
+func recordingStrategyConfigured(rawConfig cty.Value) bool {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return false
+	}
+
+	group := rawConfig.GetAttr("recording_group")
+
+	if !group.IsKnown() || group.IsNull() || group.LengthInt() == 0 {
+		return false
+	}
+
+	strategy := group.Index(cty.NumberIntVal(0)).GetAttr("recording_strategy")
+
+	return strategy.IsKnown() && !strategy.IsNull() && strategy.LengthInt() > 0
+}
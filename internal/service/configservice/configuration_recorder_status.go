@@ -2,17 +2,17 @@ package configservice
 
 import (
 	"context"
-	"errors"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -30,6 +30,11 @@ func ResourceConfigurationRecorderStatus() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -39,6 +44,30 @@ func ResourceConfigurationRecorderStatus() *schema.Resource {
 				Type:     schema.TypeBool,
 				Required: true,
 			},
+			"last_error_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_status_change_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_stop_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -50,6 +79,7 @@ func resourceConfigurationRecorderStatusPut(ctx context.Context, d *schema.Resou
 	name := d.Get("name").(string)
 	d.SetId(name)
 
+	// A recorder stopped outside of Terraform is read as is_enabled = false, so it's started again here on the next apply.
 	if d.HasChange("is_enabled") {
 		isEnabled := d.Get("is_enabled").(bool)
 		if isEnabled {
@@ -71,6 +101,21 @@ func resourceConfigurationRecorderStatusPut(ctx context.Context, d *schema.Resou
 				return sdkdiag.AppendErrorf(diags, "Failed to stop Configuration Recorder: %s", err)
 			}
 		}
+
+		action, timeout := create.ErrActionWaitingForCreation, d.Timeout(schema.TimeoutCreate)
+		if !d.IsNewResource() {
+			action, timeout = create.ErrActionWaitingForUpdate, d.Timeout(schema.TimeoutUpdate)
+		}
+
+		status, err := waitConfigurationRecorderRecording(ctx, conn, name, isEnabled, timeout)
+
+		if err != nil {
+			return create.DiagError(names.ConfigService, action, ResNameConfigurationRecorderStatus, d.Id(), err)
+		}
+
+		if isEnabled && aws.StringValue(status.LastStatus) == configservice.RecorderStatusFailure {
+			diags = sdkdiag.AppendWarningf(diags, "Configuration Recorder (%s) is recording, but its last delivery failed: %s: %s", name, aws.StringValue(status.LastErrorCode), aws.StringValue(status.LastErrorMessage))
+		}
 	}
 
 	return append(diags, resourceConfigurationRecorderStatusRead(ctx, d, meta)...)
@@ -80,12 +125,9 @@ func resourceConfigurationRecorderStatusRead(ctx context.Context, d *schema.Reso
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ConfigServiceConn()
 
-	name := d.Id()
-	statusInput := configservice.DescribeConfigurationRecorderStatusInput{
-		ConfigurationRecorderNames: []*string{aws.String(name)},
-	}
-	statusOut, err := conn.DescribeConfigurationRecorderStatusWithContext(ctx, &statusInput)
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConfigurationRecorderException) {
+	status, err := FindConfigurationRecorderStatusByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		create.LogNotFoundRemoveState(names.ConfigService, create.ErrActionReading, ResNameConfigurationRecorderStatus, d.Id())
 		d.SetId("")
 		return diags
@@ -95,23 +137,13 @@ func resourceConfigurationRecorderStatusRead(ctx context.Context, d *schema.Reso
 		return create.DiagError(names.ConfigService, create.ErrActionReading, ResNameConfigurationRecorderStatus, d.Id(), err)
 	}
 
-	numberOfStatuses := len(statusOut.ConfigurationRecordersStatus)
-	if !d.IsNewResource() && numberOfStatuses < 1 {
-		create.LogNotFoundRemoveState(names.ConfigService, create.ErrActionReading, ResNameConfigurationRecorderStatus, d.Id())
-		d.SetId("")
-		return diags
-	}
-
-	if d.IsNewResource() && numberOfStatuses < 1 {
-		return create.DiagError(names.ConfigService, create.ErrActionReading, ResNameConfigurationRecorderStatus, d.Id(), errors.New("not found after creation"))
-	}
-
-	if numberOfStatuses > 1 {
-		return sdkdiag.AppendErrorf(diags, "Expected exactly 1 Configuration Recorder (status), received %d: %#v",
-			numberOfStatuses, statusOut.ConfigurationRecordersStatus)
-	}
-
-	d.Set("is_enabled", statusOut.ConfigurationRecordersStatus[0].Recording)
+	d.Set("is_enabled", status.Recording)
+	d.Set("last_error_code", status.LastErrorCode)
+	d.Set("last_error_message", status.LastErrorMessage)
+	d.Set("last_start_time", flattenRecorderStatusTime(status.LastStartTime))
+	d.Set("last_status", status.LastStatus)
+	d.Set("last_status_change_time", flattenRecorderStatusTime(status.LastStatusChangeTime))
+	d.Set("last_stop_time", flattenRecorderStatusTime(status.LastStopTime))
 
 	return diags
 }
@@ -129,3 +161,11 @@ func resourceConfigurationRecorderStatusDelete(ctx context.Context, d *schema.Re
 
 	return diags
 }
+
+func flattenRecorderStatusTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return aws.TimeValue(t).Format(time.RFC3339)
+}
@@ -62,6 +62,7 @@ func testAccConfigurationRecorderStatus_startEnabled(t *testing.T) {
 					testAccCheckConfigurationRecorderStatus("aws_config_configuration_recorder_status.foo", true, &crs),
 					resource.TestCheckResourceAttr("aws_config_configuration_recorder_status.foo", "is_enabled", "true"),
 					resource.TestCheckResourceAttr("aws_config_configuration_recorder_status.foo", "name", expectedName),
+					resource.TestCheckResourceAttrSet("aws_config_configuration_recorder_status.foo", "last_start_time"),
 				),
 			},
 			{
@@ -107,6 +108,41 @@ func testAccConfigurationRecorderStatus_importBasic(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				// Delivery can update these between the apply and the import.
+				ImportStateVerifyIgnore: []string{"last_error_code", "last_error_message", "last_status", "last_status_change_time"},
+			},
+		},
+	})
+}
+
+func testAccConfigurationRecorderStatus_stoppedOutOfBand(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cr configservice.ConfigurationRecorder
+	var crs configservice.ConfigurationRecorderStatus
+	resourceName := "aws_config_configuration_recorder_status.foo"
+	rInt := sdkacctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationRecorderStatusDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationRecorderStatusConfig_basic(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationRecorderExists(ctx, "aws_config_configuration_recorder.foo", &cr),
+					testAccCheckConfigurationRecorderStatusStop(ctx, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccConfigurationRecorderStatusConfig_basic(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationRecorderStatusExists(ctx, resourceName, &crs),
+					testAccCheckConfigurationRecorderStatus(resourceName, true, &crs),
+					resource.TestCheckResourceAttr(resourceName, "is_enabled", "true"),
+				),
 			},
 		},
 	})
@@ -137,6 +173,22 @@ func testAccCheckConfigurationRecorderStatusExists(ctx context.Context, n string
 	}
 }
 
+func testAccCheckConfigurationRecorderStatusStop(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn()
+		_, err := conn.StopConfigurationRecorderWithContext(ctx, &configservice.StopConfigurationRecorderInput{
+			ConfigurationRecorderName: aws.String(rs.Primary.Attributes["name"]),
+		})
+
+		return err
+	}
+}
+
 func testAccCheckConfigurationRecorderStatus(n string, desired bool, obj *configservice.ConfigurationRecorderStatus) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[n]
@@ -3,6 +3,7 @@ package configservice_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -42,6 +43,36 @@ func testAccConfigurationRecorder_basic(t *testing.T) {
 	})
 }
 
+func testAccConfigurationRecorder_serviceLinkedRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cr configservice.ConfigurationRecorder
+	rInt := sdkacctest.RandInt()
+	resourceName := "aws_config_configuration_recorder.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationRecorderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationRecorderConfig_serviceLinkedRole(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationRecorderExists(ctx, resourceName, &cr),
+					acctest.CheckResourceAttrGlobalARN(resourceName, "role_arn", "iam", "role/aws-service-role/config.amazonaws.com/AWSServiceRoleForConfig"),
+					resource.TestCheckResourceAttr(resourceName, "use_service_linked_role", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"use_service_linked_role"},
+			},
+		},
+	})
+}
+
 func testAccConfigurationRecorder_allParams(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cr configservice.ConfigurationRecorder
@@ -98,6 +129,96 @@ func testAccConfigurationRecorder_importBasic(t *testing.T) {
 	})
 }
 
+func testAccConfigurationRecorder_exclusionByResourceTypes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cr configservice.ConfigurationRecorder
+	rInt := sdkacctest.RandInt()
+	resourceName := "aws_config_configuration_recorder.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationRecorderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigurationRecorderConfig_exclusionByResourceTypes(rInt, "ALL_SUPPORTED_RESOURCE_TYPES"),
+				ExpectError: regexp.MustCompile(`all_supported must be true`),
+			},
+			{
+				Config: testAccConfigurationRecorderConfig_allParams(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationRecorderExists(ctx, resourceName, &cr),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.exclusion_by_resource_types.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.resource_types.#", "2"),
+				),
+			},
+			{
+				Config: testAccConfigurationRecorderConfig_exclusionByResourceTypes(rInt, "EXCLUSION_BY_RESOURCE_TYPES"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationRecorderExists(ctx, resourceName, &cr),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.all_supported", "false"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.exclusion_by_resource_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.exclusion_by_resource_types.0.resource_types.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.recording_strategy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.recording_strategy.0.use_only", "EXCLUSION_BY_RESOURCE_TYPES"),
+					resource.TestCheckResourceAttr(resourceName, "recording_group.0.resource_types.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccConfigurationRecorder_recordingMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cr configservice.ConfigurationRecorder
+	rInt := sdkacctest.RandInt()
+	resourceName := "aws_config_configuration_recorder.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationRecorderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationRecorderConfig_recordingMode(rInt, "DAILY", "CONTINUOUS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationRecorderExists(ctx, resourceName, &cr),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.0.recording_frequency", "DAILY"),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.0.recording_mode_override.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.0.recording_mode_override.0.description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.0.recording_mode_override.0.recording_frequency", "CONTINUOUS"),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.0.recording_mode_override.0.resource_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "recording_mode.0.recording_mode_override.0.resource_types.*", "AWS::EC2::Instance"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigurationRecorderConfig_recordingMode(rInt, "CONTINUOUS", "DAILY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationRecorderExists(ctx, resourceName, &cr),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.0.recording_frequency", "CONTINUOUS"),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.0.recording_mode_override.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recording_mode.0.recording_mode_override.0.recording_frequency", "DAILY"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckConfigurationRecorderName(n string, desired string, obj *configservice.ConfigurationRecorder) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[n]
@@ -231,6 +352,15 @@ resource "aws_config_delivery_channel" "foo" {
 `, randInt, randInt, randInt, randInt, randInt)
 }
 
+func testAccConfigurationRecorderConfig_serviceLinkedRole(randInt int) string {
+	return fmt.Sprintf(`
+resource "aws_config_configuration_recorder" "foo" {
+  name                    = "tf-acc-test-%d"
+  use_service_linked_role = true
+}
+`, randInt)
+}
+
 func testAccConfigurationRecorderConfig_allParams(randInt int) string {
 	return fmt.Sprintf(`
 resource "aws_config_configuration_recorder" "foo" {
@@ -299,3 +429,151 @@ resource "aws_config_delivery_channel" "foo" {
 }
 `, randInt, randInt, randInt, randInt, randInt)
 }
+
+func testAccConfigurationRecorderConfig_exclusionByResourceTypes(randInt int, useOnly string) string {
+	return fmt.Sprintf(`
+resource "aws_config_configuration_recorder" "foo" {
+  name     = "tf-acc-test-%[1]d"
+  role_arn = aws_iam_role.r.arn
+
+  recording_group {
+    all_supported = false
+
+    exclusion_by_resource_types {
+      resource_types = ["AWS::EC2::Instance", "AWS::CloudTrail::Trail"]
+    }
+
+    recording_strategy {
+      use_only = %[2]q
+    }
+  }
+}
+
+resource "aws_iam_role" "r" {
+  name = "tf-acc-test-awsconfig-%[1]d"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "config.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "p" {
+  name = "tf-acc-test-awsconfig-%[1]d"
+  role = aws_iam_role.r.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "s3:*"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "${aws_s3_bucket.b.arn}",
+        "${aws_s3_bucket.b.arn}/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_s3_bucket" "b" {
+  bucket        = "tf-acc-test-awsconfig-%[1]d"
+  force_destroy = true
+}
+
+resource "aws_config_delivery_channel" "foo" {
+  name           = "tf-acc-test-awsconfig-%[1]d"
+  s3_bucket_name = aws_s3_bucket.b.bucket
+  depends_on     = [aws_config_configuration_recorder.foo]
+}
+`, randInt, useOnly)
+}
+
+func testAccConfigurationRecorderConfig_recordingMode(randInt int, recordingFrequency, overrideRecordingFrequency string) string {
+	return fmt.Sprintf(`
+resource "aws_config_configuration_recorder" "foo" {
+  name     = "tf-acc-test-%[1]d"
+  role_arn = aws_iam_role.r.arn
+
+  recording_mode {
+    recording_frequency = %[2]q
+
+    recording_mode_override {
+      description         = "test"
+      recording_frequency = %[3]q
+      resource_types      = ["AWS::EC2::Instance"]
+    }
+  }
+}
+
+resource "aws_iam_role" "r" {
+  name = "tf-acc-test-awsconfig-%[1]d"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "config.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy" "p" {
+  name = "tf-acc-test-awsconfig-%[1]d"
+  role = aws_iam_role.r.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "s3:*"
+      ],
+      "Effect": "Allow",
+      "Resource": [
+        "${aws_s3_bucket.b.arn}",
+        "${aws_s3_bucket.b.arn}/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_s3_bucket" "b" {
+  bucket        = "tf-acc-test-awsconfig-%[1]d"
+  force_destroy = true
+}
+
+resource "aws_config_delivery_channel" "foo" {
+  name           = "tf-acc-test-awsconfig-%[1]d"
+  s3_bucket_name = aws_s3_bucket.b.bucket
+  depends_on     = [aws_config_configuration_recorder.foo]
+}
+`, randInt, recordingFrequency, overrideRecordingFrequency)
+}
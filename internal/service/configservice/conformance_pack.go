@@ -2,8 +2,10 @@ package configservice
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
@@ -13,9 +15,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"gopkg.in/yaml.v2"
 )
 
 func ResourceConformancePack() *schema.Resource {
@@ -29,11 +34,24 @@ func ResourceConformancePack() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(conformancePackDeleteTimeout),
+		},
+
+		CustomizeDiff: resourceConformancePackCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"config_rule_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"delivery_s3_bucket": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -91,6 +109,11 @@ func ResourceConformancePack() *schema.Resource {
 				),
 				AtLeastOneOf: []string{"template_s3_uri", "template_body"},
 			},
+			"wait_for_compliance": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -132,10 +155,29 @@ func resourceConformancePackPut(ctx context.Context, d *schema.ResourceData, met
 
 	d.SetId(name)
 
-	if err := waitForConformancePackStateCreateComplete(ctx, conn, d.Id()); err != nil {
+	action, timeout := create.ErrActionWaitingForCreation, d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		action, timeout = create.ErrActionWaitingForUpdate, d.Timeout(schema.TimeoutUpdate)
+	}
+
+	if err := waitForConformancePackStateCreateComplete(ctx, conn, d.Id(), timeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Config Conformance Pack (%s) to be created: %s", d.Id(), err)
 	}
 
+	if d.Get("wait_for_compliance").(bool) {
+		statuses, err := waitConformancePackRulesEvaluated(ctx, conn, d.Id(), timeout)
+
+		if err != nil {
+			return create.DiagError(names.ConfigService, action, ResNameConformancePack, d.Id(), err)
+		}
+
+		for _, v := range statuses {
+			if v.LastFailedEvaluationTime != nil && v.LastSuccessfulEvaluationTime == nil {
+				diags = sdkdiag.AppendWarningf(diags, "Config Rule (%s) of Config Conformance Pack (%s) failed evaluation: %s: %s", aws.StringValue(v.ConfigRuleName), d.Id(), aws.StringValue(v.LastErrorCode), aws.StringValue(v.LastErrorMessage))
+			}
+		}
+	}
+
 	return append(diags, resourceConformancePackRead(ctx, d, meta)...)
 }
 
@@ -174,6 +216,18 @@ func resourceConformancePackRead(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "setting input_parameter: %s", err)
 	}
 
+	ruleNames, err := FindConformancePackRuleNames(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Config Conformance Pack (%s) rule names: %s", d.Id(), err)
+	}
+
+	d.Set("config_rule_names", ruleNames)
+
+	if _, ok := d.GetOk("wait_for_compliance"); !ok {
+		d.Set("wait_for_compliance", false)
+	}
+
 	return diags
 }
 
@@ -185,7 +239,7 @@ func resourceConformancePackDelete(ctx context.Context, d *schema.ResourceData,
 		ConformancePackName: aws.String(d.Id()),
 	}
 
-	err := resource.RetryContext(ctx, conformancePackDeleteTimeout, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, err := conn.DeleteConformancePackWithContext(ctx, input)
 
 		if err != nil {
@@ -211,13 +265,73 @@ func resourceConformancePackDelete(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "erorr deleting Config Conformance Pack (%s): %s", d.Id(), err)
 	}
 
-	if err := waitForConformancePackStateDeleteComplete(ctx, conn, d.Id()); err != nil {
+	if err := waitForConformancePackStateDeleteComplete(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Config Conformance Pack (%s) to be deleted: %s", d.Id(), err)
 	}
 
 	return diags
 }
 
+// resourceConformancePackCustomizeDiff checks that every input parameter is declared in the template body.
+// Templates read from S3 can't be checked at plan time, and AWS Config ignores template_body when template_s3_uri is set.
+func resourceConformancePackCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("template_body") || !diff.NewValueKnown("input_parameter") || !diff.NewValueKnown("template_s3_uri") {
+		return nil
+	}
+
+	if diff.Get("template_s3_uri").(string) != "" {
+		return nil
+	}
+
+	body := diff.Get("template_body").(string)
+	if body == "" {
+		return nil
+	}
+
+	declared, err := conformancePackTemplateParameterNames(body)
+
+	if err != nil {
+		// Invalid templates are reported by the template_body validation.
+		return nil
+	}
+
+	for _, v := range diff.Get("input_parameter").(*schema.Set).List() {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap["parameter_name"].(string)
+		if name == "" {
+			continue
+		}
+
+		if _, ok := declared[name]; !ok {
+			return fmt.Errorf("input_parameter %q is not declared in the Parameters section of template_body", name)
+		}
+	}
+
+	return nil
+}
+
+func conformancePackTemplateParameterNames(body string) (map[string]struct{}, error) {
+	// JSON templates are valid YAML.
+	var template struct {
+		Parameters map[string]interface{} `yaml:"Parameters"`
+	}
+
+	if err := yaml.Unmarshal([]byte(body), &template); err != nil {
+		return nil, err
+	}
+
+	parameterNames := make(map[string]struct{}, len(template.Parameters))
+	for k := range template.Parameters {
+		parameterNames[k] = struct{}{}
+	}
+
+	return parameterNames, nil
+}
+
 func expandConfigConformancePackInputParameters(l []interface{}) []*configservice.ConformancePackInputParameter {
 	if len(l) == 0 || l[0] == nil {
 		return nil
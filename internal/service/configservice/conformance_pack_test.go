@@ -3,6 +3,7 @@ package configservice_test
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"regexp"
 	"testing"
 
@@ -17,6 +18,80 @@ import (
 	tfconfig "github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
 )
 
+func TestConformancePackTemplateParameterNames(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		body     string
+		expected map[string]struct{}
+		wantErr  bool
+	}{
+		{
+			name:    "invalid",
+			body:    `{`,
+			wantErr: true,
+		},
+		{
+			name:     "no parameters",
+			body:     "Resources: {}",
+			expected: map[string]struct{}{},
+		},
+		{
+			name: "YAML",
+			body: `
+Parameters:
+  MaxPasswordAge:
+    Type: String
+  MinimumPasswordLength:
+    Default: "14"
+    Type: String
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      InputParameters:
+        MaxPasswordAge: !Ref MaxPasswordAge
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+`,
+			expected: map[string]struct{}{"MaxPasswordAge": {}, "MinimumPasswordLength": {}},
+		},
+		{
+			name:     "JSON",
+			body:     `{"Parameters": {"MaxPasswordAge": {"Type": "String"}}, "Resources": {}}`,
+			expected: map[string]struct{}{"MaxPasswordAge": {}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfconfig.ConformancePackTemplateParameterNames(testCase.body)
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %v, expected %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
 func testAccConformancePack_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var pack configservice.ConformancePackDetail
@@ -38,6 +113,8 @@ func testAccConformancePack_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "delivery_s3_bucket", ""),
 					resource.TestCheckResourceAttr(resourceName, "delivery_s3_key_prefix", ""),
 					resource.TestCheckResourceAttr(resourceName, "input_parameter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "config_rule_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_compliance", "false"),
 				),
 			},
 			{
@@ -52,6 +129,48 @@ func testAccConformancePack_basic(t *testing.T) {
 	})
 }
 
+func testAccConformancePack_waitForCompliance(t *testing.T) {
+	ctx := acctest.Context(t)
+	var pack configservice.ConformancePackDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_config_conformance_pack.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConformancePackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConformancePackConfig_waitForCompliance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConformancePackExists(ctx, resourceName, &pack),
+					testAccCheckConformancePackRulesEvaluated(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config_rule_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_compliance", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConformancePack_undeclaredInputParameter(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConformancePackConfig_undeclaredInputParameter(rName),
+				ExpectError: regexp.MustCompile(`input_parameter "Undeclared" is not declared`),
+			},
+		},
+	})
+}
+
 func testAccConformancePack_forceNew(t *testing.T) {
 	ctx := acctest.Context(t)
 	var before, after configservice.ConformancePackDetail
@@ -482,6 +601,37 @@ func testAccCheckConformancePackExists(ctx context.Context, resourceName string,
 	}
 }
 
+func testAccCheckConformancePackRulesEvaluated(ctx context.Context, resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn()
+
+		ruleNames, err := tfconfig.FindConformancePackRuleNames(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		statuses, err := tfconfig.FindConfigRuleEvaluationStatuses(ctx, conn, ruleNames)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range statuses {
+			if v.LastSuccessfulEvaluationTime == nil && v.LastFailedEvaluationTime == nil {
+				return fmt.Errorf("Config Rule (%s) has not been evaluated", aws.StringValue(v.ConfigRuleName))
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckConformancePackRecreated(before, after *configservice.ConformancePackDetail) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if aws.StringValue(before.ConformancePackArn) == aws.StringValue(after.ConformancePackArn) {
@@ -548,6 +698,56 @@ EOT
 `, rName))
 }
 
+func testAccConformancePackConfig_waitForCompliance(rName string) string {
+	return acctest.ConfigCompose(testAccConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_config_conformance_pack" "test" {
+  depends_on          = [aws_config_configuration_recorder.test]
+  name                = %q
+  wait_for_compliance = true
+  template_body       = <<EOT
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName))
+}
+
+func testAccConformancePackConfig_undeclaredInputParameter(rName string) string {
+	return acctest.ConfigCompose(testAccConformancePackConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_config_conformance_pack" "test" {
+  depends_on = [aws_config_configuration_recorder.test]
+  name       = %q
+
+  input_parameter {
+    parameter_name  = "Undeclared"
+    parameter_value = "TestValue"
+  }
+
+  template_body = <<EOT
+Parameters:
+  Declared:
+    Type: String
+Resources:
+  IAMPasswordPolicy:
+    Properties:
+      ConfigRuleName: IAMPasswordPolicy
+      Source:
+        Owner: AWS
+        SourceIdentifier: IAM_PASSWORD_POLICY
+    Type: AWS::Config::ConfigRule
+EOT
+}
+`, rName))
+}
+
 func testAccConformancePackConfig_update(rName string) string {
 	return acctest.ConfigCompose(testAccConformancePackConfigBase(rName),
 		fmt.Sprintf(`
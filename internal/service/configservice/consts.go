@@ -9,12 +9,17 @@ const (
 )
 
 const (
-	ResNameAggregateAuthorization      = "Aggregate Authorization"
-	ResNameConfigurationAggregator     = "Configuration Aggregator"
-	ResNameConfigurationRecorderStatus = "Configuration Recorder Status"
-	ResNameConfigurationRecorder       = "Configuration Recorder"
-	ResNameDeliveryChannel             = "Delivery Channel"
-	ResNameOrganizationManagedRule     = "Organization Managed Rule"
-	ResNameOrganizationCustomRule      = "Organization Custom Rule"
-	ResNameRemediationConfiguration    = "Remediation Configuration"
+	ResNameAggregateAuthorization       = "Aggregate Authorization"
+	ResNameConfigurationAggregator      = "Configuration Aggregator"
+	ResNameConfigurationRecorderStatus  = "Configuration Recorder Status"
+	ResNameConfigurationRecorder        = "Configuration Recorder"
+	ResNameConformancePack              = "Conformance Pack"
+	ResNameDeliveryChannel              = "Delivery Channel"
+	ResNameOrganizationManagedRule      = "Organization Managed Rule"
+	ResNameOrganizationCustomRule       = "Organization Custom Rule"
+	ResNameOrganizationCustomPolicyRule = "Organization Custom Policy Rule"
+	ResNameRemediationConfiguration     = "Remediation Configuration"
+	ResNameRemediationException         = "Remediation Exception"
+	ResNameRetentionConfiguration       = "Retention Configuration"
+	ResNameStoredQuery                  = "Stored Query"
 )
@@ -2,11 +2,16 @@ package configservice
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -31,6 +36,12 @@ func ResourceDeliveryChannel() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(propagationTimeout),
+			Update: schema.DefaultTimeout(propagationTimeout),
+			Delete: schema.DefaultTimeout(30 * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -108,13 +119,18 @@ func resourceDeliveryChannelPut(ctx context.Context, d *schema.ResourceData, met
 
 	input := configservice.PutDeliveryChannelInput{DeliveryChannel: &channel}
 
-	err := resource.RetryContext(ctx, propagationTimeout, func() *resource.RetryError {
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
 		_, err := conn.PutDeliveryChannelWithContext(ctx, &input)
 		if err == nil {
 			return nil
 		}
 
-		if tfawserr.ErrCodeEquals(err, "InsufficientDeliveryPolicyException") {
+		if tfawserr.ErrCodeEquals(err, configservice.ErrCodeInsufficientDeliveryPolicyException) {
 			return resource.RetryableError(err)
 		}
 
@@ -123,6 +139,14 @@ func resourceDeliveryChannelPut(ctx context.Context, d *schema.ResourceData, met
 	if tfresource.TimedOut(err) {
 		_, err = conn.PutDeliveryChannelWithContext(ctx, &input)
 	}
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeInsufficientDeliveryPolicyException) {
+		bucket := d.Get("s3_bucket_name").(string)
+		principal := meta.(*conns.AWSClient).PartitionHostname("config")
+
+		if hint := deliveryChannelBucketPolicyHint(ctx, meta.(*conns.AWSClient).S3Conn(), bucket, principal); hint != "" {
+			return sdkdiag.AppendErrorf(diags, "Creating Delivery Channel failed: %s\n\n%s", err, hint)
+		}
+	}
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "Creating Delivery Channel failed: %s", err)
 	}
@@ -188,7 +212,7 @@ func resourceDeliveryChannelDelete(ctx context.Context, d *schema.ResourceData,
 		DeliveryChannelName: aws.String(d.Id()),
 	}
 
-	err := resource.RetryContext(ctx, 30*time.Second, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		_, err := conn.DeleteDeliveryChannelWithContext(ctx, &input)
 		if err != nil {
 			if tfawserr.ErrMessageContains(err, configservice.ErrCodeLastDeliveryChannelDeleteFailedException, "there is a running configuration recorder") {
@@ -208,3 +232,114 @@ func resourceDeliveryChannelDelete(ctx context.Context, d *schema.ResourceData,
 
 	return diags
 }
+
+// deliveryChannelBucketPolicyActions are the actions AWS Config needs on the delivery bucket.
+var deliveryChannelBucketPolicyActions = []string{"s3:GetBucketAcl", "s3:PutObject"}
+
+// deliveryChannelBucketPolicyHint explains an InsufficientDeliveryPolicyException by inspecting the delivery bucket's policy.
+// Buckets in the same account may instead grant access through the recorder's IAM role, so this is only used once delivery has failed.
+func deliveryChannelBucketPolicyHint(ctx context.Context, conn *s3.S3, bucket, principal string) string {
+	output, err := conn.GetBucketPolicyWithContext(ctx, &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	})
+
+	if tfawserr.ErrCodeEquals(err, "NoSuchBucketPolicy") {
+		return fmt.Sprintf("S3 bucket (%s) has no bucket policy. Allow %s to %s on the bucket, or allow the configuration recorder's IAM role to do so.", bucket, principal, strings.Join(deliveryChannelBucketPolicyActions, " and "))
+	}
+
+	if err != nil {
+		return fmt.Sprintf("Unable to read the policy of S3 bucket (%s) to check access for %s: %s", bucket, principal, err)
+	}
+
+	missing, err := deliveryChannelBucketPolicyMissingActions(aws.StringValue(output.Policy), principal)
+
+	if err != nil {
+		return fmt.Sprintf("Unable to parse the policy of S3 bucket (%s): %s", bucket, err)
+	}
+
+	if len(missing) > 0 {
+		return fmt.Sprintf("The policy of S3 bucket (%s) does not allow %s to %s.", bucket, principal, strings.Join(missing, " and "))
+	}
+
+	return ""
+}
+
+// deliveryChannelBucketPolicyMissingActions returns the actions needed by AWS Config that the bucket policy doesn't allow for the service principal.
+// Deny statements and conditions are not evaluated.
+func deliveryChannelBucketPolicyMissingActions(policy, principal string) ([]string, error) {
+	var doc struct {
+		Statement []struct {
+			Action    interface{}
+			Effect    string
+			Principal interface{}
+		}
+	}
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, err
+	}
+
+	allowed := make(map[string]bool)
+
+	for _, statement := range doc.Statement {
+		if !strings.EqualFold(statement.Effect, "Allow") || !policyPrincipalMatches(statement.Principal, principal) {
+			continue
+		}
+
+		for _, pattern := range policyStrings(statement.Action) {
+			for _, action := range deliveryChannelBucketPolicyActions {
+				if ok, _ := path.Match(strings.ToLower(pattern), strings.ToLower(action)); ok {
+					allowed[action] = true
+				}
+			}
+		}
+	}
+
+	var missing []string
+
+	for _, action := range deliveryChannelBucketPolicyActions {
+		if !allowed[action] {
+			missing = append(missing, action)
+		}
+	}
+
+	return missing, nil
+}
+
+func policyPrincipalMatches(v interface{}, principal string) bool {
+	switch v := v.(type) {
+	case string:
+		return v == "*"
+	case map[string]interface{}:
+		for _, id := range policyStrings(v["Service"]) {
+			if id == principal {
+				return true
+			}
+		}
+
+		for _, id := range policyStrings(v["AWS"]) {
+			if id == "*" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func policyStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var result []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				result = append(result, v)
+			}
+		}
+		return result
+	}
+
+	return nil
+}
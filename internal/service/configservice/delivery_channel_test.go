@@ -3,6 +3,7 @@ package configservice_test
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -12,8 +13,91 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconfigservice "github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
 )
 
+func TestDeliveryChannelBucketPolicyMissingActions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		policy   string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:    "invalid JSON",
+			policy:  `{`,
+			wantErr: true,
+		},
+		{
+			name:     "no statements",
+			policy:   `{"Version": "2012-10-17", "Statement": []}`,
+			expected: []string{"s3:GetBucketAcl", "s3:PutObject"},
+		},
+		{
+			name: "service principal",
+			policy: `{"Statement": [
+  {"Effect": "Allow", "Principal": {"Service": "config.amazonaws.com"}, "Action": "s3:GetBucketAcl", "Resource": "arn:aws:s3:::test"},
+  {"Effect": "Allow", "Principal": {"Service": ["config.amazonaws.com"]}, "Action": ["s3:PutObject"], "Resource": "arn:aws:s3:::test/*"}
+]}`,
+		},
+		{
+			name: "missing put",
+			policy: `{"Statement": [
+  {"Effect": "Allow", "Principal": {"Service": "config.amazonaws.com"}, "Action": "s3:GetBucketAcl", "Resource": "arn:aws:s3:::test"}
+]}`,
+			expected: []string{"s3:PutObject"},
+		},
+		{
+			name: "other principal",
+			policy: `{"Statement": [
+  {"Effect": "Allow", "Principal": {"Service": "cloudtrail.amazonaws.com"}, "Action": "s3:*", "Resource": "*"}
+]}`,
+			expected: []string{"s3:GetBucketAcl", "s3:PutObject"},
+		},
+		{
+			name: "deny",
+			policy: `{"Statement": [
+  {"Effect": "Deny", "Principal": {"Service": "config.amazonaws.com"}, "Action": "s3:*", "Resource": "*"}
+]}`,
+			expected: []string{"s3:GetBucketAcl", "s3:PutObject"},
+		},
+		{
+			name: "wildcards",
+			policy: `{"Statement": [
+  {"Effect": "Allow", "Principal": "*", "Action": "s3:Get*", "Resource": "*"},
+  {"Effect": "Allow", "Principal": {"AWS": "*"}, "Action": "S3:PUTOBJECT", "Resource": "*"}
+]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfconfigservice.DeliveryChannelBucketPolicyMissingActions(testCase.policy, "config.amazonaws.com")
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %v, expected %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
 func testAccDeliveryChannel_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dc configservice.DeliveryChannel
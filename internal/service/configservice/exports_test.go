@@ -0,0 +1,9 @@
+package configservice
+
+// Exports for use in tests only.
+var (
+	ConformancePackTemplateParameterNames     = conformancePackTemplateParameterNames
+	DeliveryChannelBucketPolicyMissingActions = deliveryChannelBucketPolicyMissingActions
+	NormalizePolicyText                       = normalizePolicyText
+	ValidateManagedRuleInputParameters        = validateManagedRuleInputParameters
+)
@@ -33,3 +33,390 @@ func FindConfigRule(ctx context.Context, conn *configservice.ConfigService, name
 
 	return output.ConfigRules[0], nil
 }
+
+func FindConfigRules(ctx context.Context, conn *configservice.ConfigService, input *configservice.DescribeConfigRulesInput) ([]*configservice.ConfigRule, error) {
+	var output []*configservice.ConfigRule
+
+	err := conn.DescribeConfigRulesPagesWithContext(ctx, input, func(page *configservice.DescribeConfigRulesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ConfigRules {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindRemediationExecutionStatuses(ctx context.Context, conn *configservice.ConfigService, configRuleName string) ([]*configservice.RemediationExecutionStatus, error) {
+	input := &configservice.DescribeRemediationExecutionStatusInput{
+		ConfigRuleName: aws.String(configRuleName),
+	}
+	var output []*configservice.RemediationExecutionStatus
+
+	err := conn.DescribeRemediationExecutionStatusPagesWithContext(ctx, input, func(page *configservice.DescribeRemediationExecutionStatusOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RemediationExecutionStatuses {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchRemediationConfigurationException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindStoredQueryByName(ctx context.Context, conn *configservice.ConfigService, name string) (*configservice.StoredQuery, error) {
+	input := &configservice.GetStoredQueryInput{
+		QueryName: aws.String(name),
+	}
+
+	output, err := conn.GetStoredQueryWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.StoredQuery == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.StoredQuery, nil
+}
+
+func FindRetentionConfigurationByName(ctx context.Context, conn *configservice.ConfigService, name string) (*configservice.RetentionConfiguration, error) {
+	input := &configservice.DescribeRetentionConfigurationsInput{
+		RetentionConfigurationNames: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeRetentionConfigurationsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchRetentionConfigurationException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.RetentionConfigurations) == 0 || output.RetentionConfigurations[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.RetentionConfigurations); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.RetentionConfigurations[0], nil
+}
+
+func FindConfigurationAggregatorByName(ctx context.Context, conn *configservice.ConfigService, name string) (*configservice.ConfigurationAggregator, error) {
+	input := &configservice.DescribeConfigurationAggregatorsInput{
+		ConfigurationAggregatorNames: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeConfigurationAggregatorsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConfigurationAggregatorException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ConfigurationAggregators) == 0 || output.ConfigurationAggregators[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ConfigurationAggregators); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ConfigurationAggregators[0], nil
+}
+
+func FindConfigurationAggregatorSourcesStatus(ctx context.Context, conn *configservice.ConfigService, name string) ([]*configservice.AggregatedSourceStatus, error) {
+	input := &configservice.DescribeConfigurationAggregatorSourcesStatusInput{
+		ConfigurationAggregatorName: aws.String(name),
+	}
+	var output []*configservice.AggregatedSourceStatus
+
+	err := conn.DescribeConfigurationAggregatorSourcesStatusPagesWithContext(ctx, input, func(page *configservice.DescribeConfigurationAggregatorSourcesStatusOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AggregatedSourceStatusList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConfigurationAggregatorException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindComplianceByConfigRules(ctx context.Context, conn *configservice.ConfigService, input *configservice.DescribeComplianceByConfigRuleInput) ([]*configservice.ComplianceByConfigRule, error) {
+	var output []*configservice.ComplianceByConfigRule
+
+	err := conn.DescribeComplianceByConfigRulePagesWithContext(ctx, input, func(page *configservice.DescribeComplianceByConfigRuleOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ComplianceByConfigRules {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConfigRuleException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindRemediationExceptions(ctx context.Context, conn *configservice.ConfigService, configRuleName string, resourceKeys []*configservice.RemediationExceptionResourceKey) ([]*configservice.RemediationException, error) {
+	input := &configservice.DescribeRemediationExceptionsInput{
+		ConfigRuleName: aws.String(configRuleName),
+	}
+
+	if len(resourceKeys) > 0 {
+		input.ResourceKeys = resourceKeys
+	}
+
+	var output []*configservice.RemediationException
+
+	err := conn.DescribeRemediationExceptionsPagesWithContext(ctx, input, func(page *configservice.DescribeRemediationExceptionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RemediationExceptions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchRemediationExceptionException, configservice.ErrCodeNoSuchConfigRuleException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindConfigurationRecorderStatusByName(ctx context.Context, conn *configservice.ConfigService, name string) (*configservice.ConfigurationRecorderStatus, error) {
+	input := &configservice.DescribeConfigurationRecorderStatusInput{
+		ConfigurationRecorderNames: aws.StringSlice([]string{name}),
+	}
+
+	output, err := conn.DescribeConfigurationRecorderStatusWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConfigurationRecorderException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ConfigurationRecordersStatus) == 0 || output.ConfigurationRecordersStatus[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ConfigurationRecordersStatus); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ConfigurationRecordersStatus[0], nil
+}
+
+func FindConformancePackRuleNames(ctx context.Context, conn *configservice.ConfigService, name string) ([]string, error) {
+	input := &configservice.DescribeConformancePackComplianceInput{
+		ConformancePackName: aws.String(name),
+	}
+	var output []string
+
+	err := conn.DescribeConformancePackCompliancePagesWithContext(ctx, input, func(page *configservice.DescribeConformancePackComplianceOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ConformancePackRuleComplianceList {
+			if v != nil {
+				output = append(output, aws.StringValue(v.ConfigRuleName))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConformancePackException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func FindConfigRuleEvaluationStatuses(ctx context.Context, conn *configservice.ConfigService, names []string) ([]*configservice.ConfigRuleEvaluationStatus, error) {
+	// DescribeConfigRuleEvaluationStatus accepts at most 25 rule names per request.
+	const batchSize = 25
+	var output []*configservice.ConfigRuleEvaluationStatus
+
+	for i := 0; i < len(names); i += batchSize {
+		j := i + batchSize
+		if j > len(names) {
+			j = len(names)
+		}
+
+		input := &configservice.DescribeConfigRuleEvaluationStatusInput{
+			ConfigRuleNames: aws.StringSlice(names[i:j]),
+		}
+
+		err := conn.DescribeConfigRuleEvaluationStatusPagesWithContext(ctx, input, func(page *configservice.DescribeConfigRuleEvaluationStatusOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.ConfigRulesEvaluationStatus {
+				if v != nil {
+					output = append(output, v)
+				}
+			}
+
+			return !lastPage
+		})
+
+		if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConfigRuleException) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func FindAggregateDiscoveredResources(ctx context.Context, conn *configservice.ConfigService, input *configservice.ListAggregateDiscoveredResourcesInput) ([]*configservice.AggregateResourceIdentifier, error) {
+	var output []*configservice.AggregateResourceIdentifier
+
+	err := conn.ListAggregateDiscoveredResourcesPagesWithContext(ctx, input, func(page *configservice.ListAggregateDiscoveredResourcesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceIdentifiers {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConfigurationAggregatorException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
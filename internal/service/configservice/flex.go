@@ -1,6 +1,9 @@
 package configservice
 
 import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/configservice/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -50,24 +53,93 @@ func expandOrganizationAggregationSource(configured map[string]interface{}) *con
 	return &source
 }
 
-func expandRecordingGroup(configured []interface{}) *configservice.RecordingGroup {
-	recordingGroup := configservice.RecordingGroup{}
+func expandRecordingGroup(configured []interface{}) *types.RecordingGroup {
+	recordingGroup := types.RecordingGroup{}
 	group := configured[0].(map[string]interface{})
 
 	if v, ok := group["all_supported"]; ok {
-		recordingGroup.AllSupported = aws.Bool(v.(bool))
+		recordingGroup.AllSupported = v.(bool)
 	}
 
 	if v, ok := group["include_global_resource_types"]; ok {
-		recordingGroup.IncludeGlobalResourceTypes = aws.Bool(v.(bool))
+		recordingGroup.IncludeGlobalResourceTypes = v.(bool)
+	}
+
+	if v, ok := group["exclusion_by_resource_types"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		exclusions := v[0].(map[string]interface{})
+		recordingGroup.ExclusionByResourceTypes = &types.ExclusionByResourceTypes{
+			ResourceTypes: expandResourceTypes(exclusions["resource_types"].(*schema.Set)),
+		}
+	}
+
+	if v, ok := group["recording_strategy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if useOnly := v[0].(map[string]interface{})["use_only"].(string); useOnly != "" {
+			recordingGroup.RecordingStrategy = &types.RecordingStrategy{
+				UseOnly: types.RecordingStrategyType(useOnly),
+			}
+		}
 	}
 
 	if v, ok := group["resource_types"]; ok {
-		recordingGroup.ResourceTypes = flex.ExpandStringSet(v.(*schema.Set))
+		recordingGroup.ResourceTypes = expandResourceTypes(v.(*schema.Set))
 	}
 	return &recordingGroup
 }
 
+func expandRecordingMode(tfMap map[string]interface{}) *types.RecordingMode {
+	apiObject := &types.RecordingMode{}
+
+	if v, ok := tfMap["recording_frequency"].(string); ok && v != "" {
+		apiObject.RecordingFrequency = types.RecordingFrequency(v)
+	}
+
+	if v, ok := tfMap["recording_mode_override"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RecordingModeOverrides = expandRecordingModeOverrides(v)
+	}
+
+	return apiObject
+}
+
+func expandRecordingModeOverrides(tfList []interface{}) []types.RecordingModeOverride {
+	var apiObjects []types.RecordingModeOverride
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.RecordingModeOverride{}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["recording_frequency"].(string); ok && v != "" {
+			apiObject.RecordingFrequency = types.RecordingFrequency(v)
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ResourceTypes = expandResourceTypes(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandResourceTypes(s *schema.Set) []types.ResourceType {
+	var resourceTypes []types.ResourceType
+
+	for _, v := range flex.ExpandStringValueSet(s) {
+		resourceTypes = append(resourceTypes, types.ResourceType(v))
+	}
+
+	return resourceTypes
+}
+
 func expandRuleScope(l []interface{}) *configservice.Scope {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -180,24 +252,95 @@ func flattenOrganizationAggregationSource(source *configservice.OrganizationAggr
 	return result
 }
 
-func flattenRecordingGroup(g *configservice.RecordingGroup) []map[string]interface{} {
+func flattenAggregatedSourceStatuses(statuses []*configservice.AggregatedSourceStatus) []interface{} {
+	var result []interface{}
+
+	for _, status := range statuses {
+		if status == nil {
+			continue
+		}
+
+		m := make(map[string]interface{})
+		m["aws_region"] = aws.StringValue(status.AwsRegion)
+		m["last_error_code"] = aws.StringValue(status.LastErrorCode)
+		m["last_error_message"] = aws.StringValue(status.LastErrorMessage)
+		m["last_update_status"] = aws.StringValue(status.LastUpdateStatus)
+		if status.LastUpdateTime != nil {
+			m["last_update_time"] = aws.TimeValue(status.LastUpdateTime).Format(time.RFC3339)
+		}
+		m["source_id"] = aws.StringValue(status.SourceId)
+		m["source_type"] = aws.StringValue(status.SourceType)
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func flattenRecordingGroup(g *types.RecordingGroup) []map[string]interface{} {
 	m := make(map[string]interface{}, 1)
 
-	if g.AllSupported != nil {
-		m["all_supported"] = aws.BoolValue(g.AllSupported)
+	m["all_supported"] = g.AllSupported
+	m["include_global_resource_types"] = g.IncludeGlobalResourceTypes
+
+	if g.ExclusionByResourceTypes != nil && len(g.ExclusionByResourceTypes.ResourceTypes) > 0 {
+		m["exclusion_by_resource_types"] = []interface{}{
+			map[string]interface{}{
+				"resource_types": flattenResourceTypes(g.ExclusionByResourceTypes.ResourceTypes),
+			},
+		}
 	}
 
-	if g.IncludeGlobalResourceTypes != nil {
-		m["include_global_resource_types"] = aws.BoolValue(g.IncludeGlobalResourceTypes)
+	if g.RecordingStrategy != nil {
+		m["recording_strategy"] = []interface{}{
+			map[string]interface{}{
+				"use_only": string(g.RecordingStrategy.UseOnly),
+			},
+		}
 	}
 
-	if g.ResourceTypes != nil && len(g.ResourceTypes) > 0 {
-		m["resource_types"] = flex.FlattenStringSet(g.ResourceTypes)
+	if len(g.ResourceTypes) > 0 {
+		m["resource_types"] = flattenResourceTypes(g.ResourceTypes)
 	}
 
 	return []map[string]interface{}{m}
 }
 
+func flattenRecordingMode(apiObject *types.RecordingMode) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"recording_frequency": string(apiObject.RecordingFrequency),
+	}
+
+	if v := apiObject.RecordingModeOverrides; len(v) > 0 {
+		tfMap["recording_mode_override"] = flattenRecordingModeOverrides(v)
+	}
+
+	return tfMap
+}
+
+func flattenRecordingModeOverrides(apiObjects []types.RecordingModeOverride) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"description":         aws.StringValue(apiObject.Description),
+			"recording_frequency": string(apiObject.RecordingFrequency),
+			"resource_types":      flattenResourceTypes(apiObject.ResourceTypes),
+		})
+	}
+
+	return tfList
+}
+
+func flattenResourceTypes(resourceTypes []types.ResourceType) *schema.Set {
+	var l []string
+
+	for _, v := range resourceTypes {
+		l = append(l, string(v))
+	}
+
+	return flex.FlattenStringValueSet(l)
+}
+
 func flattenRuleScope(scope *configservice.Scope) []interface{} {
 	var items []interface{}
 
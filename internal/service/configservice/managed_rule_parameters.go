@@ -0,0 +1,172 @@
+package configservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// managedRuleParameters lists the input parameter names of commonly used AWS managed rules.
+// See https://docs.aws.amazon.com/config/latest/developerguide/managed-rules-by-aws-config.html.
+// Rules that aren't listed here aren't validated.
+var managedRuleParameters = map[string][]string{
+	"ACCESS_KEYS_ROTATED":                {"maxAccessKeyAge"},
+	"ACM_CERTIFICATE_EXPIRATION_CHECK":   {"daysToExpiration"},
+	"APPROVED_AMIS_BY_ID":                {"amiIds"},
+	"APPROVED_AMIS_BY_TAG":               {"amisByTagKeyAndValue"},
+	"CLOUD_TRAIL_ENABLED":                {"cloudWatchLogsLogGroupArn", "s3BucketName", "snsTopicArn"},
+	"CW_LOGGROUP_RETENTION_PERIOD_CHECK": {"LogGroupNames", "MinRetentionTime"},
+	"DESIRED_INSTANCE_TENANCY":           {"hostId", "imageId", "tenancy"},
+	"DESIRED_INSTANCE_TYPE":              {"instanceType"},
+	"DYNAMODB_THROUGHPUT_LIMIT_CHECK":    {"accountRCUThresholdPercentage", "accountWCUThresholdPercentage"},
+	"EC2_INSTANCE_NO_PUBLIC_IP":          {},
+	"EC2_STOPPED_INSTANCE":               {"AllowedDays"},
+	"EC2_VOLUME_INUSE_CHECK":             {"deleteOnTermination"},
+	"ENCRYPTED_VOLUMES":                  {"kmsId"},
+	"GUARDDUTY_ENABLED_CENTRALIZED":      {"CentralMonitoringAccount"},
+	"IAM_PASSWORD_POLICY": {
+		"MaxPasswordAge",
+		"MinimumPasswordLength",
+		"PasswordReusePrevention",
+		"RequireLowercaseCharacters",
+		"RequireNumbers",
+		"RequireSymbols",
+		"RequireUppercaseCharacters",
+	},
+	"IAM_POLICY_IN_USE":                    {"policyARN", "policyUsageType"},
+	"IAM_ROOT_ACCESS_KEY_CHECK":            {},
+	"IAM_USER_UNUSED_CREDENTIALS_CHECK":    {"maxCredentialUsageAge"},
+	"INSTANCES_IN_VPC":                     {"vpcId"},
+	"INTERNET_GATEWAY_AUTHORIZED_VPC_ONLY": {"AuthorizedVpcIds"},
+	"LAMBDA_FUNCTION_SETTINGS_CHECK":       {"memorySize", "role", "runtime", "timeout"},
+	"MFA_ENABLED_FOR_IAM_CONSOLE_ACCESS":   {},
+	"RDS_STORAGE_ENCRYPTED":                {"kmsKeyId"},
+	"REQUIRED_TAGS": {
+		"tag1Key", "tag1Value",
+		"tag2Key", "tag2Value",
+		"tag3Key", "tag3Value",
+		"tag4Key", "tag4Value",
+		"tag5Key", "tag5Value",
+		"tag6Key", "tag6Value",
+	},
+	"RESTRICTED_INCOMING_TRAFFIC":              {"blockedPort1", "blockedPort2", "blockedPort3", "blockedPort4", "blockedPort5"},
+	"ROOT_ACCOUNT_MFA_ENABLED":                 {},
+	"S3_ACCOUNT_LEVEL_PUBLIC_ACCESS_BLOCKS":    {"BlockPublicAcls", "BlockPublicPolicy", "IgnorePublicAcls", "RestrictPublicBuckets"},
+	"S3_BUCKET_LOGGING_ENABLED":                {"targetBucket", "targetPrefix"},
+	"S3_BUCKET_SERVER_SIDE_ENCRYPTION_ENABLED": {},
+	"S3_BUCKET_VERSIONING_ENABLED":             {"isMfaDeleteEnabled"},
+	"S3_DEFAULT_ENCRYPTION_KMS":                {"kmsKeyArns"},
+	"VPC_FLOW_LOGS_ENABLED":                    {"trafficType"},
+}
+
+func managedRuleInputParametersCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("rule_identifier") || !diff.NewValueKnown("input_parameters") {
+		return nil
+	}
+
+	return validateManagedRuleInputParameters(diff.Get("rule_identifier").(string), diff.Get("input_parameters").(string))
+}
+
+// validateManagedRuleInputParameters checks the input parameter names of a managed rule against managedRuleParameters.
+func validateManagedRuleInputParameters(ruleIdentifier, inputParameters string) error {
+	valid, ok := managedRuleParameters[ruleIdentifier]
+
+	if !ok || inputParameters == "" {
+		return nil
+	}
+
+	var parameters map[string]interface{}
+
+	if err := json.Unmarshal([]byte(inputParameters), &parameters); err != nil {
+		// Invalid JSON is reported by the input_parameters validation.
+		return nil
+	}
+
+	var names []string
+	for k := range parameters {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if isManagedRuleParameter(valid, name) {
+			continue
+		}
+
+		if len(valid) == 0 {
+			return fmt.Errorf("input_parameters: managed rule %s doesn't take parameters, got %q", ruleIdentifier, name)
+		}
+
+		if suggestion := closestManagedRuleParameter(valid, name); suggestion != "" {
+			return fmt.Errorf("input_parameters: %q is not a parameter of managed rule %s, did you mean %q?", name, ruleIdentifier, suggestion)
+		}
+
+		return fmt.Errorf("input_parameters: %q is not a parameter of managed rule %s, valid parameters are: %s", name, ruleIdentifier, strings.Join(valid, ", "))
+	}
+
+	return nil
+}
+
+func isManagedRuleParameter(valid []string, name string) bool {
+	for _, v := range valid {
+		if v == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// closestManagedRuleParameter returns the valid parameter name that differs from name only in case or by at most 2 edits.
+func closestManagedRuleParameter(valid []string, name string) string {
+	var closest string
+	closestDistance := 3
+
+	for _, v := range valid {
+		if strings.EqualFold(v, name) {
+			return v
+		}
+
+		if d := levenshteinDistance(strings.ToLower(v), strings.ToLower(name)); d < closestDistance {
+			closest, closestDistance = v, d
+		}
+	}
+
+	return closest
+}
+
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = previous[j-1] + cost
+			if v := previous[j] + 1; v < current[j] {
+				current[j] = v
+			}
+			if v := current[j-1] + 1; v < current[j] {
+				current[j] = v
+			}
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,159 @@
+package configservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceOrganizationConformancePackStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceOrganizationConformancePackStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"error_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member_account_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(configservice.OrganizationResourceDetailedStatus_Values(), false),
+			},
+			"member_accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"conformance_pack_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_update_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOrganizationConformancePackStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	name := d.Get("name").(string)
+	status, err := describeOrganizationConformancePackStatus(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Config Organization Conformance Pack (%s) status: %s", name, err)
+	}
+
+	if status == nil {
+		return sdkdiag.AppendErrorf(diags, "reading Config Organization Conformance Pack (%s) status: not found", name)
+	}
+
+	filters := &configservice.OrganizationResourceDetailedStatusFilters{}
+
+	if v, ok := d.GetOk("account_id"); ok {
+		filters.AccountId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("member_account_status"); ok {
+		filters.Status = aws.String(v.(string))
+	}
+
+	memberAccountStatuses, err := getOrganizationConformancePackDetailedStatus(ctx, conn, name, filters)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Config Organization Conformance Pack (%s) detailed status: %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("error_code", status.ErrorCode)
+	d.Set("error_message", status.ErrorMessage)
+	if status.LastUpdateTime != nil {
+		d.Set("last_update_time", aws.TimeValue(status.LastUpdateTime).Format(time.RFC3339))
+	}
+	d.Set("name", status.OrganizationConformancePackName)
+	d.Set("status", status.Status)
+
+	if err := d.Set("member_accounts", flattenOrganizationConformancePackDetailedStatuses(memberAccountStatuses)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting member_accounts: %s", err)
+	}
+
+	return diags
+}
+
+func flattenOrganizationConformancePackDetailedStatuses(apiObjects []*configservice.OrganizationConformancePackDetailedStatus) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"account_id":            aws.StringValue(apiObject.AccountId),
+			"conformance_pack_name": aws.StringValue(apiObject.ConformancePackName),
+			"error_code":            aws.StringValue(apiObject.ErrorCode),
+			"error_message":         aws.StringValue(apiObject.ErrorMessage),
+			"status":                aws.StringValue(apiObject.Status),
+		}
+
+		if v := apiObject.LastUpdateTime; v != nil {
+			tfMap["last_update_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,338 @@
+package configservice
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceOrganizationCustomPolicyRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOrganizationCustomPolicyRuleCreate,
+		DeleteWithoutTimeout: resourceOrganizationCustomPolicyRuleDelete,
+		ReadWithoutTimeout:   resourceOrganizationCustomPolicyRuleRead,
+		UpdateWithoutTimeout: resourceOrganizationCustomPolicyRuleUpdate,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"debug_log_delivery_accounts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1000,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"excluded_accounts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1000,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+			},
+			"input_parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 2048),
+					validation.StringIsJSON,
+				),
+			},
+			"maximum_execution_frequency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(configservice.MaximumExecutionFrequency_Values(), false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"policy_runtime": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^guard\-2\.x\.x$`), "Must match cloudformation-guard version"),
+				),
+			},
+			"policy_text": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentPolicyTextDiffs,
+				ValidateFunc:     validation.StringLenBetween(1, 10000),
+			},
+			"resource_id_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 768),
+			},
+			"resource_types_scope": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(0, 256),
+				},
+			},
+			"tag_key_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"tag_value_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"trigger_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(configservice.OrganizationConfigRuleTriggerTypeNoSN_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func resourceOrganizationCustomPolicyRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+	name := d.Get("name").(string)
+
+	input := &configservice.PutOrganizationConfigRuleInput{
+		OrganizationConfigRuleName:           aws.String(name),
+		OrganizationCustomPolicyRuleMetadata: expandOrganizationCustomPolicyRuleMetadata(d),
+	}
+
+	if v, ok := d.GetOk("excluded_accounts"); ok && v.(*schema.Set).Len() > 0 {
+		input.ExcludedAccounts = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	_, err := conn.PutOrganizationConfigRuleWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionCreating, ResNameOrganizationCustomPolicyRule, name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForOrganizationRuleStatusCreateSuccessful(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionWaitingForCreation, ResNameOrganizationCustomPolicyRule, d.Id(), err)
+	}
+
+	return append(diags, resourceOrganizationCustomPolicyRuleRead(ctx, d, meta)...)
+}
+
+func resourceOrganizationCustomPolicyRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	rule, err := DescribeOrganizationConfigRule(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchOrganizationConfigRuleException) {
+		log.Printf("[WARN] Config Organization Custom Policy Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionReading, ResNameOrganizationCustomPolicyRule, d.Id(), err)
+	}
+
+	if !d.IsNewResource() && rule == nil {
+		log.Printf("[WARN] Config Organization Custom Policy Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if d.IsNewResource() && rule == nil {
+		return create.DiagError(names.ConfigService, create.ErrActionReading, ResNameOrganizationCustomPolicyRule, d.Id(), errors.New("empty rule after creation"))
+	}
+
+	if rule.OrganizationManagedRuleMetadata != nil || rule.OrganizationCustomRuleMetadata != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionReading, ResNameOrganizationCustomPolicyRule, d.Id(), errors.New("expected Organization Custom Policy Rule, found another Organization Config Rule type"))
+	}
+
+	metadata := rule.OrganizationCustomPolicyRuleMetadata
+
+	if metadata == nil {
+		return create.DiagError(names.ConfigService, create.ErrActionReading, ResNameOrganizationCustomPolicyRule, d.Id(), errors.New("empty metadata"))
+	}
+
+	// The policy text is not returned by DescribeOrganizationConfigRules.
+	policy, err := conn.GetOrganizationCustomRulePolicyWithContext(ctx, &configservice.GetOrganizationCustomRulePolicyInput{
+		OrganizationConfigRuleName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionReading, ResNameOrganizationCustomPolicyRule, d.Id(), err)
+	}
+
+	d.Set("arn", rule.OrganizationConfigRuleArn)
+
+	if err := d.Set("debug_log_delivery_accounts", aws.StringValueSlice(metadata.DebugLogDeliveryAccounts)); err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionSetting, ResNameOrganizationCustomPolicyRule, d.Id(), err)
+	}
+
+	d.Set("description", metadata.Description)
+
+	if err := d.Set("excluded_accounts", aws.StringValueSlice(rule.ExcludedAccounts)); err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionSetting, ResNameOrganizationCustomPolicyRule, d.Id(), err)
+	}
+
+	d.Set("input_parameters", metadata.InputParameters)
+	d.Set("maximum_execution_frequency", metadata.MaximumExecutionFrequency)
+	d.Set("name", rule.OrganizationConfigRuleName)
+	d.Set("policy_runtime", metadata.PolicyRuntime)
+	d.Set("policy_text", policy.PolicyText)
+	d.Set("resource_id_scope", metadata.ResourceIdScope)
+
+	if err := d.Set("resource_types_scope", aws.StringValueSlice(metadata.ResourceTypesScope)); err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionSetting, ResNameOrganizationCustomPolicyRule, d.Id(), err)
+	}
+
+	d.Set("tag_key_scope", metadata.TagKeyScope)
+	d.Set("tag_value_scope", metadata.TagValueScope)
+
+	if err := d.Set("trigger_types", aws.StringValueSlice(metadata.OrganizationConfigRuleTriggerTypes)); err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionSetting, ResNameOrganizationCustomPolicyRule, d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceOrganizationCustomPolicyRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	input := &configservice.PutOrganizationConfigRuleInput{
+		OrganizationConfigRuleName:           aws.String(d.Id()),
+		OrganizationCustomPolicyRuleMetadata: expandOrganizationCustomPolicyRuleMetadata(d),
+	}
+
+	if v, ok := d.GetOk("excluded_accounts"); ok && v.(*schema.Set).Len() > 0 {
+		input.ExcludedAccounts = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	_, err := conn.PutOrganizationConfigRuleWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionUpdating, ResNameOrganizationCustomPolicyRule, d.Id(), err)
+	}
+
+	if err := waitForOrganizationRuleStatusUpdateSuccessful(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionWaitingForUpdate, ResNameOrganizationCustomPolicyRule, d.Id(), err)
+	}
+
+	return append(diags, resourceOrganizationCustomPolicyRuleRead(ctx, d, meta)...)
+}
+
+func resourceOrganizationCustomPolicyRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	input := &configservice.DeleteOrganizationConfigRuleInput{
+		OrganizationConfigRuleName: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteOrganizationConfigRuleWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchOrganizationConfigRuleException) {
+		return diags
+	}
+
+	if err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionDeleting, ResNameOrganizationCustomPolicyRule, d.Id(), err)
+	}
+
+	if err := waitForOrganizationRuleStatusDeleteSuccessful(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionWaitingForDeletion, ResNameOrganizationCustomPolicyRule, d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandOrganizationCustomPolicyRuleMetadata(d *schema.ResourceData) *configservice.OrganizationCustomPolicyRuleMetadata {
+	metadata := &configservice.OrganizationCustomPolicyRuleMetadata{
+		OrganizationConfigRuleTriggerTypes: flex.ExpandStringSet(d.Get("trigger_types").(*schema.Set)),
+		PolicyRuntime:                      aws.String(d.Get("policy_runtime").(string)),
+		PolicyText:                         aws.String(d.Get("policy_text").(string)),
+	}
+
+	if v, ok := d.GetOk("debug_log_delivery_accounts"); ok && v.(*schema.Set).Len() > 0 {
+		metadata.DebugLogDeliveryAccounts = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		metadata.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("input_parameters"); ok {
+		metadata.InputParameters = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("maximum_execution_frequency"); ok {
+		metadata.MaximumExecutionFrequency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_id_scope"); ok {
+		metadata.ResourceIdScope = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_types_scope"); ok && v.(*schema.Set).Len() > 0 {
+		metadata.ResourceTypesScope = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("tag_key_scope"); ok {
+		metadata.TagKeyScope = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tag_value_scope"); ok {
+		metadata.TagValueScope = aws.String(v.(string))
+	}
+
+	return metadata
+}
@@ -0,0 +1,297 @@
+package configservice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfconfigservice "github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const testAccOrganizationCustomPolicyRulePolicyText = `rule tableisactive when
+    resourceType == "AWS::DynamoDB::Table" {
+    configuration.tableStatus == ['ACTIVE']
+}
+`
+
+func testAccOrganizationCustomPolicyRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule configservice.OrganizationConfigRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_config_organization_custom_policy_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationCustomPolicyRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationCustomPolicyRuleConfig_basic(rName, testAccOrganizationCustomPolicyRulePolicyText),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationCustomPolicyRuleExists(ctx, resourceName, &rule),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "config", regexp.MustCompile(fmt.Sprintf("organization-config-rule/%s-.+", rName))),
+					resource.TestCheckResourceAttr(resourceName, "debug_log_delivery_accounts.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "excluded_accounts.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "input_parameters", ""),
+					resource.TestCheckResourceAttr(resourceName, "maximum_execution_frequency", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "policy_runtime", "guard-2.x.x"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_text"),
+					resource.TestCheckResourceAttr(resourceName, "resource_types_scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "trigger_types.*", configservice.OrganizationConfigRuleTriggerTypeNoSNConfigurationItemChangeNotification),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccOrganizationCustomPolicyRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule configservice.OrganizationConfigRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_config_organization_custom_policy_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationCustomPolicyRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationCustomPolicyRuleConfig_basic(rName, testAccOrganizationCustomPolicyRulePolicyText),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationCustomPolicyRuleExists(ctx, resourceName, &rule),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconfigservice.ResourceOrganizationCustomPolicyRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccOrganizationCustomPolicyRule_debugLogDeliveryAccounts(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule configservice.OrganizationConfigRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_config_organization_custom_policy_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationCustomPolicyRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationCustomPolicyRuleConfig_debugLogDeliveryAccounts(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationCustomPolicyRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "debug_log_delivery_accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "debug_log_delivery_accounts.*", "data.aws_caller_identity.current", "account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrganizationCustomPolicyRuleConfig_basic(rName, testAccOrganizationCustomPolicyRulePolicyText),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationCustomPolicyRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "debug_log_delivery_accounts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationCustomPolicyRule_policyText(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule configservice.OrganizationConfigRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_config_organization_custom_policy_rule.test"
+	updatedPolicyText := `rule tableisactive when
+    resourceType == "AWS::DynamoDB::Table" {
+    configuration.tableStatus == ['ACTIVE', 'UPDATING']
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationCustomPolicyRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationCustomPolicyRuleConfig_basic(rName, testAccOrganizationCustomPolicyRulePolicyText),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationCustomPolicyRuleExists(ctx, resourceName, &rule),
+				),
+			},
+			{
+				// Indentation and blank line changes are not semantic.
+				Config: testAccOrganizationCustomPolicyRuleConfig_basic(rName, `
+rule tableisactive when
+resourceType == "AWS::DynamoDB::Table" {
+
+  configuration.tableStatus == ['ACTIVE']
+}
+`),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccOrganizationCustomPolicyRuleConfig_basic(rName, updatedPolicyText),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationCustomPolicyRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "policy_text", updatedPolicyText),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrganizationCustomPolicyRuleExists(ctx context.Context, resourceName string, ocr *configservice.OrganizationConfigRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return create.Error(names.ConfigService, create.ErrActionCheckingExistence, tfconfigservice.ResNameOrganizationCustomPolicyRule, resourceName, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn()
+
+		rule, err := tfconfigservice.DescribeOrganizationConfigRule(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.ConfigService, create.ErrActionCheckingExistence, tfconfigservice.ResNameOrganizationCustomPolicyRule, resourceName, err)
+		}
+
+		if rule == nil {
+			return create.Error(names.ConfigService, create.ErrActionCheckingExistence, tfconfigservice.ResNameOrganizationCustomPolicyRule, resourceName, errors.New("empty response"))
+		}
+
+		*ocr = *rule
+
+		return nil
+	}
+}
+
+func testAccCheckOrganizationCustomPolicyRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_config_organization_custom_policy_rule" {
+				continue
+			}
+
+			rule, err := tfconfigservice.DescribeOrganizationConfigRule(ctx, conn, rs.Primary.ID)
+
+			if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchOrganizationConfigRuleException) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.ConfigService, create.ErrActionCheckingDestroyed, tfconfigservice.ResNameOrganizationCustomPolicyRule, rs.Primary.ID, err)
+			}
+
+			if rule != nil {
+				return create.Error(names.ConfigService, create.ErrActionCheckingDestroyed, tfconfigservice.ResNameOrganizationCustomPolicyRule, rs.Primary.ID, errors.New("still exists"))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccOrganizationCustomPolicyRuleConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_config_configuration_recorder" "test" {
+  depends_on = [aws_iam_role_policy_attachment.config]
+
+  name     = %[1]q
+  role_arn = aws_iam_role.config.arn
+}
+
+resource "aws_iam_role" "config" {
+  name = "%[1]s-config"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "config.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "config" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWS_ConfigRole"
+  role       = aws_iam_role.config.name
+}
+
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = ["config-multiaccountsetup.amazonaws.com"]
+  feature_set                   = "ALL"
+}
+`, rName)
+}
+
+func testAccOrganizationCustomPolicyRuleConfig_basic(rName, policyText string) string {
+	return acctest.ConfigCompose(testAccOrganizationCustomPolicyRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_config_organization_custom_policy_rule" "test" {
+  depends_on = [aws_config_configuration_recorder.test, aws_organizations_organization.test]
+
+  name                 = %[1]q
+  policy_runtime       = "guard-2.x.x"
+  policy_text          = %[2]q
+  resource_types_scope = ["AWS::DynamoDB::Table"]
+  trigger_types        = ["ConfigurationItemChangeNotification"]
+}
+`, rName, policyText))
+}
+
+func testAccOrganizationCustomPolicyRuleConfig_debugLogDeliveryAccounts(rName string) string {
+	return acctest.ConfigCompose(testAccOrganizationCustomPolicyRuleConfigBase(rName), fmt.Sprintf(`
+resource "aws_config_organization_custom_policy_rule" "test" {
+  depends_on = [aws_config_configuration_recorder.test, aws_organizations_organization.test]
+
+  debug_log_delivery_accounts = [data.aws_caller_identity.current.account_id]
+  name                        = %[1]q
+  policy_runtime              = "guard-2.x.x"
+  policy_text                 = %[2]q
+  resource_types_scope        = ["AWS::DynamoDB::Table"]
+  trigger_types               = ["ConfigurationItemChangeNotification"]
+}
+`, rName, testAccOrganizationCustomPolicyRulePolicyText))
+}
@@ -37,6 +37,8 @@ func ResourceOrganizationManagedRule() *schema.Resource {
 			Update: schema.DefaultTimeout(5 * time.Minute),
 		},
 
+		CustomizeDiff: managedRuleInputParametersCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -16,6 +16,77 @@ import (
 	tfconfig "github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
 )
 
+func TestValidateManagedRuleInputParameters(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		ruleIdentifier  string
+		inputParameters string
+		expectedErr     *regexp.Regexp
+	}{
+		{
+			name:            "unknown rule",
+			ruleIdentifier:  "NOT_A_KNOWN_RULE",
+			inputParameters: `{"anything":"goes"}`,
+		},
+		{
+			name:           "no parameters",
+			ruleIdentifier: "DESIRED_INSTANCE_TYPE",
+		},
+		{
+			name:            "valid",
+			ruleIdentifier:  "REQUIRED_TAGS",
+			inputParameters: `{"tag1Key":"CostCenter","tag2Key":"Owner"}`,
+		},
+		{
+			name:            "typo",
+			ruleIdentifier:  "DESIRED_INSTANCE_TYPE",
+			inputParameters: `{"instanceTypes":"t3.micro"}`,
+			expectedErr:     regexp.MustCompile(`"instanceTypes" is not a parameter of managed rule DESIRED_INSTANCE_TYPE, did you mean "instanceType"\?`),
+		},
+		{
+			name:            "wrong case",
+			ruleIdentifier:  "IAM_PASSWORD_POLICY",
+			inputParameters: `{"minimumPasswordLength":"14"}`,
+			expectedErr:     regexp.MustCompile(`did you mean "MinimumPasswordLength"\?`),
+		},
+		{
+			name:            "unrelated",
+			ruleIdentifier:  "S3_BUCKET_LOGGING_ENABLED",
+			inputParameters: `{"bucketName":"example"}`,
+			expectedErr:     regexp.MustCompile(`valid parameters are: targetBucket, targetPrefix`),
+		},
+		{
+			name:            "rule without parameters",
+			ruleIdentifier:  "ROOT_ACCOUNT_MFA_ENABLED",
+			inputParameters: `{"enabled":"true"}`,
+			expectedErr:     regexp.MustCompile(`doesn't take parameters`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfconfig.ValidateManagedRuleInputParameters(testCase.ruleIdentifier, testCase.inputParameters)
+
+			if testCase.expectedErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !testCase.expectedErr.MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got: %v", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
 func testAccOrganizationManagedRule_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var rule configservice.OrganizationConfigRule
@@ -204,6 +275,24 @@ func testAccOrganizationManagedRule_InputParameters(t *testing.T) {
 	})
 }
 
+func testAccOrganizationManagedRule_InputParametersInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOrganizationManagedRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccOrganizationManagedRuleConfig_inputParameters(rName, `{"tag1key":"CostCenter"}`),
+				ExpectError: regexp.MustCompile(`did you mean "tag1Key"`),
+			},
+		},
+	})
+}
+
 func testAccOrganizationManagedRule_MaximumExecutionFrequency(t *testing.T) {
 	ctx := acctest.Context(t)
 	var rule configservice.OrganizationConfigRule
@@ -37,6 +37,11 @@ func ResourceRemediationConfiguration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -83,6 +88,7 @@ func ResourceRemediationConfiguration() *schema.Resource {
 			"maximum_automatic_attempts": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.IntBetween(1, 25),
 			},
 			"parameter": {
@@ -119,6 +125,7 @@ func ResourceRemediationConfiguration() *schema.Resource {
 			"retry_attempt_seconds": {
 				Type:         schema.TypeInt,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.IntBetween(1, 2678000),
 			},
 			"target_id": {
@@ -135,6 +142,11 @@ func ResourceRemediationConfiguration() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"wait_for_first_execution": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -190,6 +202,17 @@ func resourceRemediationConfigurationPut(ctx context.Context, d *schema.Resource
 
 	log.Printf("[DEBUG] AWSConfig config remediation configuration for rule %q created", name)
 
+	if d.Get("automatic").(bool) && d.Get("wait_for_first_execution").(bool) {
+		action, timeout := create.ErrActionWaitingForCreation, d.Timeout(schema.TimeoutCreate)
+		if !d.IsNewResource() {
+			action, timeout = create.ErrActionWaitingForUpdate, d.Timeout(schema.TimeoutUpdate)
+		}
+
+		if _, err := waitRemediationExecutionFinished(ctx, conn, name, timeout); err != nil {
+			return create.DiagError(names.ConfigService, action, ResNameRemediationConfiguration, d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceRemediationConfigurationRead(ctx, d, meta)...)
 }
 
@@ -233,7 +256,12 @@ func resourceRemediationConfigurationRead(ctx context.Context, d *schema.Resourc
 	d.Set("automatic", remediationConfiguration.Automatic)
 	d.Set("maximum_automatic_attempts", remediationConfiguration.MaximumAutomaticAttempts)
 	d.Set("retry_attempt_seconds", remediationConfiguration.RetryAttemptSeconds)
-	d.Set("maximum_automatic_attempts", remediationConfiguration.MaximumAutomaticAttempts)
+
+	if v, ok := d.GetOk("wait_for_first_execution"); ok {
+		d.Set("wait_for_first_execution", v.(bool))
+	} else {
+		d.Set("wait_for_first_execution", false)
+	}
 
 	if err := d.Set("execution_controls", flattenExecutionControls(remediationConfiguration.ExecutionControls)); err != nil {
 		return create.DiagError(names.ConfigService, create.ErrActionReading, ResNameRemediationConfiguration, d.Id(), err)
@@ -407,10 +435,10 @@ func flattenSSMControls(controls *configservice.SsmControls) []interface{} {
 	}
 	m := make(map[string]interface{})
 	if controls.ConcurrentExecutionRatePercentage != nil {
-		m["concurrent_execution_rate_percentage"] = controls.ConcurrentExecutionRatePercentage
+		m["concurrent_execution_rate_percentage"] = aws.Int64Value(controls.ConcurrentExecutionRatePercentage)
 	}
 	if controls.ErrorPercentage != nil {
-		m["error_percentage"] = controls.ErrorPercentage
+		m["error_percentage"] = aws.Int64Value(controls.ErrorPercentage)
 	}
 	return []interface{}{m}
 }
@@ -278,6 +278,52 @@ func testAccRemediationConfiguration_values(t *testing.T) {
 	})
 }
 
+func testAccRemediationConfiguration_automaticAttempts(t *testing.T) {
+	ctx := acctest.Context(t)
+	var original configservice.RemediationConfiguration
+	var updated configservice.RemediationConfiguration
+	resourceName := "aws_config_remediation_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRemediationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRemediationConfigurationConfig_automaticAttempts(rName, 5, 60, 25, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRemediationConfigurationExists(ctx, resourceName, &original),
+					resource.TestCheckResourceAttr(resourceName, "automatic", "true"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_automatic_attempts", "5"),
+					resource.TestCheckResourceAttr(resourceName, "retry_attempt_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "execution_controls.0.ssm_controls.0.concurrent_execution_rate_percentage", "25"),
+					resource.TestCheckResourceAttr(resourceName, "execution_controls.0.ssm_controls.0.error_percentage", "20"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_first_execution", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_first_execution"},
+			},
+			{
+				Config: testAccRemediationConfigurationConfig_automaticAttempts(rName, 10, 120, 50, 40),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRemediationConfigurationExists(ctx, resourceName, &updated),
+					testAccCheckRemediationConfigurationNotRecreated(&original, &updated),
+					resource.TestCheckResourceAttr(resourceName, "maximum_automatic_attempts", "10"),
+					resource.TestCheckResourceAttr(resourceName, "retry_attempt_seconds", "120"),
+					resource.TestCheckResourceAttr(resourceName, "execution_controls.0.ssm_controls.0.concurrent_execution_rate_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "execution_controls.0.ssm_controls.0.error_percentage", "40"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRemediationConfigurationExists(ctx context.Context, n string, obj *configservice.RemediationConfiguration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -646,3 +692,130 @@ EOF
 }
 `, rName, sseAlgorithm, randAttempts, randSeconds, randExecPct, randErrorPct, automatic)
 }
+
+func testAccRemediationConfigurationConfig_automaticAttempts(rName string, attempts, seconds, execPct, errorPct int) string {
+	return fmt.Sprintf(`
+resource "aws_config_remediation_configuration" "test" {
+  config_rule_name = aws_config_config_rule.test.name
+
+  resource_type  = "AWS::S3::Bucket"
+  target_id      = "AWS-ConfigureS3BucketVersioning"
+  target_type    = "SSM_DOCUMENT"
+  target_version = "1"
+
+  parameter {
+    name         = "AutomationAssumeRole"
+    static_value = aws_iam_role.test.arn
+  }
+
+  parameter {
+    name           = "BucketName"
+    resource_value = "RESOURCE_ID"
+  }
+
+  automatic                  = true
+  maximum_automatic_attempts = %[2]d
+  retry_attempt_seconds      = %[3]d
+  wait_for_first_execution   = true
+
+  execution_controls {
+    ssm_controls {
+      concurrent_execution_rate_percentage = %[4]d
+      error_percentage                     = %[5]d
+    }
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+
+  depends_on = [aws_config_config_rule.test]
+}
+
+resource "aws_config_config_rule" "test" {
+  name = %[1]q
+
+  scope {
+    compliance_resource_types = ["AWS::S3::Bucket"]
+  }
+
+  source {
+    owner             = "AWS"
+    source_identifier = "S3_BUCKET_VERSIONING_ENABLED"
+  }
+
+  depends_on = [aws_config_configuration_recorder_status.test]
+}
+
+resource "aws_config_configuration_recorder_status" "test" {
+  name       = aws_config_configuration_recorder.test.name
+  is_enabled = true
+
+  depends_on = [aws_config_delivery_channel.test]
+}
+
+resource "aws_config_delivery_channel" "test" {
+  name           = %[1]q
+  s3_bucket_name = aws_s3_bucket.delivery.bucket
+}
+
+resource "aws_s3_bucket" "delivery" {
+  bucket        = "%[1]s-delivery"
+  force_destroy = true
+}
+
+resource "aws_config_configuration_recorder" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": ["config.amazonaws.com", "ssm.amazonaws.com"]
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWS_ConfigRole"
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": [
+        "s3:GetBucketAcl",
+        "s3:GetBucketVersioning",
+        "s3:PutBucketVersioning",
+        "s3:PutObject"
+      ],
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+`, rName, attempts, seconds, execPct, errorPct)
+}
@@ -0,0 +1,249 @@
+package configservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceRemediationException() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRemediationExceptionCreate,
+		ReadWithoutTimeout:   resourceRemediationExceptionRead,
+		UpdateWithoutTimeout: resourceRemediationExceptionUpdate,
+		DeleteWithoutTimeout: resourceRemediationExceptionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"config_rule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"expiration_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidUTCTimestamp,
+			},
+			"message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"resource_key": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"resource_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceRemediationExceptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	name := d.Get("config_rule_name").(string)
+
+	if err := putRemediationExceptions(ctx, conn, d, expandRemediationExceptionResourceKeys(d.Get("resource_key").(*schema.Set).List())); err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionCreating, ResNameRemediationException, name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceRemediationExceptionRead(ctx, d, meta)...)
+}
+
+func resourceRemediationExceptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	// Only the exceptions of this resource's keys are read so that several resources can manage exceptions of the same rule.
+	// On import all exceptions of the rule are read.
+	resourceKeys := expandRemediationExceptionResourceKeys(d.Get("resource_key").(*schema.Set).List())
+	exceptions, err := FindRemediationExceptions(ctx, conn, d.Id(), resourceKeys)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.ConfigService, create.ErrActionReading, ResNameRemediationException, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionReading, ResNameRemediationException, d.Id(), err)
+	}
+
+	exception := exceptions[0]
+	d.Set("config_rule_name", exception.ConfigRuleName)
+	if exception.ExpirationTime != nil {
+		d.Set("expiration_time", aws.TimeValue(exception.ExpirationTime).Format(time.RFC3339))
+	} else {
+		d.Set("expiration_time", nil)
+	}
+	d.Set("message", exception.Message)
+
+	if err := d.Set("resource_key", flattenRemediationExceptionResourceKeys(exceptions)); err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionReading, ResNameRemediationException, d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceRemediationExceptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	if d.HasChange("resource_key") {
+		o, n := d.GetChange("resource_key")
+
+		if del := o.(*schema.Set).Difference(n.(*schema.Set)).List(); len(del) > 0 {
+			if err := deleteRemediationExceptions(ctx, conn, d.Id(), expandRemediationExceptionResourceKeys(del)); err != nil {
+				return create.DiagError(names.ConfigService, create.ErrActionUpdating, ResNameRemediationException, d.Id(), err)
+			}
+		}
+	}
+
+	if err := putRemediationExceptions(ctx, conn, d, expandRemediationExceptionResourceKeys(d.Get("resource_key").(*schema.Set).List())); err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionUpdating, ResNameRemediationException, d.Id(), err)
+	}
+
+	return append(diags, resourceRemediationExceptionRead(ctx, d, meta)...)
+}
+
+func resourceRemediationExceptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	if err := deleteRemediationExceptions(ctx, conn, d.Id(), expandRemediationExceptionResourceKeys(d.Get("resource_key").(*schema.Set).List())); err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionDeleting, ResNameRemediationException, d.Id(), err)
+	}
+
+	return diags
+}
+
+func putRemediationExceptions(ctx context.Context, conn *configservice.ConfigService, d *schema.ResourceData, resourceKeys []*configservice.RemediationExceptionResourceKey) error {
+	input := &configservice.PutRemediationExceptionsInput{
+		ConfigRuleName: aws.String(d.Get("config_rule_name").(string)),
+		ResourceKeys:   resourceKeys,
+	}
+
+	if v, ok := d.GetOk("expiration_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExpirationTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk("message"); ok {
+		input.Message = aws.String(v.(string))
+	}
+
+	output, err := conn.PutRemediationExceptionsWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range output.FailedBatches {
+		if v != nil {
+			return errors.New(aws.StringValue(v.FailureMessage))
+		}
+	}
+
+	return nil
+}
+
+func deleteRemediationExceptions(ctx context.Context, conn *configservice.ConfigService, configRuleName string, resourceKeys []*configservice.RemediationExceptionResourceKey) error {
+	output, err := conn.DeleteRemediationExceptionsWithContext(ctx, &configservice.DeleteRemediationExceptionsInput{
+		ConfigRuleName: aws.String(configRuleName),
+		ResourceKeys:   resourceKeys,
+	})
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchRemediationExceptionException, configservice.ErrCodeNoSuchConfigRuleException) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range output.FailedBatches {
+		if v == nil {
+			continue
+		}
+
+		// Exceptions that no longer exist are reported as failed items.
+		if _, err := FindRemediationExceptions(ctx, conn, configRuleName, v.FailedItems); tfresource.NotFound(err) {
+			continue
+		}
+
+		return fmt.Errorf("%d resource keys: %s", len(v.FailedItems), aws.StringValue(v.FailureMessage))
+	}
+
+	return nil
+}
+
+func expandRemediationExceptionResourceKeys(tfList []interface{}) []*configservice.RemediationExceptionResourceKey {
+	var apiObjects []*configservice.RemediationExceptionResourceKey
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &configservice.RemediationExceptionResourceKey{
+			ResourceId:   aws.String(tfMap["resource_id"].(string)),
+			ResourceType: aws.String(tfMap["resource_type"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenRemediationExceptionResourceKeys(apiObjects []*configservice.RemediationException) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"resource_id":   aws.StringValue(apiObject.ResourceId),
+			"resource_type": aws.StringValue(apiObject.ResourceType),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,205 @@
+package configservice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfconfigservice "github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRemediationException_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var exceptions []*configservice.RemediationException
+	rInt := sdkacctest.RandInt()
+	resourceName := "aws_config_remediation_exception.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRemediationExceptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRemediationExceptionConfig_basic(rInt, "bucket-a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRemediationExceptionExists(ctx, resourceName, &exceptions),
+					resource.TestCheckResourceAttrPair(resourceName, "config_rule_name", "aws_config_config_rule.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_time", ""),
+					resource.TestCheckResourceAttr(resourceName, "message", ""),
+					resource.TestCheckResourceAttr(resourceName, "resource_key.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_key.*", map[string]string{
+						"resource_id":   fmt.Sprintf("tf-acc-test-%d-bucket-a", rInt),
+						"resource_type": "AWS::S3::Bucket",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRemediationExceptionConfig_basic(rInt, "bucket-b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRemediationExceptionExists(ctx, resourceName, &exceptions),
+					resource.TestCheckResourceAttr(resourceName, "resource_key.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "resource_key.*", map[string]string{
+						"resource_id":   fmt.Sprintf("tf-acc-test-%d-bucket-b", rInt),
+						"resource_type": "AWS::S3::Bucket",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccRemediationException_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var exceptions []*configservice.RemediationException
+	rInt := sdkacctest.RandInt()
+	resourceName := "aws_config_remediation_exception.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRemediationExceptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRemediationExceptionConfig_basic(rInt, "bucket-a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRemediationExceptionExists(ctx, resourceName, &exceptions),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconfigservice.ResourceRemediationException(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccRemediationException_messageAndExpiration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var exceptions []*configservice.RemediationException
+	rInt := sdkacctest.RandInt()
+	resourceName := "aws_config_remediation_exception.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRemediationExceptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRemediationExceptionConfig_messageAndExpiration(rInt, "accepted risk", "2099-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRemediationExceptionExists(ctx, resourceName, &exceptions),
+					resource.TestCheckResourceAttr(resourceName, "expiration_time", "2099-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "message", "accepted risk"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRemediationExceptionConfig_messageAndExpiration(rInt, "accepted risk, reviewed", "2099-06-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRemediationExceptionExists(ctx, resourceName, &exceptions),
+					resource.TestCheckResourceAttr(resourceName, "expiration_time", "2099-06-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "message", "accepted risk, reviewed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRemediationExceptionExists(ctx context.Context, resourceName string, exceptions *[]*configservice.RemediationException) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return create.Error(names.ConfigService, create.ErrActionCheckingExistence, tfconfigservice.ResNameRemediationException, resourceName, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.ConfigService, create.ErrActionCheckingExistence, tfconfigservice.ResNameRemediationException, resourceName, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn()
+
+		output, err := tfconfigservice.FindRemediationExceptions(ctx, conn, rs.Primary.ID, nil)
+
+		if err != nil {
+			return create.Error(names.ConfigService, create.ErrActionCheckingExistence, tfconfigservice.ResNameRemediationException, rs.Primary.ID, err)
+		}
+
+		*exceptions = output
+
+		return nil
+	}
+}
+
+func testAccCheckRemediationExceptionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_config_remediation_exception" {
+				continue
+			}
+
+			_, err := tfconfigservice.FindRemediationExceptions(ctx, conn, rs.Primary.ID, nil)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.ConfigService, create.ErrActionCheckingDestroyed, tfconfigservice.ResNameRemediationException, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.ConfigService, create.ErrActionCheckingDestroyed, tfconfigservice.ResNameRemediationException, rs.Primary.ID, errors.New("still exists"))
+		}
+
+		return nil
+	}
+}
+
+func testAccRemediationExceptionConfig_basic(rInt int, bucket string) string {
+	return acctest.ConfigCompose(testAccRemediationConfigurationConfig_basic("tf-acc-test", "AES256", rInt, 5, 60, 25, 20, "false"), fmt.Sprintf(`
+resource "aws_config_remediation_exception" "test" {
+  config_rule_name = aws_config_remediation_configuration.test.config_rule_name
+
+  resource_key {
+    resource_type = "AWS::S3::Bucket"
+    resource_id   = "tf-acc-test-%[1]d-%[2]s"
+  }
+}
+`, rInt, bucket))
+}
+
+func testAccRemediationExceptionConfig_messageAndExpiration(rInt int, message, expirationTime string) string {
+	return acctest.ConfigCompose(testAccRemediationConfigurationConfig_basic("tf-acc-test", "AES256", rInt, 5, 60, 25, 20, "false"), fmt.Sprintf(`
+resource "aws_config_remediation_exception" "test" {
+  config_rule_name = aws_config_remediation_configuration.test.config_rule_name
+  message          = %[2]q
+  expiration_time  = %[3]q
+
+  resource_key {
+    resource_type = "AWS::S3::Bucket"
+    resource_id   = "tf-acc-test-%[1]d-bucket-a"
+  }
+}
+`, rInt, message, expirationTime))
+}
@@ -0,0 +1,109 @@
+package configservice
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceRetentionConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRetentionConfigurationPut,
+		ReadWithoutTimeout:   resourceRetentionConfigurationRead,
+		UpdateWithoutTimeout: resourceRetentionConfigurationPut,
+		DeleteWithoutTimeout: resourceRetentionConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"retention_period_in_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(30, 2557),
+			},
+		},
+	}
+}
+
+func resourceRetentionConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	input := &configservice.PutRetentionConfigurationInput{
+		RetentionPeriodInDays: aws.Int64(int64(d.Get("retention_period_in_days").(int))),
+	}
+
+	output, err := conn.PutRetentionConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		action := create.ErrActionUpdating
+		if d.IsNewResource() {
+			action = create.ErrActionCreating
+		}
+
+		return create.DiagError(names.ConfigService, action, ResNameRetentionConfiguration, d.Id(), err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(aws.StringValue(output.RetentionConfiguration.Name))
+	}
+
+	return append(diags, resourceRetentionConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceRetentionConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	configuration, err := FindRetentionConfigurationByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.ConfigService, create.ErrActionReading, ResNameRetentionConfiguration, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionReading, ResNameRetentionConfiguration, d.Id(), err)
+	}
+
+	d.Set("name", configuration.Name)
+	d.Set("retention_period_in_days", configuration.RetentionPeriodInDays)
+
+	return diags
+}
+
+// resourceRetentionConfigurationDelete removes the retention configuration, which restores the default retention period of 2557 days.
+func resourceRetentionConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	_, err := conn.DeleteRetentionConfigurationWithContext(ctx, &configservice.DeleteRetentionConfigurationInput{
+		RetentionConfigurationName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchRetentionConfigurationException) {
+		return diags
+	}
+
+	if err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionDeleting, ResNameRetentionConfiguration, d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,136 @@
+package configservice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfconfigservice "github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRetentionConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rc configservice.RetentionConfiguration
+	resourceName := "aws_config_retention_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRetentionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRetentionConfigurationConfig_basic(90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRetentionConfigurationExists(ctx, resourceName, &rc),
+					resource.TestCheckResourceAttr(resourceName, "name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period_in_days", "90"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRetentionConfigurationConfig_basic(180),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRetentionConfigurationExists(ctx, resourceName, &rc),
+					resource.TestCheckResourceAttr(resourceName, "name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "retention_period_in_days", "180"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRetentionConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rc configservice.RetentionConfiguration
+	resourceName := "aws_config_retention_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRetentionConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRetentionConfigurationConfig_basic(90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRetentionConfigurationExists(ctx, resourceName, &rc),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconfigservice.ResourceRetentionConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRetentionConfigurationExists(ctx context.Context, resourceName string, rc *configservice.RetentionConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return create.Error(names.ConfigService, create.ErrActionCheckingExistence, tfconfigservice.ResNameRetentionConfiguration, resourceName, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.ConfigService, create.ErrActionCheckingExistence, tfconfigservice.ResNameRetentionConfiguration, resourceName, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn()
+
+		output, err := tfconfigservice.FindRetentionConfigurationByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.ConfigService, create.ErrActionCheckingExistence, tfconfigservice.ResNameRetentionConfiguration, rs.Primary.ID, err)
+		}
+
+		*rc = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRetentionConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_config_retention_configuration" {
+				continue
+			}
+
+			_, err := tfconfigservice.FindRetentionConfigurationByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.ConfigService, create.ErrActionCheckingDestroyed, tfconfigservice.ResNameRetentionConfiguration, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.ConfigService, create.ErrActionCheckingDestroyed, tfconfigservice.ResNameRetentionConfiguration, rs.Primary.ID, errors.New("still exists"))
+		}
+
+		return nil
+	}
+}
+
+func testAccRetentionConfigurationConfig_basic(days int) string {
+	return fmt.Sprintf(`
+resource "aws_config_retention_configuration" "test" {
+  retention_period_in_days = %[1]d
+}
+`, days)
+}
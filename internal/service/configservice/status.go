@@ -2,6 +2,7 @@ package configservice
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
@@ -9,6 +10,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	remediationExecutionStateNotStarted = "NOT_STARTED"
+)
+
 func statusRule(ctx context.Context, conn *configservice.ConfigService, name string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindConfigRule(ctx, conn, name)
@@ -24,3 +29,114 @@ func statusRule(ctx context.Context, conn *configservice.ConfigService, name str
 		return output, aws.StringValue(output.ConfigRuleState), nil
 	}
 }
+
+// statusRemediationExecution aggregates the remediation execution statuses of a Config Rule.
+// Any queued or running execution reports IN_PROGRESS, otherwise any failed execution reports FAILED.
+func statusRemediationExecution(ctx context.Context, conn *configservice.ConfigService, configRuleName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindRemediationExecutionStatuses(ctx, conn, configRuleName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(output) == 0 {
+			return output, remediationExecutionStateNotStarted, nil
+		}
+
+		state := configservice.RemediationExecutionStateSucceeded
+
+		for _, v := range output {
+			switch aws.StringValue(v.State) {
+			case configservice.RemediationExecutionStateQueued, configservice.RemediationExecutionStateInProgress:
+				return output, configservice.RemediationExecutionStateInProgress, nil
+			case configservice.RemediationExecutionStateFailed:
+				state = configservice.RemediationExecutionStateFailed
+			}
+		}
+
+		return output, state, nil
+	}
+}
+
+// statusConfigurationAggregatorSources aggregates the source statuses of a Configuration Aggregator.
+// Sources that have not reported yet or are still outdated report OUTDATED, otherwise any failed source reports FAILED.
+func statusConfigurationAggregatorSources(ctx context.Context, conn *configservice.ConfigService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindConfigurationAggregatorSourcesStatus(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(output) == 0 {
+			return output, configservice.AggregatedSourceStatusTypeOutdated, nil
+		}
+
+		state := configservice.AggregatedSourceStatusTypeSucceeded
+
+		for _, v := range output {
+			switch aws.StringValue(v.LastUpdateStatus) {
+			case configservice.AggregatedSourceStatusTypeOutdated:
+				return output, configservice.AggregatedSourceStatusTypeOutdated, nil
+			case configservice.AggregatedSourceStatusTypeFailed:
+				state = configservice.AggregatedSourceStatusTypeFailed
+			}
+		}
+
+		return output, state, nil
+	}
+}
+
+func statusConfigurationRecorderRecording(ctx context.Context, conn *configservice.ConfigService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindConfigurationRecorderStatusByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, strconv.FormatBool(aws.BoolValue(output.Recording)), nil
+	}
+}
+
+func statusConformancePackRulesEvaluated(ctx context.Context, conn *configservice.ConfigService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		names, err := FindConformancePackRuleNames(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		statuses, err := FindConfigRuleEvaluationStatuses(ctx, conn, names)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		evaluated := len(statuses) == len(names)
+		for _, v := range statuses {
+			if v.LastSuccessfulEvaluationTime == nil && v.LastFailedEvaluationTime == nil {
+				evaluated = false
+			}
+		}
+
+		return statuses, strconv.FormatBool(evaluated), nil
+	}
+}
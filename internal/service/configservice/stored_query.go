@@ -0,0 +1,198 @@
+package configservice
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func ResourceStoredQuery() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStoredQueryCreate,
+		ReadWithoutTimeout:   resourceStoredQueryRead,
+		UpdateWithoutTimeout: resourceStoredQueryUpdate,
+		DeleteWithoutTimeout: resourceStoredQueryDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"expression": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 64),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+			"query_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceStoredQueryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &configservice.PutStoredQueryInput{
+		StoredQuery: expandStoredQuery(d),
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	_, err := conn.PutStoredQueryWithContext(ctx, input)
+
+	if err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionCreating, ResNameStoredQuery, name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceStoredQueryRead(ctx, d, meta)...)
+}
+
+func resourceStoredQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	query, err := FindStoredQueryByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.ConfigService, create.ErrActionReading, ResNameStoredQuery, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionReading, ResNameStoredQuery, d.Id(), err)
+	}
+
+	arn := aws.StringValue(query.QueryArn)
+	d.Set("arn", arn)
+	d.Set("description", query.Description)
+	d.Set("expression", query.Expression)
+	d.Set("name", query.QueryName)
+	d.Set("query_id", query.QueryId)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Config Stored Query (%s): %s", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceStoredQueryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	if d.HasChanges("description", "expression") {
+		input := &configservice.PutStoredQueryInput{
+			StoredQuery: expandStoredQuery(d),
+		}
+
+		_, err := conn.PutStoredQueryWithContext(ctx, input)
+
+		if err != nil {
+			return create.DiagError(names.ConfigService, create.ErrActionUpdating, ResNameStoredQuery, d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		arn := d.Get("arn").(string)
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, arn, o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Config Stored Query (%s) tags: %s", arn, err)
+		}
+	}
+
+	return append(diags, resourceStoredQueryRead(ctx, d, meta)...)
+}
+
+func resourceStoredQueryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ConfigServiceConn()
+
+	_, err := conn.DeleteStoredQueryWithContext(ctx, &configservice.DeleteStoredQueryInput{
+		QueryName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return create.DiagError(names.ConfigService, create.ErrActionDeleting, ResNameStoredQuery, d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandStoredQuery(d *schema.ResourceData) *configservice.StoredQuery {
+	query := &configservice.StoredQuery{
+		Expression: aws.String(d.Get("expression").(string)),
+		QueryName:  aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		query.Description = aws.String(v.(string))
+	}
+
+	return query
+}
@@ -0,0 +1,266 @@
+package configservice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfconfigservice "github.com/hashicorp/terraform-provider-aws/internal/service/configservice"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccConfigServiceStoredQuery_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var query configservice.StoredQuery
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_config_stored_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStoredQueryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStoredQueryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStoredQueryExists(ctx, resourceName, &query),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "config", regexp.MustCompile(fmt.Sprintf("stored-query/%s/.+", rName))),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "expression", "SELECT resourceId WHERE resourceType = 'AWS::EC2::Instance'"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "query_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccConfigServiceStoredQuery_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var query configservice.StoredQuery
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_config_stored_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStoredQueryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStoredQueryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStoredQueryExists(ctx, resourceName, &query),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconfigservice.ResourceStoredQuery(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccConfigServiceStoredQuery_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after configservice.StoredQuery
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_config_stored_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStoredQueryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStoredQueryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStoredQueryExists(ctx, resourceName, &before),
+				),
+			},
+			{
+				Config: testAccStoredQueryConfig_description(rName, "instances by type", "SELECT resourceId, configuration.instanceType WHERE resourceType = 'AWS::EC2::Instance'"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStoredQueryExists(ctx, resourceName, &after),
+					testAccCheckStoredQueryNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "description", "instances by type"),
+					resource.TestCheckResourceAttr(resourceName, "expression", "SELECT resourceId, configuration.instanceType WHERE resourceType = 'AWS::EC2::Instance'"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccConfigServiceStoredQuery_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var query configservice.StoredQuery
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_config_stored_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, configservice.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStoredQueryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStoredQueryConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStoredQueryExists(ctx, resourceName, &query),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStoredQueryConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStoredQueryExists(ctx, resourceName, &query),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccStoredQueryConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStoredQueryExists(ctx, resourceName, &query),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStoredQueryExists(ctx context.Context, resourceName string, query *configservice.StoredQuery) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return create.Error(names.ConfigService, create.ErrActionCheckingExistence, tfconfigservice.ResNameStoredQuery, resourceName, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.ConfigService, create.ErrActionCheckingExistence, tfconfigservice.ResNameStoredQuery, resourceName, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn()
+
+		output, err := tfconfigservice.FindStoredQueryByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return create.Error(names.ConfigService, create.ErrActionCheckingExistence, tfconfigservice.ResNameStoredQuery, rs.Primary.ID, err)
+		}
+
+		*query = *output
+
+		return nil
+	}
+}
+
+func testAccCheckStoredQueryDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConfigServiceConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_config_stored_query" {
+				continue
+			}
+
+			_, err := tfconfigservice.FindStoredQueryByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.ConfigService, create.ErrActionCheckingDestroyed, tfconfigservice.ResNameStoredQuery, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.ConfigService, create.ErrActionCheckingDestroyed, tfconfigservice.ResNameStoredQuery, rs.Primary.ID, errors.New("still exists"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckStoredQueryNotRecreated(before, after *configservice.StoredQuery) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := *before.QueryId, *after.QueryId; before != after {
+			return fmt.Errorf("Config Stored Query (%s) recreated", after)
+		}
+
+		return nil
+	}
+}
+
+func testAccStoredQueryConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_config_stored_query" "test" {
+  name       = %[1]q
+  expression = "SELECT resourceId WHERE resourceType = 'AWS::EC2::Instance'"
+}
+`, rName)
+}
+
+func testAccStoredQueryConfig_description(rName, description, expression string) string {
+	return fmt.Sprintf(`
+resource "aws_config_stored_query" "test" {
+  name        = %[1]q
+  description = %[2]q
+  expression  = %[3]q
+}
+`, rName, description, expression)
+}
+
+func testAccStoredQueryConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_config_stored_query" "test" {
+  name       = %[1]q
+  expression = "SELECT resourceId WHERE resourceType = 'AWS::EC2::Instance'"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccStoredQueryConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_config_stored_query" "test" {
+  name       = %[1]q
+  expression = "SELECT resourceId WHERE resourceType = 'AWS::EC2::Instance'"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
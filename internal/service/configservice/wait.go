@@ -2,9 +2,13 @@ package configservice
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -12,7 +16,7 @@ const (
 	ruleDeletedTimeout = 5 * time.Minute
 )
 
-func waitRuleDeleted(ctx context.Context, conn *configservice.ConfigService, name string) (*configservice.ConfigRule, error) {
+func waitRuleDeleted(ctx context.Context, conn *configservice.ConfigService, name string, timeout time.Duration) (*configservice.ConfigRule, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			configservice.ConfigRuleStateActive,
@@ -22,7 +26,7 @@ func waitRuleDeleted(ctx context.Context, conn *configservice.ConfigService, nam
 		},
 		Target:  []string{},
 		Refresh: statusRule(ctx, conn, name),
-		Timeout: ruleDeletedTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -33,3 +37,113 @@ func waitRuleDeleted(ctx context.Context, conn *configservice.ConfigService, nam
 
 	return nil, err
 }
+
+func waitRemediationExecutionFinished(ctx context.Context, conn *configservice.ConfigService, configRuleName string, timeout time.Duration) ([]*configservice.RemediationExecutionStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			remediationExecutionStateNotStarted,
+			configservice.RemediationExecutionStateInProgress,
+		},
+		Target: []string{
+			configservice.RemediationExecutionStateFailed,
+			configservice.RemediationExecutionStateSucceeded,
+		},
+		Refresh: statusRemediationExecution(ctx, conn, configRuleName),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]*configservice.RemediationExecutionStatus); ok {
+		if err == nil {
+			err = remediationExecutionError(output)
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func remediationExecutionError(statuses []*configservice.RemediationExecutionStatus) error {
+	var errs *multierror.Error
+
+	for _, status := range statuses {
+		if aws.StringValue(status.State) != configservice.RemediationExecutionStateFailed {
+			continue
+		}
+
+		var resourceID string
+		if status.ResourceKey != nil {
+			resourceID = aws.StringValue(status.ResourceKey.ResourceId)
+		}
+
+		var stepErrors int
+		for _, step := range status.StepDetails {
+			if v := aws.StringValue(step.ErrorMessage); v != "" {
+				errs = multierror.Append(errs, fmt.Errorf("remediation of resource (%s) failed in step (%s): %s", resourceID, aws.StringValue(step.Name), v))
+				stepErrors++
+			}
+		}
+
+		if stepErrors == 0 {
+			errs = multierror.Append(errs, fmt.Errorf("remediation of resource (%s) failed", resourceID))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func waitConfigurationAggregatorSourcesAggregated(ctx context.Context, conn *configservice.ConfigService, name string, timeout time.Duration) ([]*configservice.AggregatedSourceStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{configservice.AggregatedSourceStatusTypeOutdated},
+		Target: []string{
+			configservice.AggregatedSourceStatusTypeFailed,
+			configservice.AggregatedSourceStatusTypeSucceeded,
+		},
+		Refresh: statusConfigurationAggregatorSources(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]*configservice.AggregatedSourceStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConfigurationRecorderRecording(ctx context.Context, conn *configservice.ConfigService, name string, recording bool, timeout time.Duration) (*configservice.ConfigurationRecorderStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{strconv.FormatBool(!recording)},
+		Target:  []string{strconv.FormatBool(recording)},
+		Refresh: statusConfigurationRecorderRecording(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*configservice.ConfigurationRecorderStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitConformancePackRulesEvaluated(ctx context.Context, conn *configservice.ConfigService, name string, timeout time.Duration) ([]*configservice.ConfigRuleEvaluationStatus, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{strconv.FormatBool(false)},
+		Target:  []string{strconv.FormatBool(true)},
+		Refresh: statusConformancePackRulesEvaluated(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]*configservice.ConfigRuleEvaluationStatus); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 func TestAccECRScanningConfiguration_serial(t *testing.T) {
@@ -28,16 +27,16 @@ func testAccRegistryScanningConfiguration_basic(t *testing.T) {
 	var v ecr.GetRegistryScanningConfigurationOutput
 	resourceName := "aws_ecr_registry_scanning_configuration.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRegistryScanningConfigurationDestroy(ctx),
+		CheckDestroy:             testAccCheckRegistryScanningConfigurationDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRegistryScanningConfigurationConfig_basic(),
 				Check: resource.ComposeTestCheckFunc(
-					testAccRegistryScanningConfigurationExists(ctx, resourceName, &v),
+					testAccRegistryScanningConfigurationExists(ctx, t, resourceName, &v),
 					acctest.CheckResourceAttrAccountID(resourceName, "registry_id"),
 					resource.TestCheckResourceAttr(resourceName, "rule.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "scan_type", "BASIC"),
@@ -57,16 +56,16 @@ func testAccRegistryScanningConfiguration_update(t *testing.T) {
 	var v ecr.GetRegistryScanningConfigurationOutput
 	resourceName := "aws_ecr_registry_scanning_configuration.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRegistryScanningConfigurationDestroy(ctx),
+		CheckDestroy:             testAccCheckRegistryScanningConfigurationDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRegistryScanningConfigurationConfig_oneRule(),
 				Check: resource.ComposeTestCheckFunc(
-					testAccRegistryScanningConfigurationExists(ctx, resourceName, &v),
+					testAccRegistryScanningConfigurationExists(ctx, t, resourceName, &v),
 					acctest.CheckResourceAttrAccountID(resourceName, "registry_id"),
 					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
@@ -87,7 +86,7 @@ func testAccRegistryScanningConfiguration_update(t *testing.T) {
 			{
 				Config: testAccRegistryScanningConfigurationConfig_twoRules(),
 				Check: resource.ComposeTestCheckFunc(
-					testAccRegistryScanningConfigurationExists(ctx, resourceName, &v),
+					testAccRegistryScanningConfigurationExists(ctx, t, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
 						"scan_frequency": "CONTINUOUS_SCAN",
@@ -110,9 +109,9 @@ func testAccRegistryScanningConfiguration_update(t *testing.T) {
 	})
 }
 
-func testAccCheckRegistryScanningConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckRegistryScanningConfigurationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn()
+		conn := acctest.ProviderMeta(t).ECRConn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_ecr_registry_scanning_configuration" {
@@ -130,7 +129,7 @@ func testAccCheckRegistryScanningConfigurationDestroy(ctx context.Context) resou
 	}
 }
 
-func testAccRegistryScanningConfigurationExists(ctx context.Context, name string, v *ecr.GetRegistryScanningConfigurationOutput) resource.TestCheckFunc {
+func testAccRegistryScanningConfigurationExists(ctx context.Context, t *testing.T, name string, v *ecr.GetRegistryScanningConfigurationOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
 		if !ok {
@@ -141,7 +140,7 @@ func testAccRegistryScanningConfigurationExists(ctx context.Context, name string
 			return fmt.Errorf("No ECR Registry Scanning Configuration ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn()
+		conn := acctest.ProviderMeta(t).ECRConn()
 
 		output, err := conn.GetRegistryScanningConfigurationWithContext(ctx, &ecr.GetRegistryScanningConfigurationInput{})
 
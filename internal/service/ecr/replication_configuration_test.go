@@ -9,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 func TestAccECRReplicationConfiguration_serial(t *testing.T) {
@@ -27,16 +26,16 @@ func testAccReplicationConfiguration_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ecr_replication_configuration.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckReplicationConfigurationDestroy(ctx),
+		CheckDestroy:             testAccCheckReplicationConfigurationDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccReplicationConfigurationConfig_basic(acctest.AlternateRegion()),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckReplicationConfigurationExists(ctx, resourceName),
+					testAccCheckReplicationConfigurationExists(ctx, t, resourceName),
 					acctest.CheckResourceAttrAccountID(resourceName, "registry_id"),
 					resource.TestCheckResourceAttr(resourceName, "replication_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.#", "1"),
@@ -54,7 +53,7 @@ func testAccReplicationConfiguration_basic(t *testing.T) {
 			{
 				Config: testAccReplicationConfigurationConfig_multipleRegion(acctest.AlternateRegion(), acctest.ThirdRegion()),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckReplicationConfigurationExists(ctx, resourceName),
+					testAccCheckReplicationConfigurationExists(ctx, t, resourceName),
 					acctest.CheckResourceAttrAccountID(resourceName, "registry_id"),
 					resource.TestCheckResourceAttr(resourceName, "replication_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.#", "1"),
@@ -69,7 +68,7 @@ func testAccReplicationConfiguration_basic(t *testing.T) {
 			{
 				Config: testAccReplicationConfigurationConfig_basic(acctest.AlternateRegion()),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckReplicationConfigurationExists(ctx, resourceName),
+					testAccCheckReplicationConfigurationExists(ctx, t, resourceName),
 					acctest.CheckResourceAttrAccountID(resourceName, "registry_id"),
 					resource.TestCheckResourceAttr(resourceName, "replication_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.#", "1"),
@@ -87,16 +86,16 @@ func testAccReplicationConfiguration_repositoryFilter(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ecr_replication_configuration.test"
 
-	resource.Test(t, resource.TestCase{
+	acctest.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ecr.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckReplicationConfigurationDestroy(ctx),
+		CheckDestroy:             testAccCheckReplicationConfigurationDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccReplicationConfigurationConfig_repositoryFilter(acctest.AlternateRegion()),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckReplicationConfigurationExists(ctx, resourceName),
+					testAccCheckReplicationConfigurationExists(ctx, t, resourceName),
 					acctest.CheckResourceAttrAccountID(resourceName, "registry_id"),
 					resource.TestCheckResourceAttr(resourceName, "replication_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.#", "1"),
@@ -114,7 +113,7 @@ func testAccReplicationConfiguration_repositoryFilter(t *testing.T) {
 			{
 				Config: testAccReplicationConfigurationConfig_repositoryFilterMultiple(acctest.AlternateRegion()),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckReplicationConfigurationExists(ctx, resourceName),
+					testAccCheckReplicationConfigurationExists(ctx, t, resourceName),
 					acctest.CheckResourceAttrAccountID(resourceName, "registry_id"),
 					resource.TestCheckResourceAttr(resourceName, "replication_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.#", "1"),
@@ -128,7 +127,7 @@ func testAccReplicationConfiguration_repositoryFilter(t *testing.T) {
 			{
 				Config: testAccReplicationConfigurationConfig_repositoryFilter(acctest.AlternateRegion()),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckReplicationConfigurationExists(ctx, resourceName),
+					testAccCheckReplicationConfigurationExists(ctx, t, resourceName),
 					acctest.CheckResourceAttrAccountID(resourceName, "registry_id"),
 					resource.TestCheckResourceAttr(resourceName, "replication_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "replication_configuration.0.rule.#", "1"),
@@ -142,14 +141,14 @@ func testAccReplicationConfiguration_repositoryFilter(t *testing.T) {
 	})
 }
 
-func testAccCheckReplicationConfigurationExists(ctx context.Context, name string) resource.TestCheckFunc {
+func testAccCheckReplicationConfigurationExists(ctx context.Context, t *testing.T, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, ok := s.RootModule().Resources[name]
 		if !ok {
 			return fmt.Errorf("Not found: %s", name)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn()
+		conn := acctest.ProviderMeta(t).ECRConn()
 		out, err := conn.DescribeRegistryWithContext(ctx, &ecr.DescribeRegistryInput{})
 		if err != nil {
 			return fmt.Errorf("ECR replication rules not found: %w", err)
@@ -163,9 +162,9 @@ func testAccCheckReplicationConfigurationExists(ctx context.Context, name string
 	}
 }
 
-func testAccCheckReplicationConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckReplicationConfigurationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn()
+		conn := acctest.ProviderMeta(t).ECRConn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_ecr_replication_configuration" {
@@ -2,7 +2,9 @@ package ecr
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -135,8 +137,8 @@ func resourceRepositoryCreate(ctx context.Context, d *schema.ResourceData, meta
 	output, err := conn.CreateRepositoryWithContext(ctx, input)
 
 	// Some partitions (i.e., ISO) may not support tag-on-create
-	if input.Tags != nil && meta.(*conns.AWSClient).Partition != endpoints.AwsPartitionID && verify.ErrorISOUnsupported(conn.PartitionID, err) {
-		log.Printf("[WARN] failed creating ECR Repository (%s) with tags: %s. Trying create without tags.", d.Get("name").(string), err)
+	if input.Tags != nil && verify.ErrorTaggingUnsupported(meta.(*conns.AWSClient), err) {
+		diags = sdkdiag.AppendWarningf(diags, "failed creating ECR Repository (%s) with tags, retrying without tags: %s", name, err)
 		input.Tags = nil
 
 		output, err = conn.CreateRepositoryWithContext(ctx, input)
@@ -153,8 +155,8 @@ func resourceRepositoryCreate(ctx context.Context, d *schema.ResourceData, meta
 		err := UpdateTags(ctx, conn, aws.StringValue(output.Repository.RepositoryArn), nil, tags)
 
 		// If default tags only, log and continue. Otherwise, error.
-		if v, ok := d.GetOk("tags"); (!ok || len(v.(map[string]interface{})) == 0) && verify.ErrorISOUnsupported(conn.PartitionID, err) {
-			log.Printf("[WARN] failed adding tags after create for ECR Repository (%s): %s", d.Id(), err)
+		if v, ok := d.GetOk("tags"); (!ok || len(v.(map[string]interface{})) == 0) && verify.ErrorTaggingUnsupported(meta.(*conns.AWSClient), err) {
+			diags = sdkdiag.AppendWarningf(diags, "failed adding tags after create for ECR Repository (%s): %s", d.Id(), err)
 			return append(diags, resourceRepositoryRead(ctx, d, meta)...)
 		}
 
@@ -196,12 +198,13 @@ func resourceRepositoryRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("registry_id", repository.RegistryId)
 	d.Set("repository_url", repository.RepositoryUri)
 
-	tags, err := ListTags(ctx, conn, arn)
+	tags, err := meta.(*conns.AWSClient).BatchListTags(ctx, arn, d.IsNewResource() || d.HasChange("tags_all"), func() (tftags.KeyValueTags, error) {
+		return ListTags(ctx, conn, arn)
+	})
 
 	// Some partitions (i.e., ISO) may not support tagging, giving error
-	if meta.(*conns.AWSClient).Partition != endpoints.AwsPartitionID && verify.ErrorISOUnsupported(conn.PartitionID, err) {
-		log.Printf("[WARN] failed listing tags for ECR Repository (%s): %s", d.Id(), err)
-		return diags
+	if verify.ErrorTaggingUnsupported(meta.(*conns.AWSClient), err) {
+		return sdkdiag.AppendWarningf(diags, "failed listing tags for ECR Repository (%s): %s", d.Id(), err)
 	}
 
 	if err != nil {
@@ -265,8 +268,8 @@ func resourceRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta
 		err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n)
 
 		// Some partitions may not support tagging, giving error
-		if meta.(*conns.AWSClient).Partition != endpoints.AwsPartitionID && verify.ErrorISOUnsupported(conn.PartitionID, err) {
-			log.Printf("[WARN] failed updating tags for ECR Repository (%s): %s", d.Id(), err)
+		if verify.ErrorTaggingUnsupported(meta.(*conns.AWSClient), err) {
+			diags = sdkdiag.AppendWarningf(diags, "failed updating tags for ECR Repository (%s): %s", d.Id(), err)
 			return append(diags, resourceRepositoryRead(ctx, d, meta)...)
 		}
 
@@ -282,18 +285,40 @@ func resourceRepositoryDelete(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECRConn()
 
+	registryID := d.Get("registry_id").(string)
+	fd := &tfresource.ForceDeleter{
+		Force:   d.Get("force_delete").(bool),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Delete: func(ctx context.Context, force bool) error {
+			_, err := conn.DeleteRepositoryWithContext(ctx, &ecr.DeleteRepositoryInput{
+				Force:          aws.Bool(force),
+				RegistryId:     aws.String(registryID),
+				RepositoryName: aws.String(d.Id()),
+			})
+
+			return err
+		},
+		// Images may be pushed while a forced delete is in progress.
+		IsDependencyViolation: func(err error) bool {
+			return tfawserr.ErrCodeEquals(err, ecr.ErrCodeRepositoryNotEmptyException)
+		},
+		ListDependents: func(ctx context.Context) ([]string, error) {
+			return listRepositoryImages(ctx, conn, registryID, d.Id())
+		},
+	}
+
 	log.Printf("[DEBUG] Deleting ECR Repository: %s", d.Id())
-	_, err := conn.DeleteRepositoryWithContext(ctx, &ecr.DeleteRepositoryInput{
-		Force:          aws.Bool(d.Get("force_delete").(bool)),
-		RegistryId:     aws.String(d.Get("registry_id").(string)),
-		RepositoryName: aws.String(d.Id()),
-	})
+	err := fd.Run(ctx)
 
 	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeRepositoryNotFoundException) {
 		return diags
 	}
 
 	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeRepositoryNotEmptyException) {
+		if images, _ := fd.DryRun(ctx); images != "" {
+			return sdkdiag.AppendErrorf(diags, "ECR Repository (%s) not empty, consider using force_delete, which would also delete images %s: %s", d.Id(), images, err)
+		}
+
 		return sdkdiag.AppendErrorf(diags, "ECR Repository (%s) not empty, consider using force_delete: %s", d.Id(), err)
 	}
 
@@ -312,6 +337,32 @@ func resourceRepositoryDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// listRepositoryImages returns a description of each image in the specified repository.
+func listRepositoryImages(ctx context.Context, conn *ecr.ECR, registryID, name string) ([]string, error) {
+	imageDetails, err := FindImageDetails(ctx, conn, &ecr.DescribeImagesInput{
+		RegistryId:     aws.String(registryID),
+		RepositoryName: aws.String(name),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var images []string
+
+	for _, v := range imageDetails {
+		image := aws.StringValue(v.ImageDigest)
+
+		if tags := aws.StringValueSlice(v.ImageTags); len(tags) > 0 {
+			image = fmt.Sprintf("%s (%s)", image, strings.Join(tags, ", "))
+		}
+
+		images = append(images, image)
+	}
+
+	return images, nil
+}
+
 func FindRepositoryByName(ctx context.Context, conn *ecr.ECR, name string) (*ecr.Repository, error) {
 	input := &ecr.DescribeRepositoriesInput{
 		RepositoryNames: aws.StringSlice([]string{name}),
@@ -334,28 +385,26 @@ func FindRepositoryByName(ctx context.Context, conn *ecr.ECR, name string) (*ecr
 }
 
 func FindRepository(ctx context.Context, conn *ecr.ECR, input *ecr.DescribeRepositoriesInput) (*ecr.Repository, error) {
-	output, err := conn.DescribeRepositoriesWithContext(ctx, input)
-
-	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeRepositoryNotFoundException) {
-		return nil, &resource.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
-		}
-	}
+	output, err := findRepositories(ctx, conn, input)
 
 	if err != nil {
 		return nil, err
 	}
 
-	if output == nil || len(output.Repositories) == 0 || output.Repositories[0] == nil {
-		return nil, tfresource.NewEmptyResultError(input)
-	}
-
-	if count := len(output.Repositories); count > 1 {
-		return nil, tfresource.NewTooManyResultsError(count, input)
-	}
+	return tfresource.AssertSinglePtrResult(output, input)
+}
 
-	return output.Repositories[0], nil
+func findRepositories(ctx context.Context, conn *ecr.ECR, input *ecr.DescribeRepositoriesInput) ([]*ecr.Repository, error) {
+	return tfresource.FindAllPages(input,
+		func(fn func(*ecr.DescribeRepositoriesOutput, bool) bool) error {
+			return conn.DescribeRepositoriesPagesWithContext(ctx, input, fn)
+		},
+		func(page *ecr.DescribeRepositoriesOutput) []*ecr.Repository {
+			return page.Repositories
+		},
+		[]string{ecr.ErrCodeRepositoryNotFoundException},
+		tfresource.NotNil[ecr.Repository],
+	)
 }
 
 func flattenImageScanningConfiguration(isc *ecr.ImageScanningConfiguration) []map[string]interface{} {
@@ -2,10 +2,8 @@ package ecr
 
 import (
 	"context"
-	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -114,12 +112,13 @@ func dataSourceRepositoryRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("registry_id", repository.RegistryId)
 	d.Set("repository_url", repository.RepositoryUri)
 
-	tags, err := ListTags(ctx, conn, arn)
+	tags, err := meta.(*conns.AWSClient).BatchListTags(ctx, arn, false, func() (tftags.KeyValueTags, error) {
+		return ListTags(ctx, conn, arn)
+	})
 
 	// Some partitions (i.e., ISO) may not support tagging, giving error
-	if meta.(*conns.AWSClient).Partition != endpoints.AwsPartitionID && verify.ErrorISOUnsupported(conn.PartitionID, err) {
-		log.Printf("[WARN] failed listing tags for ECR Repository (%s): %s", d.Id(), err)
-		return diags
+	if verify.ErrorTaggingUnsupported(meta.(*conns.AWSClient), err) {
+		return sdkdiag.AppendWarningf(diags, "failed listing tags for ECR Repository (%s): %s", d.Id(), err)
 	}
 
 	if err != nil {
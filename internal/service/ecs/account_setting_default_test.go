@@ -12,7 +12,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 func TestAccECSAccountSettingDefault_containerInstanceLongARNFormat(t *testing.T) {
@@ -20,11 +19,11 @@ func TestAccECSAccountSettingDefault_containerInstanceLongARNFormat(t *testing.T
 	resourceName := "aws_ecs_account_setting_default.test"
 	settingName := ecs.SettingNameContainerInstanceLongArnFormat
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckAccountSettingDefaultDestroy(ctx),
+		CheckDestroy:             testAccCheckAccountSettingDefaultDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAccountSettingDefaultConfig_basic(settingName),
@@ -49,11 +48,11 @@ func TestAccECSAccountSettingDefault_serviceLongARNFormat(t *testing.T) {
 	resourceName := "aws_ecs_account_setting_default.test"
 	settingName := ecs.SettingNameServiceLongArnFormat
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckAccountSettingDefaultDestroy(ctx),
+		CheckDestroy:             testAccCheckAccountSettingDefaultDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAccountSettingDefaultConfig_basic(settingName),
@@ -78,11 +77,11 @@ func TestAccECSAccountSettingDefault_taskLongARNFormat(t *testing.T) {
 	resourceName := "aws_ecs_account_setting_default.test"
 	settingName := ecs.SettingNameTaskLongArnFormat
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckAccountSettingDefaultDestroy(ctx),
+		CheckDestroy:             testAccCheckAccountSettingDefaultDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAccountSettingDefaultConfig_basic(settingName),
@@ -107,11 +106,11 @@ func TestAccECSAccountSettingDefault_vpcTrunking(t *testing.T) {
 	resourceName := "aws_ecs_account_setting_default.test"
 	settingName := ecs.SettingNameAwsvpcTrunking
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckAccountSettingDefaultDestroy(ctx),
+		CheckDestroy:             testAccCheckAccountSettingDefaultDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAccountSettingDefaultConfig_basic(settingName),
@@ -136,11 +135,11 @@ func TestAccECSAccountSettingDefault_containerInsights(t *testing.T) {
 	resourceName := "aws_ecs_account_setting_default.test"
 	settingName := ecs.SettingNameContainerInsights
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckAccountSettingDefaultDestroy(ctx),
+		CheckDestroy:             testAccCheckAccountSettingDefaultDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAccountSettingDefaultConfig_basic(settingName),
@@ -160,9 +159,9 @@ func TestAccECSAccountSettingDefault_containerInsights(t *testing.T) {
 	})
 }
 
-func testAccCheckAccountSettingDefaultDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckAccountSettingDefaultDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn()
+		conn := acctest.ProviderMeta(t).ECSConn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_ecs_account_setting_default" {
@@ -0,0 +1,6 @@
+package ecs
+
+// Exports for use in tests only.
+var (
+	ResourceTaskSet = newResourceTaskSet
+)
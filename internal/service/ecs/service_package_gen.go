@@ -18,7 +18,9 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []func(contex
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []func(context.Context) (resource.ResourceWithConfigure, error) {
-	return []func(context.Context) (resource.ResourceWithConfigure, error){}
+	return []func(context.Context) (resource.ResourceWithConfigure, error){
+		newResourceTaskSet,
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) map[string]func() *schema.Resource {
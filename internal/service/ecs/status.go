@@ -111,47 +111,3 @@ func statusCluster(ctx context.Context, conn *ecs.ECS, arn string) resource.Stat
 		return cluster, aws.StringValue(cluster.Status), err
 	}
 }
-
-func stabilityStatusTaskSet(ctx context.Context, conn *ecs.ECS, taskSetID, service, cluster string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		input := &ecs.DescribeTaskSetsInput{
-			Cluster:  aws.String(cluster),
-			Service:  aws.String(service),
-			TaskSets: aws.StringSlice([]string{taskSetID}),
-		}
-
-		output, err := conn.DescribeTaskSetsWithContext(ctx, input)
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		if output == nil || len(output.TaskSets) == 0 {
-			return nil, "", nil
-		}
-
-		return output.TaskSets[0], aws.StringValue(output.TaskSets[0].StabilityStatus), nil
-	}
-}
-
-func statusTaskSet(ctx context.Context, conn *ecs.ECS, taskSetID, service, cluster string) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		input := &ecs.DescribeTaskSetsInput{
-			Cluster:  aws.String(cluster),
-			Service:  aws.String(service),
-			TaskSets: aws.StringSlice([]string{taskSetID}),
-		}
-
-		output, err := conn.DescribeTaskSetsWithContext(ctx, input)
-
-		if err != nil {
-			return nil, "", err
-		}
-
-		if output == nil || len(output.TaskSets) == 0 {
-			return nil, "", nil
-		}
-
-		return output.TaskSets[0], aws.StringValue(output.TaskSets[0].Status), nil
-	}
-}
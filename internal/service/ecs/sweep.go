@@ -7,8 +7,8 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -36,6 +36,9 @@ func init() {
 	resource.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
 		Name: "aws_ecs_service",
 		F:    sweepServices,
+		Dependencies: []string{
+			"aws_ecs_task_set",
+		},
 	})
 
 	resource.AddTestSweepers("aws_ecs_task_definition", &resource.Sweeper{
@@ -45,6 +48,11 @@ func init() {
 			"aws_ecs_service",
 		},
 	})
+
+	resource.AddTestSweepers("aws_ecs_task_set", &resource.Sweeper{
+		Name: "aws_ecs_task_set",
+		F:    sweepTaskSets,
+	})
 }
 
 func sweepCapacityProviders(region string) error {
@@ -53,19 +61,26 @@ func sweepCapacityProviders(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-	conn := client.(*conns.AWSClient).ECSConn()
+	conn := client.(*conns.AWSClient).ECSClient()
 	input := &ecs.DescribeCapacityProvidersInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = describeCapacityProvidersPages(ctx, conn, input, func(page *ecs.DescribeCapacityProvidersOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
+	for {
+		output, err := conn.DescribeCapacityProviders(ctx, input)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping ECS Capacity Provider sweep for %s: %s", region, err)
+			return nil
 		}
 
-		for _, v := range page.CapacityProviders {
-			arn := aws.StringValue(v.CapacityProviderArn)
+		if err != nil {
+			return fmt.Errorf("error listing ECS Capacity Providers (%s): %w", region, err)
+		}
 
-			if name := aws.StringValue(v.Name); name == "FARGATE" || name == "FARGATE_SPOT" {
+		for _, v := range output.CapacityProviders {
+			arn := aws.ToString(v.CapacityProviderArn)
+
+			if name := aws.ToString(v.Name); name == "FARGATE" || name == "FARGATE_SPOT" {
 				log.Printf("[INFO] Skipping AWS managed ECS Capacity Provider: %s", arn)
 				continue
 			}
@@ -77,16 +92,11 @@ func sweepCapacityProviders(region string) error {
 			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
-		return !lastPage
-	})
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping ECS Capacity Provider sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing ECS Capacity Providers (%s): %w", region, err)
+		input.NextToken = output.NextToken
 	}
 
 	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
@@ -104,33 +114,30 @@ func sweepClusters(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ECSConn()
+	conn := client.(*conns.AWSClient).ECSClient()
 	input := &ecs.ListClustersInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListClustersPagesWithContext(ctx, input, func(page *ecs.ListClustersOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
+	pages := ecs.NewListClustersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping ECS Cluster sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing ECS Clusters (%s): %w", region, err)
 		}
 
 		for _, v := range page.ClusterArns {
 			r := ResourceCluster()
 			d := r.Data(nil)
-			d.SetId(aws.StringValue(v))
+			d.SetId(v)
 
 			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
-
-		return !lastPage
-	})
-
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping ECS Cluster sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing ECS Clusters (%s): %w", region, err)
 	}
 
 	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
@@ -148,58 +155,53 @@ func sweepServices(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ECSConn()
+	conn := client.(*conns.AWSClient).ECSClient()
 	input := &ecs.ListClustersInput{}
 	var sweeperErrs *multierror.Error
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListClustersPagesWithContext(ctx, input, func(page *ecs.ListClustersOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
+	pages := ecs.NewListClustersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping ECS Service sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
 		}
 
-		for _, v := range page.ClusterArns {
-			clusterARN := aws.StringValue(v)
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing ECS Clusters (%s): %w", region, err))
+			break
+		}
+
+		for _, clusterARN := range page.ClusterArns {
 			input := &ecs.ListServicesInput{
 				Cluster: aws.String(clusterARN),
 			}
 
-			err := conn.ListServicesPagesWithContext(ctx, input, func(page *ecs.ListServicesOutput, lastPage bool) bool {
-				if page == nil {
-					return !lastPage
+			pages := ecs.NewListServicesPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if sweep.SkipSweepError(err) {
+					break
+				}
+
+				if err != nil {
+					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing ECS Services (%s): %w", region, err))
+					break
 				}
 
 				for _, v := range page.ServiceArns {
 					r := ResourceService()
 					d := r.Data(nil)
-					d.SetId(aws.StringValue(v))
+					d.SetId(v)
 					d.Set("cluster", clusterARN)
 
 					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 				}
-
-				return !lastPage
-			})
-
-			if sweep.SkipSweepError(err) {
-				continue
-			}
-
-			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing ECS Services (%s): %w", region, err))
 			}
 		}
-
-		return !lastPage
-	})
-
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping ECS Service sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
-
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing ECS Clusters (%s): %w", region, err))
 	}
 
 	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
@@ -217,41 +219,120 @@ func sweepTaskDefinitions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	conn := client.(*conns.AWSClient).ECSConn()
+	conn := client.(*conns.AWSClient).ECSClient()
 	input := &ecs.ListTaskDefinitionsInput{}
 	sweepResources := make([]sweep.Sweepable, 0)
 
-	err = conn.ListTaskDefinitionsPagesWithContext(ctx, input, func(page *ecs.ListTaskDefinitionsOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
+	pages := ecs.NewListTaskDefinitionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping ECS Task Definition sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing ECS Task Definitions (%s): %w", region, err)
 		}
 
 		for _, v := range page.TaskDefinitionArns {
 			r := ResourceTaskDefinition()
 			d := r.Data(nil)
-			d.SetId(aws.StringValue(v))
+			d.SetId(v)
 			d.Set("arn", v)
 
 			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+	}
 
-		return !lastPage
-	})
+	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping ECS Task Definition sweep for %s: %s", region, err)
-		return nil
+	if err != nil {
+		return fmt.Errorf("error sweeping ECS Task Definitions (%s): %w", region, err)
 	}
 
+	return nil
+}
+
+func sweepTaskSets(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		return fmt.Errorf("error listing ECS Task Definitions (%s): %w", region, err)
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*conns.AWSClient).ECSClient()
+	input := &ecs.ListClustersInput{}
+	var sweeperErrs *multierror.Error
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := ecs.NewListClustersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if sweep.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping ECS Task Set sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing ECS Clusters (%s): %w", region, err))
+			break
+		}
+
+		for _, clusterARN := range page.ClusterArns {
+			input := &ecs.ListServicesInput{
+				Cluster: aws.String(clusterARN),
+			}
+
+			pages := ecs.NewListServicesPaginator(conn, input)
+			for pages.HasMorePages() {
+				page, err := pages.NextPage(ctx)
+
+				if sweep.SkipSweepError(err) {
+					break
+				}
+
+				if err != nil {
+					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing ECS Services (%s): %w", region, err))
+					break
+				}
+
+				if len(page.ServiceArns) == 0 {
+					continue
+				}
+
+				// Task sets are only returned by DescribeServices, which accepts at most 10 services (the default ListServices page size).
+				output, err := conn.DescribeServices(ctx, &ecs.DescribeServicesInput{
+					Cluster:  aws.String(clusterARN),
+					Services: page.ServiceArns,
+				})
+
+				if err != nil {
+					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing ECS Services (%s): %w", region, err))
+					continue
+				}
+
+				for _, service := range output.Services {
+					for _, taskSet := range service.TaskSets {
+						id := TaskSetCreateResourceID(aws.ToString(taskSet.Id), aws.ToString(service.ServiceArn), clusterARN)
+
+						// Task sets that haven't been scaled down to zero can only be deleted with force.
+						sweepResources = append(sweepResources, sweep.NewSweepFrameworkResource(newResourceTaskSet, id, client, sweep.FrameworkSupplementalAttribute{
+							Path:  "force_delete",
+							Value: true,
+						}))
+					}
+				}
+			}
+		}
 	}
 
 	err = sweep.SweepOrchestratorWithContext(ctx, sweepResources)
 
 	if err != nil {
-		return fmt.Errorf("error sweeping ECS Task Definitions (%s): %w", region, err)
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping ECS Task Sets (%s): %w", region, err))
 	}
 
-	return nil
+	return sweeperErrs.ErrorOrNil()
 }
@@ -0,0 +1,77 @@
+// Code generated by internal/generate/tags/main.go; DO NOT EDIT.
+package ecs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// []*SERVICE.Tag handling
+
+// tagsV2 returns ecs service tags.
+func tagsV2(tags tftags.KeyValueTags) []types.Tag {
+	result := make([]types.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := types.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// keyValueTagsV2 creates tftags.KeyValueTags from ecs service tags.
+func keyValueTagsV2(ctx context.Context, tags []types.Tag) tftags.KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.ToString(tag.Key)] = tag.Value
+	}
+
+	return tftags.New(ctx, m)
+}
+
+// updateTagsV2 updates ecs service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func updateTagsV2(ctx context.Context, conn *ecs.Client, identifier string, oldTagsMap interface{}, newTagsMap interface{}) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		input := &ecs.UntagResourceInput{
+			ResourceArn: aws.String(identifier),
+			TagKeys:     removedTags.IgnoreAWS().Keys(),
+		}
+
+		_, err := conn.UntagResource(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		input := &ecs.TagResourceInput{
+			ResourceArn: aws.String(identifier),
+			Tags:        tagsV2(updatedTags.IgnoreAWS()),
+		}
+
+		_, err := conn.TagResource(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", identifier, err)
+		}
+	}
+
+	return nil
+}
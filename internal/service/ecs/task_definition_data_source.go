@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func DataSourceTaskDefinition() *schema.Resource {
@@ -22,10 +24,7 @@ func DataSourceTaskDefinition() *schema.Resource {
 				Required: true,
 			},
 			// Computed values.
-			"arn": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+			names.AttrARN: sdkv2.ARNSchemaComputed(),
 			"family": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -3,566 +3,1016 @@ package ecs
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ecs"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwboolplanmodifier "github.com/hashicorp/terraform-provider-aws/internal/framework/boolplanmodifier"
+	fwint64planmodifier "github.com/hashicorp/terraform-provider-aws/internal/framework/int64planmodifier"
+	fwstringplanmodifier "github.com/hashicorp/terraform-provider-aws/internal/framework/stringplanmodifier"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func ResourceTaskSet() *schema.Resource {
-	return &schema.Resource{
-		CreateWithoutTimeout: resourceTaskSetCreate,
-		ReadWithoutTimeout:   resourceTaskSetRead,
-		UpdateWithoutTimeout: resourceTaskSetUpdate,
-		DeleteWithoutTimeout: resourceTaskSetDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+// @FrameworkResource
+func newResourceTaskSet(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceTaskSet{}
+	r.SetMigratedFromPluginSDK(true)
 
-		Schema: map[string]*schema.Schema{
-			"arn": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+	return r, nil
+}
 
-			"service": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
+type resourceTaskSet struct {
+	framework.ResourceWithConfigure
+}
 
-			"cluster": {
-				Type:     schema.TypeString,
+func (r *resourceTaskSet) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ecs_task_set"
+}
+
+func (r *resourceTaskSet) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cluster": schema.StringAttribute{
 				Required: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-
-			"external_id": {
-				Type:     schema.TypeString,
+			"external_id": schema.StringAttribute{
 				Optional: true,
-				ForceNew: true,
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
-
-			"task_definition": {
-				Type:     schema.TypeString,
+			"force_delete": schema.BoolAttribute{
+				Optional: true,
+			},
+			"id": framework.IDAttribute(),
+			"launch_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					enum.FrameworkValidate[awstypes.LaunchType](),
+				},
+			},
+			"platform_version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
 				Required: true,
-				ForceNew: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-
-			"task_set_id": {
-				Type:     schema.TypeString,
+			"stability_status": schema.StringAttribute{
 				Computed: true,
 			},
-
-			"network_configuration": {
-				Type:     schema.TypeList,
-				MaxItems: 1,
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"task_definition": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"task_set_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"wait_until_stable": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					fwboolplanmodifier.DefaultValue(false),
+				},
+			},
+			"wait_until_stable_timeout": schema.StringAttribute{
 				Optional: true,
-				ForceNew: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"security_groups": {
-							Type:     schema.TypeSet,
-							MaxItems: 5,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					fwstringplanmodifier.DefaultValue("10m"),
+				},
+				Validators: []validator.String{
+					fwvalidators.NonNegativeDuration(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"capacity_provider_strategy": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"base": schema.Int64Attribute{
 							Optional: true,
-							ForceNew: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+							PlanModifiers: []planmodifier.Int64{
+								fwint64planmodifier.DefaultValue(0),
+							},
+							Validators: []validator.Int64{
+								int64validator.Between(0, 100000),
+							},
 						},
-						"subnets": {
-							Type:     schema.TypeSet,
-							MaxItems: 16,
+						"capacity_provider": schema.StringAttribute{
 							Required: true,
-							ForceNew: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
-						"assign_public_ip": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-							ForceNew: true,
+						"weight": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 1000),
+							},
 						},
 					},
 				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
 			},
-
 			// If you are using the CodeDeploy or an external deployment controller,
 			// multiple target groups are not supported.
 			// https://docs.aws.amazon.com/AmazonECS/latest/developerguide/register-multiple-targetgroups.html
-			"load_balancer": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				ForceNew: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"load_balancer_name": {
-							Type:     schema.TypeString,
+			"load_balancer": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"container_name": schema.StringAttribute{
+							Required: true,
+						},
+						"container_port": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
+						},
+						"load_balancer_name": schema.StringAttribute{
 							Optional: true,
-							ForceNew: true,
 						},
-						"target_group_arn": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+						"target_group_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
 						},
-						"container_name": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
+					},
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrNetworkConfiguration: framework.NetworkConfigurationBlock(listplanmodifier.RequiresReplace()),
+			"scale": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"unit": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								fwstringplanmodifier.DefaultValue(string(awstypes.ScaleUnitPercent)),
+							},
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.ScaleUnit](),
+							},
 						},
-						"container_port": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: validation.IsPortNumber,
+						"value": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Float64{
+								float64validator.Between(0.0, 100.0),
+							},
 						},
 					},
 				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
 			},
-
-			"service_registries": {
-				Type:     schema.TypeList,
-				MaxItems: 1,
-				Optional: true,
-				ForceNew: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"container_name": {
-							Type:     schema.TypeString,
+			"service_registries": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"container_name": schema.StringAttribute{
 							Optional: true,
-							ForceNew: true,
 						},
-						"container_port": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: validation.IsPortNumber,
+						"container_port": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
 						},
-						"port": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: validation.IsPortNumber,
+						"port": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 65535),
+							},
 						},
-						"registry_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+						"registry_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
 						},
 					},
 				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
 			},
+		},
+	}
+}
 
-			"launch_type": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				ForceNew:      true,
-				Computed:      true,
-				ValidateFunc:  validation.StringInSlice(ecs.LaunchType_Values(), false),
-				ConflictsWith: []string{"capacity_provider_strategy"},
-			},
+func (r *resourceTaskSet) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceTaskSetData
 
-			"capacity_provider_strategy": {
-				Type:          schema.TypeSet,
-				Optional:      true,
-				ForceNew:      true,
-				ConflictsWith: []string{"launch_type"},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"base": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							ValidateFunc: validation.IntBetween(0, 100000),
-							ForceNew:     true,
-						},
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
 
-						"capacity_provider": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
-						},
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-						"weight": {
-							Type:         schema.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(0, 1000),
-							ForceNew:     true,
-						},
-					},
-				},
-			},
+	conn := r.Meta().ECSClient()
 
-			"platform_version": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
-			},
+	cluster := data.Cluster.ValueString()
+	service := data.Service.ValueString()
+	tags := r.ExpandTags(ctx, data.Tags)
+	input := &ecs.CreateTaskSetInput{
+		CapacityProviderStrategy: r.expandCapacityProviderStrategy(ctx, data.CapacityProviderStrategy),
+		ClientToken:              aws.String(sdkresource.UniqueId()),
+		Cluster:                  aws.String(cluster),
+		ExternalId:               flex.StringFromFramework(ctx, data.ExternalID),
+		LaunchType:               awstypes.LaunchType(data.LaunchType.ValueString()),
+		NetworkConfiguration:     r.expandNetworkConfiguration(ctx, data.NetworkConfiguration),
+		PlatformVersion:          flex.StringFromFramework(ctx, data.PlatformVersion),
+		Scale:                    r.expandScale(ctx, data.Scale),
+		Service:                  aws.String(service),
+		ServiceRegistries:        r.expandServiceRegistries(ctx, data.ServiceRegistries),
+		TaskDefinition:           flex.StringFromFramework(ctx, data.TaskDefinition),
+	}
 
-			"scale": {
-				Type:     schema.TypeList,
-				MaxItems: 1,
-				Optional: true,
-				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"unit": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							Default:      ecs.ScaleUnitPercent,
-							ValidateFunc: validation.StringInSlice(ecs.ScaleUnit_Values(), false),
-						},
-						"value": {
-							Type:         schema.TypeFloat,
-							Optional:     true,
-							ValidateFunc: validation.FloatBetween(0.0, 100.0),
-						},
-					},
-				},
-			},
+	response.Diagnostics.Append(flex.ExpandFramework(ctx, data.LoadBalancers, &input.LoadBalancers)...)
 
-			"force_delete": {
-				Type:     schema.TypeBool,
-				Optional: true,
-			},
+	if response.Diagnostics.HasError() {
+		return
+	}
 
-			"stability_status": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+	if len(tags) > 0 {
+		input.Tags = tagsV2(tags.IgnoreAWS())
+	}
 
-			"status": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+	output, err := retryTaskSetCreate(ctx, conn, input)
 
-			"tags": tftags.TagsSchema(),
+	// Some partitions (i.e., ISO) may not support tag-on-create
+	if input.Tags != nil && verify.ErrorTaggingUnsupported(r.Meta(), err) {
+		response.Diagnostics.AddWarning("ECS tagging failed creating Task Set with tags, retrying without tags", err.Error())
 
-			"tags_all": tftags.TagsSchemaComputed(),
+		input.Tags = nil
 
-			"wait_until_stable": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
+		output, err = retryTaskSetCreate(ctx, conn, input)
+	}
 
-			"wait_until_stable_timeout": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "10m",
-				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
-					value := v.(string)
-					duration, err := time.ParseDuration(value)
-					if err != nil {
-						errors = append(errors, fmt.Errorf(
-							"%q cannot be parsed as a duration: %w", k, err))
-					}
-					if duration < 0 {
-						errors = append(errors, fmt.Errorf(
-							"%q must be greater than zero", k))
-					}
-					return
-				},
-			},
-		},
+	if err != nil {
+		response.Diagnostics.AddError("creating ECS Task Set", err.Error())
 
-		CustomizeDiff: verify.SetTagsDiff,
+		return
 	}
-}
 
-func resourceTaskSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).ECSConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+	taskSetID := aws.ToString(output.TaskSet.Id)
+	data.ID = types.StringValue(TaskSetCreateResourceID(taskSetID, service, cluster))
 
-	cluster := d.Get("cluster").(string)
-	service := d.Get("service").(string)
-	input := &ecs.CreateTaskSetInput{
-		ClientToken:    aws.String(resource.UniqueId()),
-		Cluster:        aws.String(cluster),
-		Service:        aws.String(service),
-		TaskDefinition: aws.String(d.Get("task_definition").(string)),
+	if data.WaitUntilStable.ValueBool() {
+		timeout, _ := time.ParseDuration(data.WaitUntilStableTimeout.ValueString())
+
+		if _, err := waitTaskSetStable(ctx, conn, timeout, taskSetID, service, cluster); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for ECS Task Set (%s) to be stable", data.ID.ValueString()), err.Error())
+
+			return
+		}
 	}
 
-	if len(tags) > 0 {
-		input.Tags = Tags(tags.IgnoreAWS())
+	// Some partitions (i.e., ISO) may not support tag-on-create, attempt tag after create
+	if input.Tags == nil && len(tags) > 0 {
+		err := updateTagsV2(ctx, conn, aws.ToString(output.TaskSet.TaskSetArn), nil, tags)
+
+		if (data.Tags.IsNull() || len(data.Tags.Elements()) == 0) && verify.ErrorTaggingUnsupported(r.Meta(), err) {
+			// If default tags only, warn and continue. Otherwise, error.
+			response.Diagnostics.AddWarning(fmt.Sprintf("ECS tagging failed adding tags after create for Task Set (%s)", data.ID.ValueString()), err.Error())
+		} else if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("adding tags after create for ECS Task Set (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
 	}
 
-	if v, ok := d.GetOk("capacity_provider_strategy"); ok && v.(*schema.Set).Len() > 0 {
-		input.CapacityProviderStrategy = expandCapacityProviderStrategy(v.(*schema.Set))
+	taskSet, err := r.findTaskSet(ctx, &response.Diagnostics, taskSetID, service, cluster)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ECS Task Set (%s)", data.ID.ValueString()), err.Error())
+
+		return
 	}
 
-	if v, ok := d.GetOk("external_id"); ok {
-		input.ExternalId = aws.String(v.(string))
+	// Set values for unknowns.
+	data.ARN = flex.StringToFramework(ctx, taskSet.TaskSetArn)
+	data.ExternalID = flex.StringToFrameworkLegacy(ctx, taskSet.ExternalId)
+	data.LaunchType = flex.StringValueToFrameworkLegacy(ctx, taskSet.LaunchType)
+	data.PlatformVersion = flex.StringToFrameworkLegacy(ctx, taskSet.PlatformVersion)
+	data.StabilityStatus = flex.StringValueToFramework(ctx, taskSet.StabilityStatus)
+	data.Status = flex.StringToFramework(ctx, taskSet.Status)
+	data.TaskSetID = flex.StringToFramework(ctx, taskSet.Id)
+	data.TagsAll = r.FlattenTagsAll(ctx, tags)
+
+	if len(data.Scale.Elements()) > 0 {
+		data.Scale = r.flattenScale(ctx, taskSet.Scale)
 	}
 
-	if v, ok := d.GetOk("launch_type"); ok {
-		input.LaunchType = aws.String(v.(string))
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTaskSet) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceTaskSetData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	if v, ok := d.GetOk("load_balancer"); ok && v.(*schema.Set).Len() > 0 {
-		input.LoadBalancers = expandTaskSetLoadBalancers(v.(*schema.Set).List())
+	taskSetID, service, cluster, err := TaskSetParseID(data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
 	}
 
-	if v, ok := d.GetOk("network_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.NetworkConfiguration = expandNetworkConfiguration(v.([]interface{}))
+	taskSet, err := r.findTaskSet(ctx, &response.Diagnostics, taskSetID, service, cluster)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
 	}
 
-	if v, ok := d.GetOk("platform_version"); ok {
-		input.PlatformVersion = aws.String(v.(string))
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ECS Task Set (%s)", data.ID.ValueString()), err.Error())
+
+		return
 	}
 
-	if v, ok := d.GetOk("scale"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.Scale = expandScale(v.([]interface{}))
+	data.ARN = flex.StringToFramework(ctx, taskSet.TaskSetArn)
+	data.CapacityProviderStrategy = r.flattenCapacityProviderStrategy(ctx, taskSet.CapacityProviderStrategy)
+	data.Cluster = types.StringValue(cluster)
+	data.ExternalID = flex.StringToFrameworkLegacy(ctx, taskSet.ExternalId)
+	data.LaunchType = flex.StringValueToFrameworkLegacy(ctx, taskSet.LaunchType)
+	data.NetworkConfiguration = r.flattenNetworkConfiguration(ctx, taskSet.NetworkConfiguration)
+	data.PlatformVersion = flex.StringToFrameworkLegacy(ctx, taskSet.PlatformVersion)
+	data.Service = types.StringValue(service)
+	data.ServiceRegistries = r.flattenServiceRegistries(ctx, taskSet.ServiceRegistries)
+	data.StabilityStatus = flex.StringValueToFramework(ctx, taskSet.StabilityStatus)
+	data.Status = flex.StringToFramework(ctx, taskSet.Status)
+	data.TaskDefinition = flex.StringToFramework(ctx, taskSet.TaskDefinition)
+	data.TaskSetID = flex.StringToFramework(ctx, taskSet.Id)
+
+	response.Diagnostics.Append(flex.FlattenFramework(ctx, taskSet.LoadBalancers, types.SetType{ElemType: types.ObjectType{AttrTypes: taskSetLoadBalancerAttrTypes}}, &data.LoadBalancers)...)
+
+	if response.Diagnostics.HasError() {
+		return
 	}
 
-	if v, ok := d.GetOk("service_registries"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.ServiceRegistries = expandServiceRegistries(v.([]interface{}))
+	// The API always returns a scale. Only track it if it has been configured.
+	if len(data.Scale.Elements()) > 0 {
+		data.Scale = r.flattenScale(ctx, taskSet.Scale)
 	}
 
-	output, err := retryTaskSetCreate(ctx, conn, input)
+	apiTags := keyValueTagsV2(ctx, taskSet.Tags)
+	data.Tags = r.FlattenTags(ctx, apiTags)
+	data.TagsAll = r.FlattenTagsAll(ctx, apiTags)
 
-	// Some partitions (i.e., ISO) may not support tag-on-create
-	if input.Tags != nil && verify.ErrorISOUnsupported(conn.PartitionID, err) {
-		log.Printf("[WARN] ECS tagging failed creating Task Set with tags: %s. Trying create without tags.", err)
-		input.Tags = nil
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
 
-		output, err = retryTaskSetCreate(ctx, conn, input)
+func (r *resourceTaskSet) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new resourceTaskSetData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+
+	if response.Diagnostics.HasError() {
+		return
 	}
 
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ECSClient()
+
+	taskSetID, service, cluster, err := TaskSetParseID(new.ID.ValueString())
+
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating ECS TaskSet: %s", err)
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
 	}
 
-	taskSetId := aws.StringValue(output.TaskSet.Id)
+	if !new.Scale.Equal(old.Scale) {
+		input := &ecs.UpdateTaskSetInput{
+			Cluster: aws.String(cluster),
+			Scale:   r.expandScale(ctx, new.Scale),
+			Service: aws.String(service),
+			TaskSet: aws.String(taskSetID),
+		}
+
+		_, err := conn.UpdateTaskSet(ctx, input)
 
-	d.SetId(fmt.Sprintf("%s,%s,%s", taskSetId, service, cluster))
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating ECS Task Set (%s)", new.ID.ValueString()), err.Error())
 
-	if d.Get("wait_until_stable").(bool) {
-		timeout, _ := time.ParseDuration(d.Get("wait_until_stable_timeout").(string))
-		if err := waitTaskSetStable(ctx, conn, timeout, taskSetId, service, cluster); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for ECS Task Set (%s) to be stable: %s", d.Id(), err)
+			return
 		}
-	}
 
-	// Some partitions (i.e., ISO) may not support tag-on-create, attempt tag after create
-	if input.Tags == nil && len(tags) > 0 {
-		err := UpdateTags(ctx, conn, aws.StringValue(output.TaskSet.TaskSetArn), nil, tags)
+		if new.WaitUntilStable.ValueBool() {
+			timeout, _ := time.ParseDuration(new.WaitUntilStableTimeout.ValueString())
+
+			if _, err := waitTaskSetStable(ctx, conn, timeout, taskSetID, service, cluster); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("waiting for ECS Task Set (%s) to be stable after update", new.ID.ValueString()), err.Error())
 
-		if v, ok := d.GetOk("tags"); (!ok || len(v.(map[string]interface{})) == 0) && verify.ErrorISOUnsupported(conn.PartitionID, err) {
-			// If default tags only, log and continue. Otherwise, error.
-			log.Printf("[WARN] ECS tagging failed adding tags after create for Task Set (%s): %s", d.Id(), err)
-			return append(diags, resourceTaskSetRead(ctx, d, meta)...)
+				return
+			}
 		}
+	}
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "ECS tagging failed adding tags after create for Task Set (%s): %s", d.Id(), err)
+	if !new.TagsAll.Equal(old.TagsAll) {
+		err := updateTagsV2(ctx, conn, new.ARN.ValueString(), old.TagsAll, new.TagsAll)
+
+		// Some partitions (i.e., ISO) may not support tagging, giving error
+		if verify.ErrorTaggingUnsupported(r.Meta(), err) {
+			response.Diagnostics.AddWarning(fmt.Sprintf("ECS tagging failed updating tags for Task Set (%s)", new.ID.ValueString()), err.Error())
+		} else if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating ECS Task Set (%s) tags", new.ID.ValueString()), err.Error())
+
+			return
 		}
 	}
 
-	return append(diags, resourceTaskSetRead(ctx, d, meta)...)
+	taskSet, err := r.findTaskSet(ctx, &response.Diagnostics, taskSetID, service, cluster)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ECS Task Set (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	new.StabilityStatus = flex.StringValueToFramework(ctx, taskSet.StabilityStatus)
+	new.Status = flex.StringToFramework(ctx, taskSet.Status)
+
+	if len(new.Scale.Elements()) > 0 {
+		new.Scale = r.flattenScale(ctx, taskSet.Scale)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
 }
 
-func resourceTaskSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).ECSConn()
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+func (r *resourceTaskSet) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceTaskSetData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ECSClient()
 
-	taskSetId, service, cluster, err := TaskSetParseID(d.Id())
+	taskSetID, service, cluster, err := TaskSetParseID(data.ID.ValueString())
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading ECS Task Set (%s): %s", d.Id(), err)
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
 	}
 
-	input := &ecs.DescribeTaskSetsInput{
-		Cluster:  aws.String(cluster),
-		Include:  aws.StringSlice([]string{ecs.TaskSetFieldTags}),
-		Service:  aws.String(service),
-		TaskSets: aws.StringSlice([]string{taskSetId}),
+	tflog.Debug(ctx, "deleting ECS Task Set", map[string]interface{}{
+		"id": data.ID.ValueString(),
+	})
+
+	err = r.forceDeleter(data.ForceDelete.ValueBool(), taskSetID, service, cluster).Run(ctx)
+
+	if errs.IsA[*awstypes.TaskSetNotFoundException](err) {
+		return
 	}
 
-	out, err := conn.DescribeTaskSetsWithContext(ctx, input)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting ECS Task Set (%s)", data.ID.ValueString()), err.Error())
 
-	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException, ecs.ErrCodeServiceNotFoundException, ecs.ErrCodeTaskSetNotFoundException) {
-		log.Printf("[WARN] ECS Task Set (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return diags
+		return
 	}
 
-	// Some partitions (i.e., ISO) may not support tagging, giving error
-	if verify.ErrorISOUnsupported(conn.PartitionID, err) {
-		log.Printf("[WARN] ECS tagging failed describing Task Set (%s) with tags: %s; retrying without tags", d.Id(), err)
+	if _, err := waitTaskSetDeleted(ctx, conn, taskSetID, service, cluster); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for ECS Task Set (%s) delete", data.ID.ValueString()), err.Error())
 
-		input.Include = nil
-		out, err = conn.DescribeTaskSetsWithContext(ctx, input)
+		return
 	}
+}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading ECS Task Set (%s): %s", d.Id(), err)
+func (r *resourceTaskSet) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+func (r *resourceTaskSet) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		r.modifyPlanForDestroy(ctx, request, response)
+
+		return
+	}
+
+	// Unless configured, wait_until_stable follows the provider's wait_for_stabilization argument.
+	var waitUntilStable types.Bool
+
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("wait_until_stable"), &waitUntilStable)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if waitUntilStable.IsNull() && r.Meta().WaitForStabilization {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("wait_until_stable"), true)...)
 	}
 
-	if out == nil || len(out.TaskSets) == 0 {
-		if d.IsNewResource() {
-			return sdkdiag.AppendErrorf(diags, "reading ECS Task Set (%s): empty output after creation", d.Id())
+	// Service registries must be in the provider's partition and region.
+	var serviceRegistries types.List
+
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("service_registries"), &serviceRegistries)...)
+
+	if response.Diagnostics.HasError() || serviceRegistries.IsNull() || serviceRegistries.IsUnknown() {
+		return
+	}
+
+	var data []taskSetServiceRegistryData
+
+	response.Diagnostics.Append(serviceRegistries.ElementsAs(ctx, &data, false)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	validateARN := verify.ValidARNInPartition(r.Meta(), "servicediscovery")
+
+	for i, v := range data {
+		if v.RegistryARN.IsNull() || v.RegistryARN.IsUnknown() {
+			continue
+		}
+
+		_, errs := validateARN(v.RegistryARN.ValueARN().String(), "registry_arn")
+
+		for _, err := range errs {
+			response.Diagnostics.AddAttributeError(
+				path.Root("service_registries").AtListIndex(i).AtName("registry_arn"),
+				"Invalid ARN",
+				err.Error(),
+			)
 		}
-		log.Printf("[WARN] ECS Task Set (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return diags
 	}
+}
 
-	taskSet := out.TaskSets[0]
+// modifyPlanForDestroy warns which tasks a forced delete of the task set would stop.
+func (r *resourceTaskSet) modifyPlanForDestroy(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	var data resourceTaskSetData
 
-	d.Set("arn", taskSet.TaskSetArn)
-	d.Set("cluster", cluster)
-	d.Set("launch_type", taskSet.LaunchType)
-	d.Set("platform_version", taskSet.PlatformVersion)
-	d.Set("external_id", taskSet.ExternalId)
-	d.Set("service", service)
-	d.Set("status", taskSet.Status)
-	d.Set("stability_status", taskSet.StabilityStatus)
-	d.Set("task_definition", taskSet.TaskDefinition)
-	d.Set("task_set_id", taskSet.Id)
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
 
-	if err := d.Set("capacity_provider_strategy", flattenCapacityProviderStrategy(taskSet.CapacityProviderStrategy)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting capacity_provider_strategy: %s", err)
+	if response.Diagnostics.HasError() || !data.ForceDelete.ValueBool() {
+		return
 	}
 
-	if err := d.Set("load_balancer", flattenTaskSetLoadBalancers(taskSet.LoadBalancers)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting load_balancer: %s", err)
+	taskSetID, service, cluster, err := TaskSetParseID(data.ID.ValueString())
+
+	if err != nil {
+		return
 	}
 
-	if err := d.Set("network_configuration", flattenNetworkConfiguration(taskSet.NetworkConfiguration)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting network_configuration: %s", err)
+	tasks, err := r.forceDeleter(true, taskSetID, service, cluster).DryRun(ctx)
+
+	if err != nil || tasks == "" {
+		return
 	}
 
-	if err := d.Set("scale", flattenScale(taskSet.Scale)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting scale: %s", err)
+	response.Diagnostics.AddWarning(
+		fmt.Sprintf("ECS Task Set (%s) will be force deleted", data.ID.ValueString()),
+		fmt.Sprintf("Deleting the task set also stops its tasks: %s", tasks),
+	)
+}
+
+// forceDeleter returns a ForceDeleter for the specified task set.
+// DeleteTaskSet stops the task set's tasks itself when forced.
+func (r *resourceTaskSet) forceDeleter(force bool, taskSetID, service, cluster string) *tfresource.ForceDeleter {
+	conn := r.Meta().ECSClient()
+
+	return &tfresource.ForceDeleter{
+		Force: force,
+		Delete: func(ctx context.Context, force bool) error {
+			_, err := conn.DeleteTaskSet(ctx, &ecs.DeleteTaskSetInput{
+				Cluster: aws.String(cluster),
+				Force:   aws.Bool(force),
+				Service: aws.String(service),
+				TaskSet: aws.String(taskSetID),
+			})
+
+			return err
+		},
+		ListDependents: func(ctx context.Context) ([]string, error) {
+			taskSet, err := FindTaskSetNoTagsByID(ctx, conn, taskSetID, service, cluster)
+
+			if err != nil {
+				return nil, err
+			}
+
+			var tasks []string
+
+			if v := taskSet.RunningCount; v > 0 {
+				tasks = append(tasks, fmt.Sprintf("%d running", v))
+			}
+
+			if v := taskSet.PendingCount; v > 0 {
+				tasks = append(tasks, fmt.Sprintf("%d pending", v))
+			}
+
+			return tasks, nil
+		},
+	}
+}
+
+func (r *resourceTaskSet) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data resourceTaskSetData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Absent blocks are represented as empty collections, so the generic "conflicting" validators can't be used.
+	if !data.LaunchType.IsNull() && !data.LaunchType.IsUnknown() && len(data.CapacityProviderStrategy.Elements()) > 0 {
+		response.Diagnostics.AddAttributeError(
+			path.Root("launch_type"),
+			"Invalid Attribute Combination",
+			`"launch_type" cannot be specified when "capacity_provider_strategy" is specified`,
+		)
 	}
+}
+
+// findTaskSet returns the specified task set, including its tags where the partition supports it.
+// A warning is added to diags if the task set's tags can't be described.
+func (r *resourceTaskSet) findTaskSet(ctx context.Context, diags *diag.Diagnostics, taskSetID, service, cluster string) (*awstypes.TaskSet, error) {
+	conn := r.Meta().ECSClient()
+
+	taskSet, err := FindTaskSetByID(ctx, conn, taskSetID, service, cluster)
 
-	if err := d.Set("service_registries", flattenServiceRegistries(taskSet.ServiceRegistries)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting service_registries: %s", err)
+	// Some partitions (i.e., ISO) may not support tagging, giving error
+	if verify.ErrorTaggingUnsupported(r.Meta(), err) {
+		diags.AddWarning(fmt.Sprintf("ECS tagging failed describing Task Set (%s) with tags, retrying without tags", taskSetID), err.Error())
+
+		taskSet, err = FindTaskSetNoTagsByID(ctx, conn, taskSetID, service, cluster)
 	}
 
-	tags := KeyValueTags(ctx, taskSet.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	return taskSet, err
+}
 
-	//lintignore:AWSR002
-	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+func (r *resourceTaskSet) expandCapacityProviderStrategy(ctx context.Context, tfSet types.Set) []awstypes.CapacityProviderStrategyItem {
+	if tfSet.IsNull() || tfSet.IsUnknown() {
+		return nil
 	}
 
-	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	var data []taskSetCapacityProviderStrategyData
+
+	if diags := tfSet.ElementsAs(ctx, &data, false); diags.HasError() {
+		return nil
 	}
 
-	return diags
+	var apiObjects []awstypes.CapacityProviderStrategyItem
+
+	for _, v := range data {
+		apiObjects = append(apiObjects, awstypes.CapacityProviderStrategyItem{
+			Base:             int32(v.Base.ValueInt64()),
+			CapacityProvider: flex.StringFromFramework(ctx, v.CapacityProvider),
+			Weight:           int32(v.Weight.ValueInt64()),
+		})
+	}
+
+	return apiObjects
 }
 
-func resourceTaskSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).ECSConn()
+func (r *resourceTaskSet) flattenCapacityProviderStrategy(ctx context.Context, apiObjects []awstypes.CapacityProviderStrategyItem) types.Set {
+	elementType := types.ObjectType{AttrTypes: taskSetCapacityProviderStrategyAttrTypes}
 
-	if d.HasChangesExcept("tags", "tags_all") {
-		taskSetId, service, cluster, err := TaskSetParseID(d.Id())
+	if len(apiObjects) == 0 {
+		return types.SetNull(elementType)
+	}
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating ECS Task Set (%s): %s", d.Id(), err)
-		}
+	var elements []attr.Value
 
-		input := &ecs.UpdateTaskSetInput{
-			Cluster: aws.String(cluster),
-			Service: aws.String(service),
-			TaskSet: aws.String(taskSetId),
-			Scale:   expandScale(d.Get("scale").([]interface{})),
-		}
+	for _, apiObject := range apiObjects {
+		elements = append(elements, types.ObjectValueMust(taskSetCapacityProviderStrategyAttrTypes, map[string]attr.Value{
+			"base":              types.Int64Value(int64(apiObject.Base)),
+			"capacity_provider": flex.StringToFramework(ctx, apiObject.CapacityProvider),
+			"weight":            types.Int64Value(int64(apiObject.Weight)),
+		}))
+	}
 
-		_, err = conn.UpdateTaskSetWithContext(ctx, input)
+	return types.SetValueMust(elementType, elements)
+}
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating ECS Task Set (%s): %s", d.Id(), err)
-		}
+func (r *resourceTaskSet) expandNetworkConfiguration(ctx context.Context, tfList types.List) *awstypes.NetworkConfiguration {
+	if tfList.IsNull() || tfList.IsUnknown() {
+		return nil
+	}
 
-		if d.Get("wait_until_stable").(bool) {
-			timeout, _ := time.ParseDuration(d.Get("wait_until_stable_timeout").(string))
-			if err := waitTaskSetStable(ctx, conn, timeout, taskSetId, service, cluster); err != nil {
-				return sdkdiag.AppendErrorf(diags, "waiting for ECS Task Set (%s) to be stable after update: %s", d.Id(), err)
-			}
-		}
+	var data []taskSetNetworkConfigurationData
+
+	if diags := tfList.ElementsAs(ctx, &data, false); diags.HasError() {
+		return nil
 	}
 
-	if d.HasChange("tags_all") {
-		o, n := d.GetChange("tags_all")
+	if len(data) == 0 {
+		return nil
+	}
 
-		err := UpdateTags(ctx, conn, d.Get("arn").(string), o, n)
+	apiObject := &awstypes.AwsVpcConfiguration{
+		SecurityGroups: flex.ExpandFrameworkStringValueSet(ctx, data[0].SecurityGroups),
+		Subnets:        flex.ExpandFrameworkStringValueSet(ctx, data[0].Subnets),
+	}
 
-		// Some partitions (i.e., ISO) may not support tagging, giving error
-		if verify.ErrorISOUnsupported(conn.PartitionID, err) {
-			log.Printf("[WARN] ECS tagging failed updating tags for Task Set (%s): %s", d.Id(), err)
-			return append(diags, resourceTaskSetRead(ctx, d, meta)...)
-		}
+	if data[0].AssignPublicIP.ValueBool() {
+		apiObject.AssignPublicIp = awstypes.AssignPublicIpEnabled
+	} else {
+		apiObject.AssignPublicIp = awstypes.AssignPublicIpDisabled
+	}
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "ECS tagging failed updating tags for Task Set (%s): %s", d.Id(), err)
-		}
+	return &awstypes.NetworkConfiguration{
+		AwsvpcConfiguration: apiObject,
+	}
+}
+
+func (r *resourceTaskSet) flattenNetworkConfiguration(ctx context.Context, apiObject *awstypes.NetworkConfiguration) types.List {
+	elementType := types.ObjectType{AttrTypes: taskSetNetworkConfigurationAttrTypes}
+
+	if apiObject == nil || apiObject.AwsvpcConfiguration == nil {
+		return types.ListNull(elementType)
 	}
 
-	return append(diags, resourceTaskSetRead(ctx, d, meta)...)
+	awsvpcConfiguration := apiObject.AwsvpcConfiguration
+
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(taskSetNetworkConfigurationAttrTypes, map[string]attr.Value{
+			names.AttrAssignPublicIP: types.BoolValue(awsvpcConfiguration.AssignPublicIp == awstypes.AssignPublicIpEnabled),
+			names.AttrSecurityGroups: flex.FlattenFrameworkStringValueSet(ctx, awsvpcConfiguration.SecurityGroups),
+			names.AttrSubnets:        flex.FlattenFrameworkStringValueSet(ctx, awsvpcConfiguration.Subnets),
+		}),
+	})
 }
 
-func resourceTaskSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).ECSConn()
+func (r *resourceTaskSet) expandScale(ctx context.Context, tfList types.List) *awstypes.Scale {
+	if tfList.IsNull() || tfList.IsUnknown() {
+		return nil
+	}
 
-	taskSetId, service, cluster, err := TaskSetParseID(d.Id())
+	var data []taskSetScaleData
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting ECS Task Set (%s): %s", d.Id(), err)
+	if diags := tfList.ElementsAs(ctx, &data, false); diags.HasError() {
+		return nil
 	}
 
-	input := &ecs.DeleteTaskSetInput{
-		Cluster: aws.String(cluster),
-		Service: aws.String(service),
-		TaskSet: aws.String(taskSetId),
-		Force:   aws.Bool(d.Get("force_delete").(bool)),
+	if len(data) == 0 {
+		return nil
 	}
 
-	_, err = conn.DeleteTaskSetWithContext(ctx, input)
+	return &awstypes.Scale{
+		Unit:  awstypes.ScaleUnit(data[0].Unit.ValueString()),
+		Value: data[0].Value.ValueFloat64(),
+	}
+}
+
+func (r *resourceTaskSet) flattenScale(ctx context.Context, apiObject *awstypes.Scale) types.List {
+	elementType := types.ObjectType{AttrTypes: taskSetScaleAttrTypes}
 
-	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeTaskSetNotFoundException) {
-		return diags
+	if apiObject == nil {
+		return types.ListNull(elementType)
 	}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting ECS Task Set (%s): %s", d.Id(), err)
+	return types.ListValueMust(elementType, []attr.Value{
+		types.ObjectValueMust(taskSetScaleAttrTypes, map[string]attr.Value{
+			"unit":  flex.StringValueToFramework(ctx, apiObject.Unit),
+			"value": types.Float64Value(apiObject.Value),
+		}),
+	})
+}
+
+func (r *resourceTaskSet) expandServiceRegistries(ctx context.Context, tfList types.List) []awstypes.ServiceRegistry {
+	if tfList.IsNull() || tfList.IsUnknown() {
+		return nil
 	}
 
-	if err := waitTaskSetDeleted(ctx, conn, taskSetId, service, cluster); err != nil {
-		if tfawserr.ErrCodeEquals(err, ecs.ErrCodeTaskSetNotFoundException) {
-			return diags
+	var data []taskSetServiceRegistryData
+
+	if diags := tfList.ElementsAs(ctx, &data, false); diags.HasError() {
+		return nil
+	}
+
+	var apiObjects []awstypes.ServiceRegistry
+
+	for _, v := range data {
+		apiObject := awstypes.ServiceRegistry{
+			ContainerName: flex.StringFromFramework(ctx, v.ContainerName),
+			ContainerPort: flex.Int32FromFramework(ctx, v.ContainerPort),
+			Port:          flex.Int32FromFramework(ctx, v.Port),
+		}
+
+		if !v.RegistryARN.IsNull() && !v.RegistryARN.IsUnknown() {
+			apiObject.RegistryArn = aws.String(v.RegistryARN.ValueARN().String())
 		}
-		return sdkdiag.AppendErrorf(diags, "deleting ECS Task Set (%s): waiting for completion: %s", d.Id(), err)
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func (r *resourceTaskSet) flattenServiceRegistries(ctx context.Context, apiObjects []awstypes.ServiceRegistry) types.List {
+	elementType := types.ObjectType{AttrTypes: taskSetServiceRegistryAttrTypes}
+
+	if len(apiObjects) == 0 {
+		return types.ListNull(elementType)
 	}
 
-	return diags
+	var elements []attr.Value
+
+	for _, apiObject := range apiObjects {
+		elements = append(elements, types.ObjectValueMust(taskSetServiceRegistryAttrTypes, map[string]attr.Value{
+			"container_name": flex.StringToFramework(ctx, apiObject.ContainerName),
+			"container_port": flex.Int32ToFramework(ctx, apiObject.ContainerPort),
+			"port":           flex.Int32ToFramework(ctx, apiObject.Port),
+			"registry_arn":   flattenARN(apiObject.RegistryArn),
+		}))
+	}
+
+	return types.ListValueMust(elementType, elements)
+}
+
+func flattenARN(v *string) fwtypes.ARN {
+	if v == nil {
+		return fwtypes.ARNNull()
+	}
+
+	a, err := arn.Parse(aws.ToString(v))
+
+	if err != nil {
+		return fwtypes.ARNNull()
+	}
+
+	return fwtypes.ARNValue(a)
+}
+
+type resourceTaskSetData struct {
+	ARN                      types.String `tfsdk:"arn"`
+	CapacityProviderStrategy types.Set    `tfsdk:"capacity_provider_strategy"`
+	Cluster                  types.String `tfsdk:"cluster"`
+	ExternalID               types.String `tfsdk:"external_id"`
+	ForceDelete              types.Bool   `tfsdk:"force_delete"`
+	ID                       types.String `tfsdk:"id"`
+	LaunchType               types.String `tfsdk:"launch_type"`
+	LoadBalancers            types.Set    `tfsdk:"load_balancer"`
+	NetworkConfiguration     types.List   `tfsdk:"network_configuration"`
+	PlatformVersion          types.String `tfsdk:"platform_version"`
+	Scale                    types.List   `tfsdk:"scale"`
+	Service                  types.String `tfsdk:"service"`
+	ServiceRegistries        types.List   `tfsdk:"service_registries"`
+	StabilityStatus          types.String `tfsdk:"stability_status"`
+	Status                   types.String `tfsdk:"status"`
+	Tags                     types.Map    `tfsdk:"tags"`
+	TagsAll                  types.Map    `tfsdk:"tags_all"`
+	TaskDefinition           types.String `tfsdk:"task_definition"`
+	TaskSetID                types.String `tfsdk:"task_set_id"`
+	WaitUntilStable          types.Bool   `tfsdk:"wait_until_stable"`
+	WaitUntilStableTimeout   types.String `tfsdk:"wait_until_stable_timeout"`
+}
+
+type taskSetCapacityProviderStrategyData struct {
+	Base             types.Int64  `tfsdk:"base"`
+	CapacityProvider types.String `tfsdk:"capacity_provider"`
+	Weight           types.Int64  `tfsdk:"weight"`
+}
+
+var taskSetCapacityProviderStrategyAttrTypes = map[string]attr.Type{
+	"base":              types.Int64Type,
+	"capacity_provider": types.StringType,
+	"weight":            types.Int64Type,
+}
+
+var taskSetLoadBalancerAttrTypes = map[string]attr.Type{
+	"container_name":     types.StringType,
+	"container_port":     types.Int64Type,
+	"load_balancer_name": types.StringType,
+	"target_group_arn":   fwtypes.ARNType,
+}
+
+type taskSetNetworkConfigurationData struct {
+	AssignPublicIP types.Bool `tfsdk:"assign_public_ip"`
+	SecurityGroups types.Set  `tfsdk:"security_groups"`
+	Subnets        types.Set  `tfsdk:"subnets"`
+}
+
+var taskSetNetworkConfigurationAttrTypes = map[string]attr.Type{
+	names.AttrAssignPublicIP: types.BoolType,
+	names.AttrSecurityGroups: types.SetType{ElemType: types.StringType},
+	names.AttrSubnets:        types.SetType{ElemType: types.StringType},
+}
+
+type taskSetScaleData struct {
+	Unit  types.String  `tfsdk:"unit"`
+	Value types.Float64 `tfsdk:"value"`
+}
+
+var taskSetScaleAttrTypes = map[string]attr.Type{
+	"unit":  types.StringType,
+	"value": types.Float64Type,
+}
+
+type taskSetServiceRegistryData struct {
+	ContainerName types.String `tfsdk:"container_name"`
+	ContainerPort types.Int64  `tfsdk:"container_port"`
+	Port          types.Int64  `tfsdk:"port"`
+	RegistryARN   fwtypes.ARN  `tfsdk:"registry_arn"`
+}
+
+var taskSetServiceRegistryAttrTypes = map[string]attr.Type{
+	"container_name": types.StringType,
+	"container_port": types.Int64Type,
+	"port":           types.Int64Type,
+	"registry_arn":   fwtypes.ARNType,
+}
+
+const taskSetResourceIDSeparator = ","
+
+func TaskSetCreateResourceID(taskSetID, service, cluster string) string {
+	parts := []string{taskSetID, service, cluster}
+	id := strings.Join(parts, taskSetResourceIDSeparator)
+
+	return id
 }
 
 func TaskSetParseID(id string) (string, string, string, error) {
-	parts := strings.Split(id, ",")
+	parts := strings.Split(id, taskSetResourceIDSeparator)
 
 	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
 		return "", "", "", fmt.Errorf("unexpected format of ID (%q), expected TASK_SET_ID,SERVICE,CLUSTER", id)
@@ -571,24 +1021,142 @@ func TaskSetParseID(id string) (string, string, string, error) {
 	return parts[0], parts[1], parts[2], nil
 }
 
-func retryTaskSetCreate(ctx context.Context, conn *ecs.ECS, input *ecs.CreateTaskSetInput) (*ecs.CreateTaskSetOutput, error) {
+func retryTaskSetCreate(ctx context.Context, conn *ecs.Client, input *ecs.CreateTaskSetInput) (*ecs.CreateTaskSetOutput, error) {
 	outputRaw, err := tfresource.RetryWhen(ctx, propagationTimeout+taskSetCreateTimeout,
 		func() (interface{}, error) {
-			return conn.CreateTaskSetWithContext(ctx, input)
+			return conn.CreateTaskSet(ctx, input)
 		},
 		func(err error) (bool, error) {
-			if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException, ecs.ErrCodeServiceNotFoundException, ecs.ErrCodeTaskSetNotFoundException) ||
-				tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "does not have an associated load balancer") {
+			if errs.IsA[*awstypes.ClusterNotFoundException](err) ||
+				errs.IsA[*awstypes.ServiceNotFoundException](err) ||
+				errs.IsA[*awstypes.TaskSetNotFoundException](err) ||
+				errs.IsAErrorMessageContains[*awstypes.InvalidParameterException](err, "does not have an associated load balancer") {
 				return true, err
 			}
 			return false, err
 		},
 	)
 
+	if err != nil {
+		return nil, err
+	}
+
 	output, ok := outputRaw.(*ecs.CreateTaskSetOutput)
 	if !ok || output == nil || output.TaskSet == nil {
 		return nil, fmt.Errorf("error creating ECS TaskSet: empty output")
 	}
 
-	return output, err
+	return output, nil
+}
+
+func FindTaskSetByID(ctx context.Context, conn *ecs.Client, taskSetID, service, cluster string) (*awstypes.TaskSet, error) {
+	input := &ecs.DescribeTaskSetsInput{
+		Cluster:  aws.String(cluster),
+		Include:  []awstypes.TaskSetField{awstypes.TaskSetFieldTags},
+		Service:  aws.String(service),
+		TaskSets: []string{taskSetID},
+	}
+
+	return findTaskSet(ctx, conn, input)
+}
+
+func FindTaskSetNoTagsByID(ctx context.Context, conn *ecs.Client, taskSetID, service, cluster string) (*awstypes.TaskSet, error) {
+	input := &ecs.DescribeTaskSetsInput{
+		Cluster:  aws.String(cluster),
+		Service:  aws.String(service),
+		TaskSets: []string{taskSetID},
+	}
+
+	return findTaskSet(ctx, conn, input)
+}
+
+func findTaskSet(ctx context.Context, conn *ecs.Client, input *ecs.DescribeTaskSetsInput) (*awstypes.TaskSet, error) {
+	output, err := conn.DescribeTaskSets(ctx, input)
+
+	if errs.IsA[*awstypes.ClusterNotFoundException](err) || errs.IsA[*awstypes.ServiceNotFoundException](err) || errs.IsA[*awstypes.TaskSetNotFoundException](err) {
+		return nil, &sdkresource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TaskSets) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if n := len(output.TaskSets); n > 1 {
+		return nil, tfresource.NewTooManyResultsError(n, input)
+	}
+
+	return &output.TaskSets[0], nil
+}
+
+func statusTaskSetStability(ctx context.Context, conn *ecs.Client, taskSetID, service, cluster string) sdkresource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTaskSetNoTagsByID(ctx, conn, taskSetID, service, cluster)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.StabilityStatus), nil
+	}
+}
+
+func statusTaskSet(ctx context.Context, conn *ecs.Client, taskSetID, service, cluster string) sdkresource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTaskSetNoTagsByID(ctx, conn, taskSetID, service, cluster)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitTaskSetStable(ctx context.Context, conn *ecs.Client, timeout time.Duration, taskSetID, service, cluster string) (*awstypes.TaskSet, error) {
+	stateConf := &sdkresource.StateChangeConf{
+		Pending: enum.Slice(awstypes.StabilityStatusStabilizing),
+		Target:  enum.Slice(awstypes.StabilityStatusSteadyState),
+		Refresh: statusTaskSetStability(ctx, conn, taskSetID, service, cluster),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.TaskSet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTaskSetDeleted(ctx context.Context, conn *ecs.Client, taskSetID, service, cluster string) (*awstypes.TaskSet, error) {
+	stateConf := &sdkresource.StateChangeConf{
+		Pending: []string{taskSetStatusActive, taskSetStatusPrimary, taskSetStatusDraining},
+		Target:  []string{},
+		Refresh: statusTaskSet(ctx, conn, taskSetID, service, cluster),
+		Timeout: taskSetDeleteTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.TaskSet); ok {
+		return output, err
+	}
+
+	return nil, err
 }
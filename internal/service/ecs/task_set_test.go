@@ -6,32 +6,30 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ecs"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAccECSTaskSet_basic(t *testing.T) {
 	ctx := acctest.Context(t)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecs_task_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTaskSetDestroy(ctx),
+		CheckDestroy:             testAccCheckTaskSetDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskSetConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ecs", regexp.MustCompile(fmt.Sprintf("task-set/%[1]s/%[1]s/ecs-svc/.+", rName))),
 					resource.TestCheckResourceAttr(resourceName, "service_registries.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "load_balancer.#", "0"),
@@ -53,19 +51,19 @@ func TestAccECSTaskSet_basic(t *testing.T) {
 
 func TestAccECSTaskSet_withExternalId(t *testing.T) {
 	ctx := acctest.Context(t)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecs_task_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTaskSetDestroy(ctx),
+		CheckDestroy:             testAccCheckTaskSetDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskSetConfig_externalID(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "service_registries.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "load_balancer.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "external_id", "TEST_ID"),
@@ -85,21 +83,21 @@ func TestAccECSTaskSet_withExternalId(t *testing.T) {
 
 func TestAccECSTaskSet_withScale(t *testing.T) {
 	ctx := acctest.Context(t)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecs_task_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTaskSetDestroy(ctx),
+		CheckDestroy:             testAccCheckTaskSetDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskSetConfig_scale(rName, 0.0),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "scale.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scale.0.unit", ecs.ScaleUnitPercent),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.unit", string(awstypes.ScaleUnitPercent)),
 					resource.TestCheckResourceAttr(resourceName, "scale.0.value", "0"),
 				),
 			},
@@ -114,9 +112,9 @@ func TestAccECSTaskSet_withScale(t *testing.T) {
 			{
 				Config: testAccTaskSetConfig_scale(rName, 100.0),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "scale.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scale.0.unit", ecs.ScaleUnitPercent),
+					resource.TestCheckResourceAttr(resourceName, "scale.0.unit", string(awstypes.ScaleUnitPercent)),
 					resource.TestCheckResourceAttr(resourceName, "scale.0.value", "100"),
 				),
 			},
@@ -134,20 +132,20 @@ func TestAccECSTaskSet_withScale(t *testing.T) {
 
 func TestAccECSTaskSet_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecs_task_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTaskSetDestroy(ctx),
+		CheckDestroy:             testAccCheckTaskSetDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskSetConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfecs.ResourceTaskSet(), resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
+					acctest.CheckFrameworkResourceDisappears(acctest.Provider, tfecs.ResourceTaskSet, resourceName),
 				),
 				ExpectNonEmptyPlan: true,
 			},
@@ -155,21 +153,52 @@ func TestAccECSTaskSet_disappears(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskSet_MigrateFromPluginSDK(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_set.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, names.ECSEndpointID),
+		CheckDestroy: testAccCheckTaskSetDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"aws": {
+						Source:            "hashicorp/aws",
+						VersionConstraint: "4.55.0",
+					},
+				},
+				Config: testAccTaskSetConfig_scale(rName, 50.0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskSetExists(ctx, t, resourceName),
+				),
+			},
+			{
+				ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+				Config:                   testAccTaskSetConfig_scale(rName, 50.0),
+				PlanOnly:                 true,
+			},
+		},
+	})
+}
+
 func TestAccECSTaskSet_withCapacityProviderStrategy(t *testing.T) {
 	ctx := acctest.Context(t)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecs_task_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTaskSetDestroy(ctx),
+		CheckDestroy:             testAccCheckTaskSetDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskSetConfig_capacityProviderStrategy(rName, 1, 0),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
 				),
 			},
 			{
@@ -183,7 +212,7 @@ func TestAccECSTaskSet_withCapacityProviderStrategy(t *testing.T) {
 			{
 				Config: testAccTaskSetConfig_capacityProviderStrategy(rName, 10, 1),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
 				),
 			},
 			{
@@ -200,19 +229,19 @@ func TestAccECSTaskSet_withCapacityProviderStrategy(t *testing.T) {
 
 func TestAccECSTaskSet_withMultipleCapacityProviderStrategies(t *testing.T) {
 	ctx := acctest.Context(t)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecs_task_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTaskSetDestroy(ctx),
+		CheckDestroy:             testAccCheckTaskSetDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskSetConfig_multipleCapacityProviderStrategies(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "capacity_provider_strategy.#", "2"),
 				),
 			},
@@ -230,19 +259,19 @@ func TestAccECSTaskSet_withMultipleCapacityProviderStrategies(t *testing.T) {
 
 func TestAccECSTaskSet_withAlb(t *testing.T) {
 	ctx := acctest.Context(t)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecs_task_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTaskSetDestroy(ctx),
+		CheckDestroy:             testAccCheckTaskSetDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskSetConfig_alb(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "load_balancer.#", "1"),
 				),
 			},
@@ -260,19 +289,19 @@ func TestAccECSTaskSet_withAlb(t *testing.T) {
 
 func TestAccECSTaskSet_withLaunchTypeFargate(t *testing.T) {
 	ctx := acctest.Context(t)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecs_task_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTaskSetDestroy(ctx),
+		CheckDestroy:             testAccCheckTaskSetDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskSetConfig_launchTypeFargate(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "launch_type", "FARGATE"),
 					resource.TestCheckResourceAttr(resourceName, "network_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.assign_public_ip", "false"),
@@ -295,19 +324,19 @@ func TestAccECSTaskSet_withLaunchTypeFargate(t *testing.T) {
 
 func TestAccECSTaskSet_withLaunchTypeFargateAndPlatformVersion(t *testing.T) {
 	ctx := acctest.Context(t)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecs_task_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTaskSetDestroy(ctx),
+		CheckDestroy:             testAccCheckTaskSetDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskSetConfig_launchTypeFargateAndPlatformVersion(rName, "1.3.0"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "platform_version", "1.3.0"),
 				),
 			},
@@ -322,7 +351,7 @@ func TestAccECSTaskSet_withLaunchTypeFargateAndPlatformVersion(t *testing.T) {
 			{
 				Config: testAccTaskSetConfig_launchTypeFargateAndPlatformVersion(rName, "1.4.0"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "platform_version", "1.4.0"),
 				),
 			},
@@ -340,19 +369,19 @@ func TestAccECSTaskSet_withLaunchTypeFargateAndPlatformVersion(t *testing.T) {
 
 func TestAccECSTaskSet_withServiceRegistries(t *testing.T) {
 	ctx := acctest.Context(t)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecs_task_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTaskSetDestroy(ctx),
+		CheckDestroy:             testAccCheckTaskSetDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskSetConfig_serviceRegistries(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "service_registries.#", "1"),
 				),
 			},
@@ -370,19 +399,19 @@ func TestAccECSTaskSet_withServiceRegistries(t *testing.T) {
 
 func TestAccECSTaskSet_Tags(t *testing.T) {
 	ctx := acctest.Context(t)
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_ecs_task_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSEndpointID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTaskSetDestroy(ctx),
+		CheckDestroy:             testAccCheckTaskSetDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTaskSetConfig_tags1(rName, "key1", "value1"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
 				),
@@ -398,7 +427,7 @@ func TestAccECSTaskSet_Tags(t *testing.T) {
 			{
 				Config: testAccTaskSetConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
@@ -407,7 +436,7 @@ func TestAccECSTaskSet_Tags(t *testing.T) {
 			{
 				Config: testAccTaskSetConfig_tags1(rName, "key2", "value2"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTaskSetExists(ctx, resourceName),
+					testAccCheckTaskSetExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
 				),
@@ -994,65 +1023,45 @@ resource "aws_ecs_task_set" "test" {
 `, rName, platformVersion))
 }
 
-func testAccCheckTaskSetExists(ctx context.Context, name string) resource.TestCheckFunc {
+func testAccCheckTaskSetExists(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[name]
+		rs, ok := s.RootModule().Resources[n]
 		if !ok {
-			return fmt.Errorf("Not found: %s", name)
+			return fmt.Errorf("Not found: %s", n)
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn()
-
-		taskSetId, service, cluster, err := tfecs.TaskSetParseID(rs.Primary.ID)
+		taskSetID, service, cluster, err := tfecs.TaskSetParseID(rs.Primary.ID)
 
 		if err != nil {
 			return err
 		}
 
-		input := &ecs.DescribeTaskSetsInput{
-			TaskSets: aws.StringSlice([]string{taskSetId}),
-			Cluster:  aws.String(cluster),
-			Service:  aws.String(service),
-		}
-
-		output, err := conn.DescribeTaskSetsWithContext(ctx, input)
+		conn := acctest.ProviderMeta(t).ECSClient()
 
-		if err != nil {
-			return err
-		}
+		_, err = tfecs.FindTaskSetNoTagsByID(ctx, conn, taskSetID, service, cluster)
 
-		if output == nil || len(output.TaskSets) == 0 {
-			return fmt.Errorf("ECS TaskSet (%s) not found", rs.Primary.ID)
-		}
-
-		return nil
+		return err
 	}
 }
 
-func testAccCheckTaskSetDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckTaskSetDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn()
+		conn := acctest.ProviderMeta(t).ECSClient()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_ecs_task_set" {
 				continue
 			}
 
-			taskSetId, service, cluster, err := tfecs.TaskSetParseID(rs.Primary.ID)
+			taskSetID, service, cluster, err := tfecs.TaskSetParseID(rs.Primary.ID)
 
 			if err != nil {
 				return err
 			}
 
-			input := &ecs.DescribeTaskSetsInput{
-				TaskSets: aws.StringSlice([]string{taskSetId}),
-				Cluster:  aws.String(cluster),
-				Service:  aws.String(service),
-			}
-
-			output, err := conn.DescribeTaskSetsWithContext(ctx, input)
+			_, err = tfecs.FindTaskSetNoTagsByID(ctx, conn, taskSetID, service, cluster)
 
-			if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException, ecs.ErrCodeServiceNotFoundException, ecs.ErrCodeTaskSetNotFoundException) {
+			if tfresource.NotFound(err) {
 				continue
 			}
 
@@ -1060,9 +1069,7 @@ func testAccCheckTaskSetDestroy(ctx context.Context) resource.TestCheckFunc {
 				return err
 			}
 
-			if output != nil && len(output.TaskSets) == 1 {
-				return fmt.Errorf("ECS TaskSet (%s) still exists", rs.Primary.ID)
-			}
+			return fmt.Errorf("ECS Task Set %s still exists", rs.Primary.ID)
 		}
 
 		return nil
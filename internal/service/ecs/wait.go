@@ -163,29 +163,3 @@ func waitClusterDeleted(ctx context.Context, conn *ecs.ECS, arn string) (*ecs.Cl
 
 	return nil, err
 }
-
-func waitTaskSetStable(ctx context.Context, conn *ecs.ECS, timeout time.Duration, taskSetID, service, cluster string) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{ecs.StabilityStatusStabilizing},
-		Target:  []string{ecs.StabilityStatusSteadyState},
-		Refresh: stabilityStatusTaskSet(ctx, conn, taskSetID, service, cluster),
-		Timeout: timeout,
-	}
-
-	_, err := stateConf.WaitForStateContext(ctx)
-
-	return err
-}
-
-func waitTaskSetDeleted(ctx context.Context, conn *ecs.ECS, taskSetID, service, cluster string) error {
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{taskSetStatusActive, taskSetStatusPrimary, taskSetStatusDraining},
-		Target:  []string{},
-		Refresh: statusTaskSet(ctx, conn, taskSetID, service, cluster),
-		Timeout: taskSetDeleteTimeout,
-	}
-
-	_, err := stateConf.WaitForStateContext(ctx)
-
-	return err
-}
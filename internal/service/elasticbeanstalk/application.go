@@ -10,8 +10,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -29,7 +31,10 @@ func ResourceApplication() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceApplicationCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -56,12 +61,14 @@ func ResourceApplication() *schema.Resource {
 							Required: true,
 						},
 						"max_age_in_days": {
-							Type:     schema.TypeInt,
-							Optional: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 						"max_count": {
-							Type:     schema.TypeInt,
-							Optional: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 						"delete_source_from_s3": {
 							Type:     schema.TypeBool,
@@ -76,6 +83,37 @@ func ResourceApplication() *schema.Resource {
 	}
 }
 
+func resourceApplicationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.GetOk("appversion_lifecycle")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	maxAgeInDays := tfMap["max_age_in_days"].(int)
+	maxCount := tfMap["max_count"].(int)
+
+	// Unknown values are read as zero, so these checks only fire on values known at plan time.
+	if maxAgeInDays != 0 && maxCount != 0 {
+		return fmt.Errorf(`"appversion_lifecycle": only one of "max_age_in_days" (%d) or "max_count" (%d) can be set, Elastic Beanstalk cannot enable both rules simultaneously`, maxAgeInDays, maxCount)
+	}
+
+	if tfMap["delete_source_from_s3"].(bool) {
+		if v := diff.GetRawConfig().GetAttr("appversion_lifecycle"); v.IsKnown() && !v.IsNull() {
+			for it := v.ElementIterator(); it.Next(); {
+				_, rawMap := it.Element()
+
+				if rawMap.GetAttr("max_age_in_days").IsNull() && rawMap.GetAttr("max_count").IsNull() {
+					return fmt.Errorf(`"appversion_lifecycle": "delete_source_from_s3" requires one of "max_age_in_days" or "max_count" to be set, otherwise no application versions are deleted`)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	beanstalkConn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
@@ -262,8 +300,9 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("name", app.ApplicationName)
 	d.Set("description", app.Description)
 
-	if app.ResourceLifecycleConfig != nil {
-		d.Set("appversion_lifecycle", flattenResourceLifecycleConfig(app.ResourceLifecycleConfig))
+	// Always read back the lifecycle configuration so that changes made outside Terraform are reconciled.
+	if err := d.Set("appversion_lifecycle", flattenResourceLifecycleConfig(app.ResourceLifecycleConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting appversion_lifecycle: %s", err)
 	}
 
 	tags, err := ListTags(ctx, conn, arn)
@@ -3,6 +3,7 @@ package elasticbeanstalk_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -103,6 +104,28 @@ func TestAccElasticBeanstalkApplication_BeanstalkApp_appVersionLifecycle(t *test
 	})
 }
 
+func TestAccElasticBeanstalkApplication_BeanstalkApp_appVersionLifecycleValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApplicationConfig_maxAgeAndMaxCount(rName),
+				ExpectError: regexp.MustCompile(`only one of "max_age_in_days" \(90\) or "max_count" \(10\) can be set`),
+			},
+			{
+				Config:      testAccApplicationConfig_deleteSourceFromS3NoRule(rName),
+				ExpectError: regexp.MustCompile(`"delete_source_from_s3" requires one of "max_age_in_days" or "max_count"`),
+			},
+		},
+	})
+}
+
 func TestAccElasticBeanstalkApplication_BeanstalkApp_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var app elasticbeanstalk.ApplicationDescription
@@ -311,6 +334,35 @@ resource "aws_elastic_beanstalk_application" "tftest" {
 `, rName)
 }
 
+func testAccApplicationConfig_maxAgeAndMaxCount(rName string) string {
+	return testAccApplicationConfig_serviceRole(rName) + fmt.Sprintf(`
+resource "aws_elastic_beanstalk_application" "tftest" {
+  name        = "%s"
+  description = "tf-test-desc"
+
+  appversion_lifecycle {
+    service_role    = aws_iam_role.beanstalk_service.arn
+    max_age_in_days = 90
+    max_count       = 10
+  }
+}
+`, rName)
+}
+
+func testAccApplicationConfig_deleteSourceFromS3NoRule(rName string) string {
+	return testAccApplicationConfig_serviceRole(rName) + fmt.Sprintf(`
+resource "aws_elastic_beanstalk_application" "tftest" {
+  name        = "%s"
+  description = "tf-test-desc"
+
+  appversion_lifecycle {
+    service_role          = aws_iam_role.beanstalk_service.arn
+    delete_source_from_s3 = true
+  }
+}
+`, rName)
+}
+
 func testAccApplicationConfig_tags2(rName, tag1, tag2 string) string {
 	return fmt.Sprintf(`
 resource "aws_elastic_beanstalk_application" "tftest" {
@@ -1,18 +1,29 @@
 package elasticbeanstalk
 
-import (
+import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
 	"log"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -23,7 +34,14 @@ func ResourceApplicationVersion() *schema.Resource {
 		UpdateWithoutTimeout: resourceApplicationVersionUpdate,
 		DeleteWithoutTimeout: resourceApplicationVersionDelete,
 
-		CustomizeDiff: verify.SetTagsDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourceApplicationVersionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"application": {
@@ -41,12 +59,14 @@ func ResourceApplicationVersion() *schema.Resource {
 			},
 			"bucket": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"key": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"name": {
@@ -59,6 +79,26 @@ func ResourceApplicationVersion() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"process": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"source_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+				ForceNew: true,
+			},
+			"source_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -77,6 +117,30 @@ func resourceApplicationVersionCreate(ctx context.Context, d *schema.ResourceDat
 	key := d.Get("key").(string)
 	name := d.Get("name").(string)
 
+	if sourcePath := d.Get("source_path").(string); sourcePath != "" {
+		if bucket == "" {
+			output, err := conn.CreateStorageLocationWithContext(ctx, &elasticbeanstalk.CreateStorageLocationInput{})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating Elastic Beanstalk storage location: %s", err)
+			}
+
+			bucket = aws.StringValue(output.S3Bucket)
+		}
+
+		if key == "" {
+			key = fmt.Sprintf("%s/%s-%s", application, name, filepath.Base(sourcePath))
+		}
+
+		hash, err := uploadApplicationVersionSourceBundle(ctx, meta.(*conns.AWSClient).S3Conn(), sourcePath, bucket, key)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "uploading Elastic Beanstalk Application Version (%s) source bundle: %s", name, err)
+		}
+
+		d.Set("source_hash", hash)
+	}
+
 	s3Location := elasticbeanstalk.S3Location{
 		S3Bucket: aws.String(bucket),
 		S3Key:    aws.String(key),
@@ -85,6 +149,7 @@ func resourceApplicationVersionCreate(ctx context.Context, d *schema.ResourceDat
 	createOpts := elasticbeanstalk.CreateApplicationVersionInput{
 		ApplicationName: aws.String(application),
 		Description:     aws.String(description),
+		Process:         aws.Bool(d.Get("process").(bool)),
 		SourceBundle:    &s3Location,
 		Tags:            Tags(tags.IgnoreElasticbeanstalk()),
 		VersionLabel:    aws.String(name),
@@ -97,9 +162,44 @@ func resourceApplicationVersionCreate(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(name)
 
+	if d.Get("process").(bool) {
+		if _, err := waitApplicationVersionProcessed(ctx, conn, application, name, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Elastic Beanstalk Application Version (%s) processing: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceApplicationVersionRead(ctx, d, meta)...)
 }
 
+func resourceApplicationVersionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("source_path") {
+		return diff.SetNewComputed("source_hash")
+	}
+
+	sourcePath := diff.Get("source_path").(string)
+
+	if sourcePath == "" {
+		if diff.Id() == "" && diff.NewValueKnown("bucket") && diff.NewValueKnown("key") && (diff.Get("bucket").(string) == "" || diff.Get("key").(string) == "") {
+			return errors.New(`"bucket" and "key" are required when "source_path" is not set`)
+		}
+
+		return nil
+	}
+
+	hash, err := applicationVersionSourceBundleHash(sourcePath)
+
+	if err != nil {
+		return fmt.Errorf("reading source_path (%s): %w", sourcePath, err)
+	}
+
+	// A changed source bundle replaces the application version, as its source can't be updated.
+	if diff.Get("source_hash").(string) != hash {
+		return diff.SetNew("source_hash", hash)
+	}
+
+	return nil
+}
+
 func resourceApplicationVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
@@ -128,6 +228,11 @@ func resourceApplicationVersionRead(ctx context.Context, d *schema.ResourceData,
 	arn := aws.StringValue(resp.ApplicationVersions[0].ApplicationVersionArn)
 	d.Set("arn", arn)
 	d.Set("description", resp.ApplicationVersions[0].Description)
+	if v := resp.ApplicationVersions[0].SourceBundle; v != nil {
+		d.Set("bucket", v.S3Bucket)
+		d.Set("key", v.S3Key)
+	}
+	d.Set("status", resp.ApplicationVersions[0].Status)
 
 	tags, err := ListTags(ctx, conn, arn)
 
@@ -240,3 +345,108 @@ func versionUsedBy(ctx context.Context, applicationName, versionLabel string, co
 
 	return environmentIDs, nil
 }
+
+func findApplicationVersionByTwoPartKey(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, applicationName, versionLabel string) (*elasticbeanstalk.ApplicationVersionDescription, error) {
+	input := &elasticbeanstalk.DescribeApplicationVersionsInput{
+		ApplicationName: aws.String(applicationName),
+		VersionLabels:   aws.StringSlice([]string{versionLabel}),
+	}
+
+	output, err := conn.DescribeApplicationVersionsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ApplicationVersions) == 0 || output.ApplicationVersions[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ApplicationVersions); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ApplicationVersions[0], nil
+}
+
+func statusApplicationVersion(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, applicationName, versionLabel string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findApplicationVersionByTwoPartKey(ctx, conn, applicationName, versionLabel)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitApplicationVersionProcessed(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, applicationName, versionLabel string, timeout time.Duration) (*elasticbeanstalk.ApplicationVersionDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{elasticbeanstalk.ApplicationVersionStatusUnprocessed, elasticbeanstalk.ApplicationVersionStatusProcessing, elasticbeanstalk.ApplicationVersionStatusBuilding},
+		Target:     []string{elasticbeanstalk.ApplicationVersionStatusProcessed},
+		Refresh:    statusApplicationVersion(ctx, conn, applicationName, versionLabel),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*elasticbeanstalk.ApplicationVersionDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// applicationVersionSourceBundleHash returns the hex-encoded SHA-256 hash of a local source bundle.
+func applicationVersionSourceBundleHash(path string) (string, error) {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	h := sha256.New()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// uploadApplicationVersionSourceBundle uploads a local source bundle to S3 and returns its hash.
+func uploadApplicationVersionSourceBundle(ctx context.Context, conn *s3.S3, path, bucket, key string) (string, error) {
+	hash, err := applicationVersionSourceBundleHash(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	f, err := os.Open(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer f.Close()
+
+	_, err = conn.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Body:   f,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("putting S3 Object (%s/%s): %w", bucket, key, err)
+	}
+
+	return hash, nil
+}
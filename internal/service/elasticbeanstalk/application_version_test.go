@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -110,6 +111,50 @@ func TestAccElasticBeanstalkApplicationVersion_BeanstalkApp_tags(t *testing.T) {
 	})
 }
 
+func TestAccElasticBeanstalkApplicationVersion_BeanstalkApp_sourcePath(t *testing.T) {
+	ctx := acctest.Context(t)
+	var appVersion elasticbeanstalk.ApplicationVersionDescription
+	resourceName := "aws_elastic_beanstalk_application_version.default"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationVersionConfig_sourcePath(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationVersionExists(ctx, resourceName, &appVersion),
+					resource.TestCheckResourceAttrSet(resourceName, "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "key", fmt.Sprintf("%[1]s/%[1]s-python-v1.zip", rName)),
+					resource.TestMatchResourceAttr(resourceName, "source_hash", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+					resource.TestCheckResourceAttr(resourceName, "status", elasticbeanstalk.ApplicationVersionStatusProcessed),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkApplicationVersion_BeanstalkApp_missingSource(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccApplicationVersionConfig_missingSource(rName),
+				ExpectError: regexp.MustCompile(`"bucket" and "key" are required when "source_path" is not set`),
+			},
+		},
+	})
+}
+
 func testAccCheckApplicationVersionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticBeanstalkConn()
@@ -299,3 +344,31 @@ resource "aws_elastic_beanstalk_application_version" "default" {
 }
 `, randInt, tag1, tag2, tag3)
 }
+
+func testAccApplicationVersionConfig_sourcePath(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elastic_beanstalk_application" "default" {
+  name = %[1]q
+}
+
+resource "aws_elastic_beanstalk_application_version" "default" {
+  application = aws_elastic_beanstalk_application.default.name
+  name        = %[1]q
+  source_path = "test-fixtures/python-v1.zip"
+  process     = true
+}
+`, rName)
+}
+
+func testAccApplicationVersionConfig_missingSource(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elastic_beanstalk_application" "default" {
+  name = %[1]q
+}
+
+resource "aws_elastic_beanstalk_application_version" "default" {
+  application = aws_elastic_beanstalk_application.default.name
+  name        = %[1]q
+}
+`, rName)
+}
@@ -2,11 +2,13 @@ package elasticbeanstalk
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,6 +24,8 @@ func ResourceConfigurationTemplate() *schema.Resource {
 		UpdateWithoutTimeout: resourceConfigurationTemplateUpdate,
 		DeleteWithoutTimeout: resourceConfigurationTemplateDelete,
 
+		CustomizeDiff: resourceConfigurationTemplateCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"application": {
 				Type:     schema.TypeString,
@@ -58,6 +62,51 @@ func ResourceConfigurationTemplate() *schema.Resource {
 	}
 }
 
+func resourceConfigurationTemplateCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("setting", "solution_stack_name") {
+		return nil
+	}
+
+	// The option catalog can only be looked up when the platform is known at plan time.
+	solutionStackName := diff.Get("solution_stack_name").(string)
+	if !diff.NewValueKnown("solution_stack_name") || solutionStackName == "" {
+		return nil
+	}
+
+	// Read namespaces and option names from configuration so that settings with computed values are still validated.
+	var settings []*elasticbeanstalk.ConfigurationOptionSetting
+	if v := diff.GetRawConfig().GetAttr("setting"); v.IsKnown() && !v.IsNull() {
+		for it := v.ElementIterator(); it.Next(); {
+			_, v := it.Element()
+			namespace, name := v.GetAttr("namespace"), v.GetAttr("name")
+
+			if !namespace.IsKnown() || namespace.IsNull() || !name.IsKnown() || name.IsNull() {
+				continue
+			}
+
+			settings = append(settings, &elasticbeanstalk.ConfigurationOptionSetting{
+				Namespace:  aws.String(namespace.AsString()),
+				OptionName: aws.String(name.AsString()),
+			})
+		}
+	}
+
+	if len(settings) == 0 {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
+
+	options, err := findConfigurationOptionsBySolutionStackName(ctx, conn, solutionStackName)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to validate Elastic Beanstalk Configuration Template (%s) settings: describing configuration options for %q: %s", diff.Get("name").(string), solutionStackName, err)
+		return nil
+	}
+
+	return validateOptionSettings(settings, options)
+}
+
 func resourceConfigurationTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
@@ -263,6 +312,59 @@ func FindConfigurationSettingsByTwoPartKey(ctx context.Context, conn *elasticbea
 	return output.ConfigurationSettings[0], nil
 }
 
+func findConfigurationOptionsBySolutionStackName(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, solutionStackName string) ([]*elasticbeanstalk.ConfigurationOptionDescription, error) {
+	input := &elasticbeanstalk.DescribeConfigurationOptionsInput{
+		SolutionStackName: aws.String(solutionStackName),
+	}
+
+	output, err := conn.DescribeConfigurationOptionsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Options, nil
+}
+
+// validateOptionSettings checks that every option setting names a namespace and option present in the platform's option catalog.
+func validateOptionSettings(settings []*elasticbeanstalk.ConfigurationOptionSetting, options []*elasticbeanstalk.ConfigurationOptionDescription) error {
+	known := make(map[string]map[string]struct{})
+	for _, option := range options {
+		namespace := aws.StringValue(option.Namespace)
+		if _, ok := known[namespace]; !ok {
+			known[namespace] = make(map[string]struct{})
+		}
+		known[namespace][aws.StringValue(option.Name)] = struct{}{}
+	}
+
+	var errs *multierror.Error
+	for _, setting := range settings {
+		namespace, name := aws.StringValue(setting.Namespace), aws.StringValue(setting.OptionName)
+
+		switch namespace {
+		case optionSettingNamespaceApplicationEnvironment, optionSettingNamespaceCustomOption:
+			// Option names in these namespaces are user defined.
+			continue
+		}
+
+		names, ok := known[namespace]
+		if !ok {
+			errs = multierror.Append(errs, fmt.Errorf("setting %s:%s: unknown namespace %q", namespace, name, namespace))
+			continue
+		}
+
+		if _, ok := names[name]; !ok {
+			errs = multierror.Append(errs, fmt.Errorf("setting %s:%s: unknown option %q in namespace %q", namespace, name, name, namespace))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func gatherOptionSettings(d *schema.ResourceData) []*elasticbeanstalk.ConfigurationOptionSetting {
 	optionSettingsSet, ok := d.Get("setting").(*schema.Set)
 	if !ok || optionSettingsSet == nil {
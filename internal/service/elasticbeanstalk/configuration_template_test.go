@@ -3,8 +3,10 @@ package elasticbeanstalk_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -15,6 +17,71 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestValidateOptionSettings(t *testing.T) {
+	t.Parallel()
+
+	options := []*elasticbeanstalk.ConfigurationOptionDescription{
+		{Namespace: aws.String("aws:autoscaling:launchconfiguration"), Name: aws.String("InstanceType")},
+		{Namespace: aws.String("aws:ec2:vpc"), Name: aws.String("VPCId")},
+	}
+
+	testCases := []struct {
+		Name          string
+		Namespace     string
+		OptionName    string
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name:       "known option",
+			Namespace:  "aws:autoscaling:launchconfiguration",
+			OptionName: "InstanceType",
+		},
+		{
+			Name:       "environment property",
+			Namespace:  "aws:elasticbeanstalk:application:environment",
+			OptionName: "DATABASE_URL",
+		},
+		{
+			Name:       "custom option",
+			Namespace:  "aws:elasticbeanstalk:customoption",
+			OptionName: "AlarmEmail",
+		},
+		{
+			Name:          "unknown option",
+			Namespace:     "aws:ec2:vpc",
+			OptionName:    "VpcID",
+			ExpectedError: regexp.MustCompile(`unknown option "VpcID" in namespace "aws:ec2:vpc"`),
+		},
+		{
+			Name:          "unknown namespace",
+			Namespace:     "aws:ec2:vpcs",
+			OptionName:    "VPCId",
+			ExpectedError: regexp.MustCompile(`unknown namespace "aws:ec2:vpcs"`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			settings := []*elasticbeanstalk.ConfigurationOptionSetting{
+				{Namespace: aws.String(testCase.Namespace), OptionName: aws.String(testCase.OptionName)},
+			}
+
+			err := tfelasticbeanstalk.ValidateOptionSettings(settings, options)
+
+			if testCase.ExpectedError == nil && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedError != nil && (err == nil || !testCase.ExpectedError.MatchString(err.Error())) {
+				t.Errorf("expected error matching %q, got: %v", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
+
 func TestAccElasticBeanstalkConfigurationTemplate_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var config elasticbeanstalk.ConfigurationSettingsDescription
@@ -109,6 +176,24 @@ func TestAccElasticBeanstalkConfigurationTemplate_settings(t *testing.T) {
 	})
 }
 
+func TestAccElasticBeanstalkConfigurationTemplate_settingUnknownOption(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigurationTemplateConfig_settingUnknownOption(rName),
+				ExpectError: regexp.MustCompile(`unknown option "InstanceTypo" in namespace "aws:autoscaling:launchconfiguration"`),
+			},
+		},
+	})
+}
+
 func testAccCheckConfigurationTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticBeanstalkConn()
@@ -224,3 +309,30 @@ resource "aws_elastic_beanstalk_configuration_template" "test" {
 }
 `, rName)
 }
+
+func testAccConfigurationTemplateConfig_settingUnknownOption(rName string) string {
+	return fmt.Sprintf(`
+data "aws_elastic_beanstalk_solution_stack" "test" {
+  most_recent = true
+  name_regex  = "64bit Amazon Linux .* running Python .*"
+}
+
+resource "aws_elastic_beanstalk_application" "test" {
+  name        = %[1]q
+  description = "testing"
+}
+
+resource "aws_elastic_beanstalk_configuration_template" "test" {
+  name        = %[1]q
+  application = aws_elastic_beanstalk_application.test.name
+
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "InstanceTypo"
+    value     = "t3.micro"
+  }
+}
+`, rName)
+}
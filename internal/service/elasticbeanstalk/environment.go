@@ -6,6 +6,7 @@ import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
 	"log"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -22,6 +24,7 @@ import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdktypes"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -69,6 +72,27 @@ const (
 	environmentTierTypeStandard = "Standard"
 )
 
+const (
+	optionSettingNamespaceApplicationEnvironment       = "aws:elasticbeanstalk:application:environment"
+	optionSettingNamespaceCustomOption                 = "aws:elasticbeanstalk:customoption"
+	optionSettingNamespaceEnvironment                  = "aws:elasticbeanstalk:environment"
+	optionSettingNamespaceManagedActions               = "aws:elasticbeanstalk:managedactions"
+	optionSettingNamespaceManagedActionsPlatformUpdate = "aws:elasticbeanstalk:managedactions:platformupdate"
+	optionSettingNamespaceScheduledAction              = "aws:autoscaling:scheduledaction"
+)
+
+const (
+	managedActionsUpdateLevelMinor = "minor"
+	managedActionsUpdateLevelPatch = "patch"
+)
+
+func managedActionsUpdateLevel_Values() []string {
+	return []string{
+		managedActionsUpdateLevelMinor,
+		managedActionsUpdateLevelPatch,
+	}
+}
+
 var (
 	environmentCNAMERegex = regexp.MustCompile(`(^[^.]+)(.\w{2}-\w{4,9}-\d)?\.(elasticbeanstalk\.com|eb\.amazonaws\.com\.cn)$`)
 )
@@ -85,7 +109,11 @@ func ResourceEnvironment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceEnvironmentCustomizeDiff,
+			resourceEnvironmentPlatformCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  EnvironmentMigrateState,
@@ -143,11 +171,52 @@ func ResourceEnvironment() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"managed_actions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"instance_refresh_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"preferred_start_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(?i)(mon|tue|wed|thu|fri|sat|sun):([01]\d|2[0-3]):[0-5]\d$`), "must be in the format ddd:hh:mm, e.g. Sun:10:00"),
+						},
+						"service_role_for_managed_updates": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"update_level": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(managedActionsUpdateLevel_Values(), false),
+						},
+					},
+				},
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"operations_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"platform_arn": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -164,6 +233,58 @@ func ResourceEnvironment() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"scheduled_action": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_capacity": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableIntAtLeast(0),
+						},
+						"end_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidUTCTimestamp,
+						},
+						"max_size": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableIntAtLeast(0),
+						},
+						"min_size": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableIntAtLeast(0),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"recurrence": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"start_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidUTCTimestamp,
+						},
+						"suspend": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"service_role": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"setting": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -200,6 +321,11 @@ func ResourceEnvironment() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"wait_for_health_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(elasticbeanstalk.EnvironmentHealth_Values(), false),
+			},
 			"wait_for_ready_timeout": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -210,6 +336,66 @@ func ResourceEnvironment() *schema.Resource {
 	}
 }
 
+func resourceEnvironmentCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Option settings generated from configuration blocks must not also be specified as raw settings.
+	if v, ok := diff.GetOk("managed_actions"); ok && len(v.([]interface{})) > 0 {
+		for _, tfMapRaw := range diff.Get("setting").(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			switch namespace := tfMap["namespace"].(string); namespace {
+			case optionSettingNamespaceManagedActions, optionSettingNamespaceManagedActionsPlatformUpdate:
+				return fmt.Errorf(`"setting" with namespace %q conflicts with "managed_actions"`, namespace)
+			}
+		}
+	}
+
+	if v, ok := diff.GetOk("scheduled_action"); ok && v.(*schema.Set).Len() > 0 {
+		for _, tfMapRaw := range diff.Get("setting").(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			if namespace := tfMap["namespace"].(string); namespace == optionSettingNamespaceScheduledAction {
+				return fmt.Errorf(`"setting" with namespace %q conflicts with "scheduled_action"`, namespace)
+			}
+		}
+	}
+
+	if !diff.GetRawConfig().GetAttr("service_role").IsNull() {
+		for _, tfMapRaw := range diff.Get("setting").(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			if tfMap["namespace"].(string) == optionSettingNamespaceEnvironment && tfMap["name"].(string) == "ServiceRole" {
+				return fmt.Errorf(`"setting" with namespace %q and name "ServiceRole" conflicts with "service_role"`, optionSettingNamespaceEnvironment)
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceEnvironmentPlatformCustomizeDiff allows an environment to move between solution_stack_name and platform_arn in place.
+// Both attributes are always read, so the one that isn't configured is marked as known after apply whenever the other changes.
+func resourceEnvironmentPlatformCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	rawConfig := diff.GetRawConfig()
+
+	if rawConfig.GetAttr("solution_stack_name").IsNull() && diff.HasChange("platform_arn") {
+		if err := diff.SetNewComputed("solution_stack_name"); err != nil {
+			return err
+		}
+	}
+
+	if rawConfig.GetAttr("platform_arn").IsNull() && diff.HasChange("solution_stack_name") {
+		if err := diff.SetNewComputed("platform_arn"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
@@ -224,10 +410,26 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		Tags:            Tags(tags.IgnoreElasticbeanstalk()),
 	}
 
+	if v, ok := d.GetOk("managed_actions"); ok {
+		input.OptionSettings = append(input.OptionSettings, expandManagedActionsOptionSettings(v.([]interface{}))...)
+	}
+
+	if v, ok := d.GetOk("scheduled_action"); ok {
+		input.OptionSettings = append(input.OptionSettings, expandScheduledActionOptionSettings(v.(*schema.Set).List())...)
+	}
+
+	if v, ok := d.GetOk("service_role"); ok {
+		input.OptionSettings = append(input.OptionSettings, expandServiceRoleOptionSetting(v.(string)))
+	}
+
 	if v := d.Get("description"); v.(string) != "" {
 		input.Description = aws.String(v.(string))
 	}
 
+	if v := d.Get("operations_role"); v.(string) != "" {
+		input.OperationsRole = aws.String(v.(string))
+	}
+
 	if v := d.Get("platform_arn"); v.(string) != "" {
 		input.PlatformArn = aws.String(v.(string))
 	}
@@ -297,6 +499,12 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "creating Elastic Beanstalk Environment (%s): %s", d.Id(), err)
 	}
 
+	if v, ok := environmentWaitForHealthStatus(d, meta); ok {
+		if _, err := waitEnvironmentHealth(ctx, conn, d.Id(), v, pollInterval, waitForReadyTimeOut); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Elastic Beanstalk Environment (%s) health: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceEnvironmentRead(ctx, d, meta)...)
 }
 
@@ -365,6 +573,7 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "setting load_balancers: %s", err)
 	}
 	d.Set("name", environmentName)
+	d.Set("operations_role", env.OperationsRole)
 	d.Set("platform_arn", env.PlatformArn)
 	if err := d.Set("queues", flattenQueues(resources.EnvironmentResources.Queues)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting queues: %s", err)
@@ -423,6 +632,23 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "setting all_settings: %s", err)
 	}
 
+	// Only report managed actions when they are managed via the configuration block,
+	// otherwise they would fight with the same options specified as raw settings.
+	if v, ok := d.GetOk("managed_actions"); ok && len(v.([]interface{})) > 0 {
+		if err := d.Set("managed_actions", flattenManagedActionsOptionSettings(configurationSettings.OptionSettings)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting managed_actions: %s", err)
+		}
+	}
+
+	// As with managed actions, only report scheduled actions when they are managed via the configuration block.
+	if v, ok := d.GetOk("scheduled_action"); ok && v.(*schema.Set).Len() > 0 {
+		if err := d.Set("scheduled_action", flattenScheduledActionOptionSettings(configurationSettings.OptionSettings)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting scheduled_action: %s", err)
+		}
+	}
+
+	d.Set("service_role", flattenServiceRoleOptionSetting(configurationSettings.OptionSettings))
+
 	if err := d.Set("setting", updatedSettings.List()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting setting: %s", err)
 	}
@@ -463,7 +689,16 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 		pollInterval = 0
 	}
 
-	if d.HasChangesExcept("tags", "tags_all", "wait_for_ready_timeout", "poll_interval") {
+	// Associate a new operations role before any other update so that it's used for the update itself.
+	if d.HasChange("operations_role") {
+		if v := d.Get("operations_role").(string); v != "" {
+			if err := associateEnvironmentOperationsRole(ctx, conn, d.Id(), d.Get("name").(string), v, pollInterval, waitForReadyTimeOut); err != nil {
+				return sdkdiag.AppendErrorf(diags, "associating Elastic Beanstalk Environment (%s) operations role (%s): %s", d.Id(), v, err)
+			}
+		}
+	}
+
+	if d.HasChangesExcept("tags", "tags_all", "operations_role", "wait_for_health_status", "wait_for_ready_timeout", "poll_interval") {
 		input := elasticbeanstalk.UpdateEnvironmentInput{
 			EnvironmentId: aws.String(d.Id()),
 		}
@@ -537,13 +772,49 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.OptionSettings = add
 		}
 
-		if d.HasChange("platform_arn") {
+		if d.HasChange("managed_actions") {
+			o, n := d.GetChange("managed_actions")
+			add := expandManagedActionsOptionSettings(n.([]interface{}))
+
+			input.OptionSettings = append(input.OptionSettings, add...)
+			input.OptionsToRemove = append(input.OptionsToRemove, optionSettingsToRemove(expandManagedActionsOptionSettings(o.([]interface{})), add)...)
+		}
+
+		if d.HasChange("scheduled_action") {
+			o, n := d.GetChange("scheduled_action")
+			add := expandScheduledActionOptionSettings(n.(*schema.Set).List())
+
+			input.OptionSettings = append(input.OptionSettings, add...)
+			input.OptionsToRemove = append(input.OptionsToRemove, optionSettingsToRemove(expandScheduledActionOptionSettings(o.(*schema.Set).List()), add)...)
+		}
+
+		if d.HasChange("service_role") {
+			if v, ok := d.GetOk("service_role"); ok {
+				apiObject := expandServiceRoleOptionSetting(v.(string))
+				input.OptionSettings = append(input.OptionSettings, apiObject)
+
+				// The service role may be moving from a "setting" block, which must not also be removed.
+				var optionsToRemove []*elasticbeanstalk.OptionSpecification
+				for _, v := range input.OptionsToRemove {
+					if aws.StringValue(v.Namespace) == aws.StringValue(apiObject.Namespace) && aws.StringValue(v.OptionName) == aws.StringValue(apiObject.OptionName) {
+						continue
+					}
+					optionsToRemove = append(optionsToRemove, v)
+				}
+				input.OptionsToRemove = optionsToRemove
+			}
+		}
+
+		// Only send the platform attribute that is configured, the other one may be changing as a consequence.
+		rawConfig := d.GetRawConfig()
+
+		if d.HasChange("platform_arn") && !rawConfig.GetAttr("platform_arn").IsNull() {
 			if v, ok := d.GetOk("platform_arn"); ok {
 				input.PlatformArn = aws.String(v.(string))
 			}
 		}
 
-		if d.HasChange("solution_stack_name") {
+		if d.HasChange("solution_stack_name") && !rawConfig.GetAttr("solution_stack_name").IsNull() {
 			if v, ok := d.GetOk("solution_stack_name"); ok {
 				input.SolutionStackName = aws.String(v.(string))
 			}
@@ -575,14 +846,47 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Elastic Beanstalk Environment (%s): %s", d.Id(), err)
 		}
+
+		if v, ok := environmentWaitForHealthStatus(d, meta); ok {
+			if _, err := waitEnvironmentHealth(ctx, conn, d.Id(), v, pollInterval, waitForReadyTimeOut); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Elastic Beanstalk Environment (%s) health: %s", d.Id(), err)
+			}
+		}
+	}
+
+	// Disassociate a removed operations role only after all other updates have completed.
+	if d.HasChange("operations_role") {
+		if v := d.Get("operations_role").(string); v == "" {
+			if err := disassociateEnvironmentOperationsRole(ctx, conn, d.Id(), d.Get("name").(string), pollInterval, waitForReadyTimeOut); err != nil {
+				return sdkdiag.AppendErrorf(diags, "disassociating Elastic Beanstalk Environment (%s) operations role: %s", d.Id(), err)
+			}
+		}
 	}
 
 	if d.HasChange("tags_all") {
 		arn := d.Get("arn").(string)
 		o, n := d.GetChange("tags_all")
 
+		// Tags are updated separately from UpdateEnvironment so that tag changes alone never trigger a configuration deployment.
 		opTime := time.Now()
-		if err := UpdateTags(ctx, conn, arn, o, n); err != nil {
+		_, err := tfresource.RetryWhen(ctx, waitForReadyTimeOut,
+			func() (interface{}, error) {
+				return nil, UpdateTags(ctx, conn, arn, o, n)
+			},
+			func(err error) (bool, error) {
+				if tfawserr.ErrCodeEquals(err, elasticbeanstalk.ErrCodeOperationInProgressException) {
+					return true, err
+				}
+
+				if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "Must be Ready") {
+					return true, err
+				}
+
+				return false, err
+			},
+		)
+
+		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Elastic Beanstalk Environment (%s) tags: %s", arn, err)
 		}
 
@@ -642,6 +946,55 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// environmentWaitForHealthStatus returns the health status to wait for after the environment is ready, if any.
+// Unless wait_for_health_status is configured, the provider's wait_for_stabilization argument selects Green.
+func environmentWaitForHealthStatus(d *schema.ResourceData, meta interface{}) (string, bool) {
+	if v, ok := d.GetOk("wait_for_health_status"); ok {
+		return v.(string), true
+	}
+
+	if meta.(*conns.AWSClient).WaitForStabilization {
+		return elasticbeanstalk.EnvironmentHealthGreen, true
+	}
+
+	return "", false
+}
+
+func associateEnvironmentOperationsRole(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, id, name, roleARN string, pollInterval, timeout time.Duration) error {
+	input := &elasticbeanstalk.AssociateEnvironmentOperationsRoleInput{
+		EnvironmentName: aws.String(name),
+		OperationsRole:  aws.String(roleARN),
+	}
+
+	opTime := time.Now()
+	if _, err := conn.AssociateEnvironmentOperationsRoleWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitEnvironmentReady(ctx, conn, id, pollInterval, timeout); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return findEnvironmentErrorsByID(ctx, conn, id, opTime)
+}
+
+func disassociateEnvironmentOperationsRole(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, id, name string, pollInterval, timeout time.Duration) error {
+	input := &elasticbeanstalk.DisassociateEnvironmentOperationsRoleInput{
+		EnvironmentName: aws.String(name),
+	}
+
+	opTime := time.Now()
+	if _, err := conn.DisassociateEnvironmentOperationsRoleWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitEnvironmentReady(ctx, conn, id, pollInterval, timeout); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return findEnvironmentErrorsByID(ctx, conn, id, opTime)
+}
+
 func FindEnvironmentByID(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, id string) (*elasticbeanstalk.EnvironmentDescription, error) {
 	input := &elasticbeanstalk.DescribeEnvironmentsInput{
 		EnvironmentIds: aws.StringSlice([]string{id}),
@@ -748,6 +1101,27 @@ func findConfigurationSettingsByTwoPartKey(ctx context.Context, conn *elasticbea
 	return output.ConfigurationSettings[0], nil
 }
 
+// findEnvironmentHealthCausesByID returns the causes of an environment's health status.
+// Causes are only reported for environments with enhanced health reporting enabled.
+func findEnvironmentHealthCausesByID(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, id string) ([]string, error) {
+	input := &elasticbeanstalk.DescribeEnvironmentHealthInput{
+		AttributeNames: aws.StringSlice([]string{elasticbeanstalk.EnvironmentHealthAttributeCauses}),
+		EnvironmentId:  aws.String(id),
+	}
+
+	output, err := conn.DescribeEnvironmentHealthWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.StringValueSlice(output.Causes), nil
+}
+
 func statusEnvironment(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindEnvironmentByID(ctx, conn, id)
@@ -784,6 +1158,54 @@ func waitEnvironmentReady(ctx context.Context, conn *elasticbeanstalk.ElasticBea
 	return nil, err
 }
 
+func statusEnvironmentHealth(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEnvironmentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Health), nil
+	}
+}
+
+func waitEnvironmentHealth(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, id, health string, pollInterval, timeout time.Duration) (*elasticbeanstalk.EnvironmentDescription, error) {
+	var pending []string
+	for _, v := range elasticbeanstalk.EnvironmentHealth_Values() {
+		if v != health {
+			pending = append(pending, v)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      pending,
+		Target:       []string{health},
+		Refresh:      statusEnvironmentHealth(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: pollInterval,
+		MinTimeout:   3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*elasticbeanstalk.EnvironmentDescription); ok {
+		if err != nil {
+			if causes, _ := findEnvironmentHealthCausesByID(ctx, conn, id); len(causes) > 0 {
+				tfresource.SetLastError(err, fmt.Errorf("health causes: %s", strings.Join(causes, "; ")))
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitEnvironmentDeleted(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, id string, pollInterval, timeout time.Duration) (*elasticbeanstalk.EnvironmentDescription, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{elasticbeanstalk.EnvironmentStatusTerminating},
@@ -862,6 +1284,222 @@ func extractOptionSettings(s *schema.Set) []*elasticbeanstalk.ConfigurationOptio
 	return settings
 }
 
+// optionSettingsToRemove returns the options of the old option settings that aren't part of the new option settings.
+func optionSettingsToRemove(old, new []*elasticbeanstalk.ConfigurationOptionSetting) []*elasticbeanstalk.OptionSpecification {
+	var apiObjects []*elasticbeanstalk.OptionSpecification
+
+	for _, o := range old {
+		found := false
+
+		for _, n := range new {
+			if aws.StringValue(o.Namespace) == aws.StringValue(n.Namespace) && aws.StringValue(o.OptionName) == aws.StringValue(n.OptionName) && aws.StringValue(o.ResourceName) == aws.StringValue(n.ResourceName) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			apiObjects = append(apiObjects, &elasticbeanstalk.OptionSpecification{
+				Namespace:    o.Namespace,
+				OptionName:   o.OptionName,
+				ResourceName: o.ResourceName,
+			})
+		}
+	}
+
+	return apiObjects
+}
+
+func expandScheduledActionOptionSettings(tfList []interface{}) []*elasticbeanstalk.ConfigurationOptionSetting {
+	var apiObjects []*elasticbeanstalk.ConfigurationOptionSetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		resourceName := aws.String(tfMap["name"].(string))
+		newOptionSetting := func(optionName, value string) *elasticbeanstalk.ConfigurationOptionSetting {
+			return &elasticbeanstalk.ConfigurationOptionSetting{
+				Namespace:    aws.String(optionSettingNamespaceScheduledAction),
+				OptionName:   aws.String(optionName),
+				ResourceName: resourceName,
+				Value:        aws.String(value),
+			}
+		}
+
+		if v, null, _ := nullable.Int(tfMap["desired_capacity"].(string)).Value(); !null {
+			apiObjects = append(apiObjects, newOptionSetting("DesiredCapacity", strconv.FormatInt(v, 10)))
+		}
+
+		if v, ok := tfMap["end_time"].(string); ok && v != "" {
+			apiObjects = append(apiObjects, newOptionSetting("EndTime", v))
+		}
+
+		if v, null, _ := nullable.Int(tfMap["max_size"].(string)).Value(); !null {
+			apiObjects = append(apiObjects, newOptionSetting("MaxSize", strconv.FormatInt(v, 10)))
+		}
+
+		if v, null, _ := nullable.Int(tfMap["min_size"].(string)).Value(); !null {
+			apiObjects = append(apiObjects, newOptionSetting("MinSize", strconv.FormatInt(v, 10)))
+		}
+
+		if v, ok := tfMap["recurrence"].(string); ok && v != "" {
+			apiObjects = append(apiObjects, newOptionSetting("Recurrence", v))
+		}
+
+		if v, ok := tfMap["start_time"].(string); ok && v != "" {
+			apiObjects = append(apiObjects, newOptionSetting("StartTime", v))
+		}
+
+		apiObjects = append(apiObjects, newOptionSetting("Suspend", strconv.FormatBool(tfMap["suspend"].(bool))))
+	}
+
+	return apiObjects
+}
+
+func flattenScheduledActionOptionSettings(apiObjects []*elasticbeanstalk.ConfigurationOptionSetting) []interface{} {
+	tfMaps := map[string]map[string]interface{}{}
+
+	for _, apiObject := range apiObjects {
+		if aws.StringValue(apiObject.Namespace) != optionSettingNamespaceScheduledAction {
+			continue
+		}
+
+		resourceName := aws.StringValue(apiObject.ResourceName)
+		value := aws.StringValue(apiObject.Value)
+
+		if resourceName == "" {
+			continue
+		}
+
+		tfMap, ok := tfMaps[resourceName]
+		if !ok {
+			tfMap = map[string]interface{}{
+				"name": resourceName,
+			}
+			tfMaps[resourceName] = tfMap
+		}
+
+		switch aws.StringValue(apiObject.OptionName) {
+		case "DesiredCapacity":
+			tfMap["desired_capacity"] = value
+		case "EndTime":
+			tfMap["end_time"] = value
+		case "MaxSize":
+			tfMap["max_size"] = value
+		case "MinSize":
+			tfMap["min_size"] = value
+		case "Recurrence":
+			tfMap["recurrence"] = value
+		case "StartTime":
+			tfMap["start_time"] = value
+		case "Suspend":
+			tfMap["suspend"] = strings.EqualFold(value, "true")
+		}
+	}
+
+	tfList := make([]interface{}, 0, len(tfMaps))
+	for _, tfMap := range tfMaps {
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandServiceRoleOptionSetting(v string) *elasticbeanstalk.ConfigurationOptionSetting {
+	return &elasticbeanstalk.ConfigurationOptionSetting{
+		Namespace:  aws.String(optionSettingNamespaceEnvironment),
+		OptionName: aws.String("ServiceRole"),
+		Value:      aws.String(v),
+	}
+}
+
+func flattenServiceRoleOptionSetting(apiObjects []*elasticbeanstalk.ConfigurationOptionSetting) string {
+	for _, apiObject := range apiObjects {
+		if aws.StringValue(apiObject.Namespace) == optionSettingNamespaceEnvironment && aws.StringValue(apiObject.OptionName) == "ServiceRole" {
+			return aws.StringValue(apiObject.Value)
+		}
+	}
+
+	return ""
+}
+
+func expandManagedActionsOptionSettings(tfList []interface{}) []*elasticbeanstalk.ConfigurationOptionSetting {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObjects := []*elasticbeanstalk.ConfigurationOptionSetting{
+		{
+			Namespace:  aws.String(optionSettingNamespaceManagedActions),
+			OptionName: aws.String("ManagedActionsEnabled"),
+			Value:      aws.String(strconv.FormatBool(tfMap["enabled"].(bool))),
+		},
+	}
+
+	if v, ok := tfMap["preferred_start_time"].(string); ok && v != "" {
+		apiObjects = append(apiObjects, &elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionSettingNamespaceManagedActions),
+			OptionName: aws.String("PreferredStartTime"),
+			Value:      aws.String(v),
+		})
+	}
+
+	if v, ok := tfMap["service_role_for_managed_updates"].(string); ok && v != "" {
+		apiObjects = append(apiObjects, &elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionSettingNamespaceManagedActions),
+			OptionName: aws.String("ServiceRoleForManagedUpdates"),
+			Value:      aws.String(v),
+		})
+	}
+
+	if v, ok := tfMap["update_level"].(string); ok && v != "" {
+		apiObjects = append(apiObjects, &elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionSettingNamespaceManagedActionsPlatformUpdate),
+			OptionName: aws.String("UpdateLevel"),
+			Value:      aws.String(v),
+		})
+	}
+
+	// Only send InstanceRefreshEnabled when it's known, as it defaults to false.
+	if v, ok := tfMap["instance_refresh_enabled"].(bool); ok && v {
+		apiObjects = append(apiObjects, &elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:  aws.String(optionSettingNamespaceManagedActionsPlatformUpdate),
+			OptionName: aws.String("InstanceRefreshEnabled"),
+			Value:      aws.String(strconv.FormatBool(v)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenManagedActionsOptionSettings(apiObjects []*elasticbeanstalk.ConfigurationOptionSetting) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	for _, apiObject := range apiObjects {
+		value := aws.StringValue(apiObject.Value)
+
+		switch namespace, optionName := aws.StringValue(apiObject.Namespace), aws.StringValue(apiObject.OptionName); {
+		case namespace == optionSettingNamespaceManagedActions && optionName == "ManagedActionsEnabled":
+			tfMap["enabled"] = strings.EqualFold(value, "true")
+		case namespace == optionSettingNamespaceManagedActions && optionName == "PreferredStartTime":
+			tfMap["preferred_start_time"] = value
+		case namespace == optionSettingNamespaceManagedActions && optionName == "ServiceRoleForManagedUpdates":
+			tfMap["service_role_for_managed_updates"] = value
+		case namespace == optionSettingNamespaceManagedActionsPlatformUpdate && optionName == "UpdateLevel":
+			tfMap["update_level"] = value
+		case namespace == optionSettingNamespaceManagedActionsPlatformUpdate && optionName == "InstanceRefreshEnabled":
+			tfMap["instance_refresh_enabled"] = strings.EqualFold(value, "true")
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
 func dropGeneratedSecurityGroup(ctx context.Context, settingValue string, meta interface{}) string {
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
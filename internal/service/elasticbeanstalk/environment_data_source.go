@@ -0,0 +1,217 @@
+package elasticbeanstalk
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceEnvironment() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEnvironmentRead,
+
+		Schema: map[string]*schema.Schema{
+			"application": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"autoscaling_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"health": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"launch_configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"launch_templates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"load_balancers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"platform_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"queues": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"solution_stack_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"tier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"triggers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"version_label": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+	env, err := findEnvironmentByTwoPartKey(ctx, conn, d.Get("application").(string), name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Environment (%s): %s", name, err)
+	}
+
+	id := aws.StringValue(env.EnvironmentId)
+	resources, err := conn.DescribeEnvironmentResourcesWithContext(ctx, &elasticbeanstalk.DescribeEnvironmentResourcesInput{
+		EnvironmentId: aws.String(id),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Environment (%s) resources: %s", name, err)
+	}
+
+	d.SetId(id)
+	d.Set("application", env.ApplicationName)
+	arn := aws.StringValue(env.EnvironmentArn)
+	d.Set("arn", arn)
+	if err := d.Set("autoscaling_groups", flattenASG(resources.EnvironmentResources.AutoScalingGroups)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting autoscaling_groups: %s", err)
+	}
+	d.Set("cname", env.CNAME)
+	d.Set("description", env.Description)
+	d.Set("endpoint_url", env.EndpointURL)
+	d.Set("health", env.Health)
+	if err := d.Set("instances", flattenInstances(resources.EnvironmentResources.Instances)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instances: %s", err)
+	}
+	if err := d.Set("launch_configurations", flattenLaunchConfigurations(resources.EnvironmentResources.LaunchConfigurations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting launch_configurations: %s", err)
+	}
+	if err := d.Set("launch_templates", flattenLaunchTemplates(resources.EnvironmentResources.LaunchTemplates)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting launch_templates: %s", err)
+	}
+	if err := d.Set("load_balancers", flattenLoadBalancers(resources.EnvironmentResources.LoadBalancers)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting load_balancers: %s", err)
+	}
+	d.Set("name", env.EnvironmentName)
+	d.Set("platform_arn", env.PlatformArn)
+	if err := d.Set("queues", flattenQueues(resources.EnvironmentResources.Queues)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting queues: %s", err)
+	}
+	d.Set("solution_stack_name", env.SolutionStackName)
+	d.Set("status", env.Status)
+	if env.Tier != nil {
+		d.Set("tier", env.Tier.Name)
+	}
+	if err := d.Set("triggers", flattenTriggers(resources.EnvironmentResources.Triggers)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting triggers: %s", err)
+	}
+	d.Set("version_label", env.VersionLabel)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Elastic Beanstalk Environment (%s): %s", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
+
+func findEnvironmentByTwoPartKey(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, applicationName, name string) (*elasticbeanstalk.EnvironmentDescription, error) {
+	input := &elasticbeanstalk.DescribeEnvironmentsInput{
+		EnvironmentNames: aws.StringSlice([]string{name}),
+		IncludeDeleted:   aws.Bool(false),
+	}
+
+	if applicationName != "" {
+		input.ApplicationName = aws.String(applicationName)
+	}
+
+	output, err := conn.DescribeEnvironmentsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Environments) == 0 || output.Environments[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Environments); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	environment := output.Environments[0]
+
+	if status := aws.StringValue(environment.Status); status == elasticbeanstalk.EnvironmentStatusTerminated {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return environment, nil
+}
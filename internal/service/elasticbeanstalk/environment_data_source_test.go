@@ -0,0 +1,53 @@
+package elasticbeanstalk_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccElasticBeanstalkEnvironmentDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_elastic_beanstalk_environment.test"
+	resourceName := "aws_elastic_beanstalk_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "application", resourceName, "application"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "autoscaling_groups.#", resourceName, "autoscaling_groups.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cname", resourceName, "cname"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint_url", resourceName, "endpoint_url"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "health"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.#", resourceName, "instances.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "load_balancers.#", resourceName, "load_balancers.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "platform_arn", resourceName, "platform_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "solution_stack_name", resourceName, "solution_stack_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", elasticbeanstalk.EnvironmentStatusReady),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tier", resourceName, "tier"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_basic(rName), `
+data "aws_elastic_beanstalk_environment" "test" {
+  application = aws_elastic_beanstalk_environment.test.application
+  name        = aws_elastic_beanstalk_environment.test.name
+}
+`)
+}
@@ -0,0 +1,253 @@
+package elasticbeanstalk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceEnvironmentSwap() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEnvironmentSwapCreate,
+		ReadWithoutTimeout:   resourceEnvironmentSwapRead,
+		UpdateWithoutTimeout: resourceEnvironmentSwapUpdate,
+		DeleteWithoutTimeout: resourceEnvironmentSwapDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: resourceEnvironmentSwapCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"destination_environment_cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"production_cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"production_environment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"source_environment_cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceEnvironmentSwapCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("source_environment_id") || !diff.NewValueKnown("destination_environment_id") {
+		return nil
+	}
+
+	sourceID, destinationID := diff.Get("source_environment_id").(string), diff.Get("destination_environment_id").(string)
+
+	if sourceID == destinationID {
+		return fmt.Errorf("source_environment_id and destination_environment_id must differ")
+	}
+
+	if !diff.NewValueKnown("production_environment_id") {
+		return nil
+	}
+
+	if v := diff.Get("production_environment_id").(string); v != "" && v != sourceID && v != destinationID {
+		return fmt.Errorf("production_environment_id (%s) must be one of source_environment_id (%s) or destination_environment_id (%s)", v, sourceID, destinationID)
+	}
+
+	return nil
+}
+
+func resourceEnvironmentSwapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
+
+	sourceID := d.Get("source_environment_id").(string)
+	destinationID := d.Get("destination_environment_id").(string)
+	id := EnvironmentSwapCreateID(sourceID, destinationID)
+
+	// The production CNAME is the one served by the destination environment when the pair is first managed.
+	destination, err := FindEnvironmentByID(ctx, conn, destinationID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Environment (%s): %s", destinationID, err)
+	}
+
+	productionCNAME := aws.StringValue(destination.CNAME)
+
+	if productionCNAME == "" {
+		return sdkdiag.AppendErrorf(diags, "creating Elastic Beanstalk Environment Swap (%s): destination environment (%s) has no CNAME", id, destinationID)
+	}
+
+	// Unless the destination environment is to keep serving the production CNAME, move it to the source environment.
+	if v := d.Get("production_environment_id").(string); v != destinationID {
+		if err := swapEnvironmentCNAMEs(ctx, conn, sourceID, destinationID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Elastic Beanstalk Environment Swap (%s): %s", id, err)
+		}
+	}
+
+	d.SetId(id)
+	d.Set("production_cname", productionCNAME)
+
+	return append(diags, resourceEnvironmentSwapRead(ctx, d, meta)...)
+}
+
+func resourceEnvironmentSwapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
+
+	sourceID, destinationID, err := EnvironmentSwapParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Environment Swap (%s): %s", d.Id(), err)
+	}
+
+	source, err := FindEnvironmentByID(ctx, conn, sourceID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Elastic Beanstalk Environment (%s) not found, removing Elastic Beanstalk Environment Swap (%s) from state", sourceID, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Environment (%s): %s", sourceID, err)
+	}
+
+	destination, err := FindEnvironmentByID(ctx, conn, destinationID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Elastic Beanstalk Environment (%s) not found, removing Elastic Beanstalk Environment Swap (%s) from state", destinationID, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Environment (%s): %s", destinationID, err)
+	}
+
+	sourceCNAME, destinationCNAME := aws.StringValue(source.CNAME), aws.StringValue(destination.CNAME)
+	productionCNAME := d.Get("production_cname").(string)
+
+	// Report whichever environment currently serves the production CNAME so that swaps made outside of Terraform show up as drift.
+	var productionEnvironmentID string
+	switch {
+	case strings.EqualFold(sourceCNAME, productionCNAME):
+		productionEnvironmentID = sourceID
+	case strings.EqualFold(destinationCNAME, productionCNAME):
+		productionEnvironmentID = destinationID
+	}
+
+	d.Set("destination_environment_cname", destinationCNAME)
+	d.Set("destination_environment_id", destinationID)
+	d.Set("production_environment_id", productionEnvironmentID)
+	d.Set("source_environment_cname", sourceCNAME)
+	d.Set("source_environment_id", sourceID)
+
+	return diags
+}
+
+func resourceEnvironmentSwapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
+
+	if d.HasChange("production_environment_id") {
+		sourceID, destinationID, err := EnvironmentSwapParseID(d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Elastic Beanstalk Environment Swap (%s): %s", d.Id(), err)
+		}
+
+		if err := swapEnvironmentCNAMEs(ctx, conn, sourceID, destinationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Elastic Beanstalk Environment Swap (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceEnvironmentSwapRead(ctx, d, meta)...)
+}
+
+func resourceEnvironmentSwapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[WARN] Elastic Beanstalk Environment Swap (%s) removed from state, the environments' CNAMEs are not swapped back", d.Id())
+
+	return diags
+}
+
+// swapEnvironmentCNAMEs swaps the CNAMEs of two environments once both are Ready and waits for the swap to complete.
+func swapEnvironmentCNAMEs(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, sourceID, destinationID string, timeout time.Duration) error {
+	ids := []string{sourceID, destinationID}
+
+	for _, v := range ids {
+		if _, err := waitEnvironmentReady(ctx, conn, v, 0, timeout); err != nil {
+			return fmt.Errorf("waiting for Elastic Beanstalk Environment (%s) update: %w", v, err)
+		}
+	}
+
+	opTime := time.Now()
+	_, err := conn.SwapEnvironmentCNAMEsWithContext(ctx, &elasticbeanstalk.SwapEnvironmentCNAMEsInput{
+		DestinationEnvironmentId: aws.String(destinationID),
+		SourceEnvironmentId:      aws.String(sourceID),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range ids {
+		if _, err := waitEnvironmentReady(ctx, conn, v, 0, timeout); err != nil {
+			return fmt.Errorf("waiting for Elastic Beanstalk Environment (%s) update: %w", v, err)
+		}
+
+		if err := findEnvironmentErrorsByID(ctx, conn, v, opTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const environmentSwapIDSeparator = ","
+
+func EnvironmentSwapCreateID(sourceID, destinationID string) string {
+	return strings.Join([]string{sourceID, destinationID}, environmentSwapIDSeparator)
+}
+
+func EnvironmentSwapParseID(id string) (string, string, error) {
+	parts := strings.Split(id, environmentSwapIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected SOURCE-ENVIRONMENT-ID%[2]sDESTINATION-ENVIRONMENT-ID", id, environmentSwapIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
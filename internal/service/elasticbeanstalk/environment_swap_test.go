@@ -0,0 +1,132 @@
+package elasticbeanstalk_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccElasticBeanstalkEnvironmentSwap_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var blue, green elasticbeanstalk.EnvironmentDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_environment_swap.test"
+	blueResourceName := "aws_elastic_beanstalk_environment.blue"
+	greenResourceName := "aws_elastic_beanstalk_environment.green"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentSwapConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, blueResourceName, &blue),
+					testAccCheckEnvironmentExists(ctx, greenResourceName, &green),
+					resource.TestCheckResourceAttrPair(resourceName, "source_environment_id", greenResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_environment_id", blueResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "production_environment_id", greenResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_environment_cname", resourceName, "production_cname"),
+					resource.TestCheckResourceAttrSet(resourceName, "destination_environment_cname"),
+				),
+			},
+			{
+				Config: testAccEnvironmentSwapConfig_productionEnvironment(rName, "blue"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "production_environment_id", blueResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_environment_cname", resourceName, "production_cname"),
+				),
+			},
+			{
+				Config: testAccEnvironmentSwapConfig_productionEnvironment(rName, "green"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "production_environment_id", greenResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_environment_cname", resourceName, "production_cname"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentSwapConfig_environment(name string) string {
+	return fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" %[1]q {
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = "${aws_elastic_beanstalk_application.test.name}-%[1]s"
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test[0].id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = aws_iam_role.service_role.name
+  }
+}
+`, name)
+}
+
+func testAccEnvironmentSwapConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccEnvironmentConfig_base(rName),
+		testAccEnvironmentSwapConfig_environment("blue"),
+		testAccEnvironmentSwapConfig_environment("green"),
+		`
+resource "aws_elastic_beanstalk_environment_swap" "test" {
+  source_environment_id      = aws_elastic_beanstalk_environment.green.id
+  destination_environment_id = aws_elastic_beanstalk_environment.blue.id
+}
+`)
+}
+
+func testAccEnvironmentSwapConfig_productionEnvironment(rName, production string) string {
+	return acctest.ConfigCompose(
+		testAccEnvironmentConfig_base(rName),
+		testAccEnvironmentSwapConfig_environment("blue"),
+		testAccEnvironmentSwapConfig_environment("green"),
+		fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment_swap" "test" {
+  source_environment_id      = aws_elastic_beanstalk_environment.green.id
+  destination_environment_id = aws_elastic_beanstalk_environment.blue.id
+  production_environment_id  = aws_elastic_beanstalk_environment.%[1]s.id
+}
+`, production))
+}
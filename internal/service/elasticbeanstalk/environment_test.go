@@ -7,12 +7,14 @@ import (
 	"reflect"
 	"regexp"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -20,6 +22,57 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestEnvironmentWaitForHealthStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                 string
+		Raw                  map[string]interface{}
+		WaitForStabilization bool
+		ExpectedStatus       string
+		ExpectedOK           bool
+	}{
+		{
+			Name: "not configured",
+		},
+		{
+			Name:                 "wait_for_stabilization",
+			WaitForStabilization: true,
+			ExpectedStatus:       elasticbeanstalk.EnvironmentHealthGreen,
+			ExpectedOK:           true,
+		},
+		{
+			Name:           "wait_for_health_status",
+			Raw:            map[string]interface{}{"wait_for_health_status": elasticbeanstalk.EnvironmentHealthYellow},
+			ExpectedStatus: elasticbeanstalk.EnvironmentHealthYellow,
+			ExpectedOK:     true,
+		},
+		{
+			Name:                 "wait_for_health_status overrides wait_for_stabilization",
+			Raw:                  map[string]interface{}{"wait_for_health_status": elasticbeanstalk.EnvironmentHealthYellow},
+			WaitForStabilization: true,
+			ExpectedStatus:       elasticbeanstalk.EnvironmentHealthYellow,
+			ExpectedOK:           true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, tfelasticbeanstalk.ResourceEnvironment().Schema, testCase.Raw)
+			meta := &conns.AWSClient{WaitForStabilization: testCase.WaitForStabilization}
+
+			status, ok := tfelasticbeanstalk.EnvironmentWaitForHealthStatus(d, meta)
+
+			if status != testCase.ExpectedStatus || ok != testCase.ExpectedOK {
+				t.Errorf("got (%q, %t), expected (%q, %t)", status, ok, testCase.ExpectedStatus, testCase.ExpectedOK)
+			}
+		})
+	}
+}
+
 func TestAccElasticBeanstalkEnvironment_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var app elasticbeanstalk.EnvironmentDescription
@@ -234,6 +287,7 @@ func TestAccElasticBeanstalkEnvironment_resource(t *testing.T) {
 func TestAccElasticBeanstalkEnvironment_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var app elasticbeanstalk.EnvironmentDescription
+	var instances string
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_elastic_beanstalk_environment.test"
 
@@ -247,6 +301,7 @@ func TestAccElasticBeanstalkEnvironment_tags(t *testing.T) {
 				Config: testAccEnvironmentConfig_tags1(rName, "key1", "value1"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					testAccCheckEnvironmentInstancesUnchanged(resourceName, &instances),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
 				),
@@ -264,6 +319,7 @@ func TestAccElasticBeanstalkEnvironment_tags(t *testing.T) {
 				Config: testAccEnvironmentConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					testAccCheckEnvironmentInstancesUnchanged(resourceName, &instances),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
@@ -466,6 +522,274 @@ func TestAccElasticBeanstalkEnvironment_platformARN(t *testing.T) {
 	})
 }
 
+func TestAccElasticBeanstalkEnvironment_platformARNFromSolutionStackName(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app1, app2, app3 elasticbeanstalk.EnvironmentDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_platformSolutionStackName(rName, "64bit Amazon Linux 2018.03 v2.9.5 running Python 3.6"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app1),
+					acctest.CheckResourceAttrRegionalARNNoAccount(resourceName, "platform_arn", "elasticbeanstalk", "platform/Python 3.6 running on 64bit Amazon Linux/2.9.5"),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig_platformARN(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app2),
+					testAccCheckEnvironmentNotRecreated(&app1, &app2),
+					acctest.CheckResourceAttrRegionalARNNoAccount(resourceName, "platform_arn", "elasticbeanstalk", "platform/Python 3.6 running on 64bit Amazon Linux/2.9.6"),
+					resource.TestCheckResourceAttr(resourceName, "solution_stack_name", "64bit Amazon Linux 2018.03 v2.9.6 running Python 3.6"),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig_platformSolutionStackName(rName, "64bit Amazon Linux 2018.03 v2.9.6 running Python 3.6"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app3),
+					testAccCheckEnvironmentNotRecreated(&app2, &app3),
+					acctest.CheckResourceAttrRegionalARNNoAccount(resourceName, "platform_arn", "elasticbeanstalk", "platform/Python 3.6 running on 64bit Amazon Linux/2.9.6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkEnvironment_managedActions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app elasticbeanstalk.EnvironmentDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_managedActions(rName, "Sun:10:00", "minor"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.preferred_start_time", "Sun:10:00"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.update_level", "minor"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "all_settings.*", map[string]string{
+						"namespace": "aws:elasticbeanstalk:managedactions",
+						"name":      "PreferredStartTime",
+						"value":     "Sun:10:00",
+					}),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig_managedActions(rName, "Tue:02:30", "patch"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.preferred_start_time", "Tue:02:30"),
+					resource.TestCheckResourceAttr(resourceName, "managed_actions.0.update_level", "patch"),
+					resource.TestCheckResourceAttr(resourceName, "setting.#", "7"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkEnvironment_managedActionsSettingConflict(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEnvironmentConfig_managedActionsSettingConflict(rName),
+				ExpectError: regexp.MustCompile(`conflicts with "managed_actions"`),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkEnvironment_scheduledAction(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app elasticbeanstalk.EnvironmentDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_scheduledAction(rName, "0 8 * * 1-5", "0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_action.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_action.*", map[string]string{
+						"name":       "ScaleUp",
+						"max_size":   "2",
+						"min_size":   "1",
+						"recurrence": "0 8 * * 1-5",
+						"suspend":    "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_action.*", map[string]string{
+						"name":       "ScaleDown",
+						"max_size":   "1",
+						"min_size":   "0",
+						"recurrence": "0 20 * * 1-5",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "all_settings.*", map[string]string{
+						"namespace": "aws:autoscaling:scheduledaction",
+						"resource":  "ScaleDown",
+						"name":      "MinSize",
+						"value":     "0",
+					}),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig_scheduledAction(rName, "0 7 * * 1-5", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_action.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_action.*", map[string]string{
+						"name":       "ScaleUp",
+						"recurrence": "0 7 * * 1-5",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_action.*", map[string]string{
+						"name":     "ScaleDown",
+						"min_size": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkEnvironment_scheduledActionSettingConflict(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEnvironmentConfig_scheduledActionSettingConflict(rName),
+				ExpectError: regexp.MustCompile(`conflicts with "scheduled_action"`),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkEnvironment_roles(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app elasticbeanstalk.EnvironmentDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_roles(rName, "aws_iam_role.service_role.name", "aws_iam_role.operations[0].arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttrPair(resourceName, "operations_role", "aws_iam_role.operations.0", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_role", "aws_iam_role.service_role", "name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_ready_timeout"},
+			},
+			{
+				Config: testAccEnvironmentConfig_roles(rName, "aws_iam_role.service_role_rotated.name", "aws_iam_role.operations[1].arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttrPair(resourceName, "operations_role", "aws_iam_role.operations.1", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_role", "aws_iam_role.service_role_rotated", "name"),
+				),
+			},
+			{
+				Config: testAccEnvironmentConfig_roles(rName, "aws_iam_role.service_role_rotated.name", "null"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "operations_role", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "service_role", "aws_iam_role.service_role_rotated", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkEnvironment_serviceRoleSettingConflict(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEnvironmentConfig_serviceRoleSettingConflict(rName),
+				ExpectError: regexp.MustCompile(`conflicts with "service_role"`),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkEnvironment_waitForHealthStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+	var app elasticbeanstalk.EnvironmentDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentConfig_waitForHealthStatus(rName, elasticbeanstalk.EnvironmentHealthGreen),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentExists(ctx, resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_health_status", elasticbeanstalk.EnvironmentHealthGreen),
+					func(*terraform.State) error {
+						if got, want := aws.StringValue(app.Health), elasticbeanstalk.EnvironmentHealthGreen; got != want {
+							return fmt.Errorf("Elastic Beanstalk Environment health = %s, want %s", got, want)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_health_status", "wait_for_ready_timeout"},
+			},
+		},
+	})
+}
+
 func testAccCheckEnvironmentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticBeanstalkConn()
@@ -569,6 +893,47 @@ func testAccVerifyConfig(ctx context.Context, env *elasticbeanstalk.EnvironmentD
 	}
 }
 
+func testAccCheckEnvironmentNotRecreated(before, after *elasticbeanstalk.EnvironmentDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.EnvironmentId), aws.StringValue(after.EnvironmentId); before != after {
+			return fmt.Errorf("Elastic Beanstalk Environment (%s) recreated as (%s)", before, after)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckEnvironmentInstancesUnchanged records the environment's instances on first use and
+// afterwards checks that they haven't been replaced.
+func testAccCheckEnvironmentInstancesUnchanged(n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		var instances []string
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "instances.") && k != "instances.#" {
+				instances = append(instances, v)
+			}
+		}
+		sort.Strings(instances)
+		got := strings.Join(instances, ",")
+
+		if *v == "" {
+			*v = got
+			return nil
+		}
+
+		if got != *v {
+			return fmt.Errorf("Elastic Beanstalk Environment instances = %s, want %s", got, *v)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckEnvironmentConfigValue(ctx context.Context, n string, expectedValue string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticBeanstalkConn()
@@ -1636,3 +2001,372 @@ EOF
 }
 `, rName, publicKey, email))
 }
+
+func testAccEnvironmentConfig_managedActions(rName, preferredStartTime, updateLevel string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = %[1]q
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+
+  managed_actions {
+    preferred_start_time = %[2]q
+    update_level         = %[3]q
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test[0].id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = aws_iam_role.service_role.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:healthreporting:system"
+    name      = "SystemType"
+    value     = "enhanced"
+  }
+}
+`, rName, preferredStartTime, updateLevel))
+}
+
+func testAccEnvironmentConfig_managedActionsSettingConflict(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application         = %[1]q
+  name                = %[1]q
+  solution_stack_name = "64bit Amazon Linux 2 v3.5.3 running Python 3.8"
+
+  managed_actions {
+    preferred_start_time = "Sun:10:00"
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:managedactions"
+    name      = "PreferredStartTime"
+    value     = "Mon:10:00"
+  }
+}
+`, rName)
+}
+
+func testAccEnvironmentConfig_waitForHealthStatus(rName, health string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application            = aws_elastic_beanstalk_application.test.name
+  name                   = %[1]q
+  solution_stack_name    = data.aws_elastic_beanstalk_solution_stack.test.name
+  wait_for_health_status = %[2]q
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test[0].id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = aws_iam_role.service_role.name
+  }
+}
+`, rName, health))
+}
+
+func testAccEnvironmentConfig_roles(rName, serviceRole, operationsRole string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_role" "service_role_rotated" {
+  name = "%[1]s-service-rotated"
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Condition = {
+        StringEquals = {
+          "sts:ExternalId" = "elasticbeanstalk"
+        }
+      }
+      Effect = "Allow"
+      Principal = {
+        Service = "elasticbeanstalk.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "service_role_rotated-AWSElasticBeanstalkEnhancedHealth" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSElasticBeanstalkEnhancedHealth"
+  role       = aws_iam_role.service_role_rotated.id
+}
+
+resource "aws_iam_role_policy_attachment" "service_role_rotated-AWSElasticBeanstalkService" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSElasticBeanstalkService"
+  role       = aws_iam_role.service_role_rotated.id
+}
+
+resource "aws_iam_role" "operations" {
+  count = 2
+
+  name = "%[1]s-operations-${count.index}"
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "elasticbeanstalk.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "operations" {
+  count = 2
+
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AdministratorAccess-AWSElasticBeanstalk"
+  role       = aws_iam_role.operations[count.index].id
+}
+
+resource "aws_elastic_beanstalk_environment" "test" {
+  depends_on = [
+    aws_iam_role_policy_attachment.operations,
+    aws_iam_role_policy_attachment.service_role_rotated-AWSElasticBeanstalkEnhancedHealth,
+    aws_iam_role_policy_attachment.service_role_rotated-AWSElasticBeanstalkService,
+  ]
+
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = %[1]q
+  operations_role     = %[3]s
+  service_role        = %[2]s
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test[0].id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+}
+`, rName, serviceRole, operationsRole))
+}
+
+func testAccEnvironmentConfig_serviceRoleSettingConflict(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application         = %[1]q
+  name                = %[1]q
+  service_role        = "aws-elasticbeanstalk-service-role"
+  solution_stack_name = "64bit Amazon Linux 2 v3.5.3 running Python 3.8"
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = "aws-elasticbeanstalk-service-role"
+  }
+}
+`, rName)
+}
+
+func testAccEnvironmentConfig_scheduledAction(rName, scaleUpRecurrence, scaleDownMinSize string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = %[1]q
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+
+  scheduled_action {
+    name       = "ScaleUp"
+    max_size   = 2
+    min_size   = 1
+    recurrence = %[2]q
+  }
+
+  scheduled_action {
+    name       = "ScaleDown"
+    max_size   = 1
+    min_size   = %[3]s
+    recurrence = "0 20 * * 1-5"
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test[0].id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = aws_iam_role.service_role.name
+  }
+}
+`, rName, scaleUpRecurrence, scaleDownMinSize))
+}
+
+func testAccEnvironmentConfig_scheduledActionSettingConflict(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application         = %[1]q
+  name                = %[1]q
+  solution_stack_name = "64bit Amazon Linux 2 v3.5.3 running Python 3.8"
+
+  scheduled_action {
+    name       = "ScaleUp"
+    recurrence = "0 8 * * 1-5"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:scheduledaction"
+    name      = "MinSize"
+    resource  = "ScaleDown"
+    value     = "0"
+  }
+}
+`, rName)
+}
+
+func testAccEnvironmentConfig_platformSolutionStackName(rName, solutionStackName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "test" {
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = %[1]q
+  solution_stack_name = %[2]q
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test[0].id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = aws_iam_role.service_role.name
+  }
+}
+`, rName, solutionStackName))
+}
@@ -0,0 +1,9 @@
+package elasticbeanstalk
+
+// Exports for use in tests only.
+var (
+	EnvironmentWaitForHealthStatus  = environmentWaitForHealthStatus
+	SolutionStackPlatformBranchName = solutionStackPlatformBranchName
+	SortSolutionStacksByRecency     = sortSolutionStacksByRecency
+	ValidateOptionSettings          = validateOptionSettings
+)
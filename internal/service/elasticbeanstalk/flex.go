@@ -15,6 +15,16 @@ func flattenASG(list []*elasticbeanstalk.AutoScalingGroup) []string {
 	return strs
 }
 
+func flattenLaunchTemplates(list []*elasticbeanstalk.LaunchTemplate) []string {
+	strs := make([]string, 0, len(list))
+	for _, r := range list {
+		if r.Id != nil {
+			strs = append(strs, *r.Id)
+		}
+	}
+	return strs
+}
+
 func flattenLoadBalancers(list []*elasticbeanstalk.LoadBalancer) []string {
 	strs := make([]string, 0, len(list))
 	for _, r := range list {
@@ -65,9 +75,29 @@ func flattenTriggers(list []*elasticbeanstalk.Trigger) []string {
 	return strs
 }
 
+func flattenConfigurationOptionSettings(list []*elasticbeanstalk.ConfigurationOptionSetting) []interface{} {
+	tfList := make([]interface{}, 0, len(list))
+	for _, r := range list {
+		m := map[string]interface{}{
+			"name":      aws.StringValue(r.OptionName),
+			"namespace": aws.StringValue(r.Namespace),
+			"value":     aws.StringValue(r.Value),
+		}
+		if r.ResourceName != nil {
+			m["resource"] = aws.StringValue(r.ResourceName)
+		}
+		tfList = append(tfList, m)
+	}
+	return tfList
+}
+
 func flattenResourceLifecycleConfig(rlc *elasticbeanstalk.ApplicationResourceLifecycleConfig) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, 1)
 
+	if rlc == nil {
+		return result
+	}
+
 	anything_enabled := false
 	appversion_lifecycle := make(map[string]interface{})
 
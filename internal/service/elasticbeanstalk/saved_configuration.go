@@ -0,0 +1,208 @@
+package elasticbeanstalk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceSavedConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSavedConfigurationCreate,
+		ReadWithoutTimeout:   resourceSavedConfigurationRead,
+		UpdateWithoutTimeout: resourceSavedConfigurationUpdate,
+		DeleteWithoutTimeout: resourceSavedConfigurationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"application": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"environment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"platform_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"setting": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     settingSchema(),
+				Set:      optionSettingValueHash,
+			},
+			"solution_stack_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSavedConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
+
+	applicationName := d.Get("application").(string)
+	name := d.Get("name").(string)
+	id := SavedConfigurationCreateID(applicationName, name)
+	input := &elasticbeanstalk.CreateConfigurationTemplateInput{
+		ApplicationName: aws.String(applicationName),
+		EnvironmentId:   aws.String(d.Get("environment_id").(string)),
+		TemplateName:    aws.String(name),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateConfigurationTemplateWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Elastic Beanstalk Saved Configuration (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceSavedConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceSavedConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
+
+	applicationName, name, err := SavedConfigurationParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Saved Configuration (%s): %s", d.Id(), err)
+	}
+
+	settings, err := FindConfigurationSettingsByTwoPartKey(ctx, conn, applicationName, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Elastic Beanstalk Saved Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Saved Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application", settings.ApplicationName)
+	d.Set("date_created", aws.TimeValue(settings.DateCreated).Format(time.RFC3339))
+	d.Set("date_updated", aws.TimeValue(settings.DateUpdated).Format(time.RFC3339))
+	d.Set("description", settings.Description)
+	d.Set("name", settings.TemplateName)
+	d.Set("platform_arn", settings.PlatformArn)
+	if err := d.Set("setting", flattenConfigurationOptionSettings(settings.OptionSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting setting: %s", err)
+	}
+	d.Set("solution_stack_name", settings.SolutionStackName)
+
+	return diags
+}
+
+func resourceSavedConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
+
+	if d.HasChange("description") {
+		applicationName, name, err := SavedConfigurationParseID(d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Elastic Beanstalk Saved Configuration (%s): %s", d.Id(), err)
+		}
+
+		_, err = conn.UpdateConfigurationTemplateWithContext(ctx, &elasticbeanstalk.UpdateConfigurationTemplateInput{
+			ApplicationName: aws.String(applicationName),
+			Description:     aws.String(d.Get("description").(string)),
+			TemplateName:    aws.String(name),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Elastic Beanstalk Saved Configuration (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSavedConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceSavedConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
+
+	applicationName, name, err := SavedConfigurationParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Elastic Beanstalk Saved Configuration (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Elastic Beanstalk Saved Configuration: %s", d.Id())
+	_, err = conn.DeleteConfigurationTemplateWithContext(ctx, &elasticbeanstalk.DeleteConfigurationTemplateInput{
+		ApplicationName: aws.String(applicationName),
+		TemplateName:    aws.String(name),
+	})
+
+	if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "No Configuration Template named") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Elastic Beanstalk Saved Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const savedConfigurationIDSeparator = ","
+
+func SavedConfigurationCreateID(applicationName, name string) string {
+	return strings.Join([]string{applicationName, name}, savedConfigurationIDSeparator)
+}
+
+func SavedConfigurationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, savedConfigurationIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected APPLICATION-NAME%[2]sSAVED-CONFIGURATION-NAME", id, savedConfigurationIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,155 @@
+package elasticbeanstalk_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelasticbeanstalk "github.com/hashicorp/terraform-provider-aws/internal/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccElasticBeanstalkSavedConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config elasticbeanstalk.ConfigurationSettingsDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_saved_configuration.test"
+	environmentResourceName := "aws_elastic_beanstalk_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSavedConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSavedConfigurationConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSavedConfigurationExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttrPair(resourceName, "application", environmentResourceName, "application"),
+					resource.TestCheckResourceAttrSet(resourceName, "date_created"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_id", environmentResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "solution_stack_name", environmentResourceName, "solution_stack_name"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						"namespace": "aws:ec2:vpc",
+						"name":      "AssociatePublicIpAddress",
+						"value":     "true",
+					}),
+				),
+			},
+			{
+				Config: testAccSavedConfigurationConfig_basic(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSavedConfigurationExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkSavedConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config elasticbeanstalk.ConfigurationSettingsDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_saved_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSavedConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSavedConfigurationConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSavedConfigurationExists(ctx, resourceName, &config),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfelasticbeanstalk.ResourceSavedConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSavedConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticBeanstalkConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_elastic_beanstalk_saved_configuration" {
+				continue
+			}
+
+			applicationName, name, err := tfelasticbeanstalk.SavedConfigurationParseID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfelasticbeanstalk.FindConfigurationSettingsByTwoPartKey(ctx, conn, applicationName, name)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Elastic Beanstalk Saved Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSavedConfigurationExists(ctx context.Context, n string, v *elasticbeanstalk.ConfigurationSettingsDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Elastic Beanstalk Saved Configuration ID is set")
+		}
+
+		applicationName, name, err := tfelasticbeanstalk.SavedConfigurationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticBeanstalkConn()
+
+		output, err := tfelasticbeanstalk.FindConfigurationSettingsByTwoPartKey(ctx, conn, applicationName, name)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSavedConfigurationConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_basic(rName), fmt.Sprintf(`
+resource "aws_elastic_beanstalk_saved_configuration" "test" {
+  application    = aws_elastic_beanstalk_environment.test.application
+  description    = %[2]q
+  environment_id = aws_elastic_beanstalk_environment.test.id
+  name           = %[1]q
+}
+`, rName, description))
+}
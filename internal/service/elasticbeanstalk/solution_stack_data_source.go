@@ -2,8 +2,12 @@ package elasticbeanstalk
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
@@ -29,6 +33,11 @@ func DataSourceSolutionStack() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"platform_branch_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			// Computed values.
 			"name": {
 				Type:     schema.TypeString,
@@ -56,10 +65,17 @@ func dataSourceSolutionStackRead(ctx context.Context, d *schema.ResourceData, me
 	var filteredSolutionStacks []*string
 
 	r := regexp.MustCompile(nameRegex.(string))
+	platformBranchName := d.Get("platform_branch_name").(string)
 	for _, solutionStack := range resp.SolutionStacks {
-		if r.MatchString(*solutionStack) {
-			filteredSolutionStacks = append(filteredSolutionStacks, solutionStack)
+		if !r.MatchString(aws.StringValue(solutionStack)) {
+			continue
+		}
+
+		if platformBranchName != "" && solutionStackPlatformBranchName(aws.StringValue(solutionStack)) != platformBranchName {
+			continue
 		}
+
+		filteredSolutionStacks = append(filteredSolutionStacks, solutionStack)
 	}
 
 	var solutionStack *string
@@ -83,11 +99,111 @@ func dataSourceSolutionStackRead(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(aws.StringValue(solutionStack))
 	d.Set("name", solutionStack)
+	d.Set("platform_branch_name", solutionStackPlatformBranchName(aws.StringValue(solutionStack)))
 
 	return diags
 }
 
 // Returns the most recent solution stack out of a slice of stacks.
 func mostRecentSolutionStack(solutionStacks []*string) *string {
+	solutionStacks = append([]*string(nil), solutionStacks...)
+	sortSolutionStacksByRecency(solutionStacks)
+
 	return solutionStacks[0]
 }
+
+// solutionStackNameRegexp matches solution stack names such as
+// "64bit Amazon Linux 2 v3.5.3 running Docker" or "64bit Windows Server 2019 v2.11.2 running IIS 10.0".
+var solutionStackNameRegexp = regexp.MustCompile(`^(.+) v(\d+(?:\.\d+)*) running (.+)$`)
+
+var solutionStackNumberRegexp = regexp.MustCompile(`\d+`)
+
+// solutionStackVersion holds the numeric components of a solution stack name that are used to order stacks.
+type solutionStackVersion struct {
+	platform []int
+	os       []int
+	runtime  []int
+}
+
+func parseSolutionStackVersion(name string) (solutionStackVersion, bool) {
+	m := solutionStackNameRegexp.FindStringSubmatch(name)
+
+	if m == nil {
+		return solutionStackVersion{}, false
+	}
+
+	return solutionStackVersion{
+		platform: parseSolutionStackNumbers(m[2]),
+		// Skip the leading architecture, e.g. "64bit".
+		os:      parseSolutionStackNumbers(strings.TrimPrefix(m[1], "64bit")),
+		runtime: parseSolutionStackNumbers(m[3]),
+	}, true
+}
+
+func parseSolutionStackNumbers(s string) []int {
+	var numbers []int
+
+	for _, v := range solutionStackNumberRegexp.FindAllString(s, -1) {
+		if n, err := strconv.Atoi(v); err == nil {
+			numbers = append(numbers, n)
+		}
+	}
+
+	return numbers
+}
+
+func compareSolutionStackNumbers(a, b []int) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// sortSolutionStacksByRecency sorts solution stacks from newest to oldest by platform version,
+// then operating system version and then runtime version.
+// Stacks whose names can't be parsed are sorted last, in their original order.
+func sortSolutionStacksByRecency(solutionStacks []*string) {
+	sort.SliceStable(solutionStacks, func(i, j int) bool {
+		vi, oki := parseSolutionStackVersion(aws.StringValue(solutionStacks[i]))
+		vj, okj := parseSolutionStackVersion(aws.StringValue(solutionStacks[j]))
+
+		if !oki || !okj {
+			return oki && !okj
+		}
+
+		if c := compareSolutionStackNumbers(vi.platform, vj.platform); c != 0 {
+			return c > 0
+		}
+
+		if c := compareSolutionStackNumbers(vi.os, vj.os); c != 0 {
+			return c > 0
+		}
+
+		return compareSolutionStackNumbers(vi.runtime, vj.runtime) > 0
+	})
+}
+
+// solutionStackPlatformBranchName returns the name of the platform branch of a solution stack,
+// e.g. "Docker running on 64bit Amazon Linux 2" for "64bit Amazon Linux 2 v3.5.3 running Docker".
+func solutionStackPlatformBranchName(name string) string {
+	m := solutionStackNameRegexp.FindStringSubmatch(name)
+
+	if m == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s running on %s", m[3], m[1])
+}
@@ -2,15 +2,65 @@ package elasticbeanstalk_test
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfelasticbeanstalk "github.com/hashicorp/terraform-provider-aws/internal/service/elasticbeanstalk"
 )
 
+func TestSortSolutionStacksByRecency(t *testing.T) {
+	t.Parallel()
+
+	input := []string{
+		"64bit Amazon Linux 2 v5.6.4 running Node.js 16",
+		"64bit Amazon Linux 2018.03 v4.17.0 running Node.js",
+		"64bit Amazon Linux 2 v5.8.0 running Node.js 14",
+		"Node.js running on an unparsable stack",
+		"64bit Amazon Linux 2 v5.10.0 running Node.js 16",
+		"64bit Amazon Linux 2 v5.10.0 running Node.js 18",
+		"64bit Amazon Linux 2023 v6.0.0 running Node.js 18",
+	}
+	expected := []string{
+		"64bit Amazon Linux 2023 v6.0.0 running Node.js 18",
+		"64bit Amazon Linux 2 v5.10.0 running Node.js 18",
+		"64bit Amazon Linux 2 v5.10.0 running Node.js 16",
+		"64bit Amazon Linux 2 v5.8.0 running Node.js 14",
+		"64bit Amazon Linux 2 v5.6.4 running Node.js 16",
+		"64bit Amazon Linux 2018.03 v4.17.0 running Node.js",
+		"Node.js running on an unparsable stack",
+	}
+
+	solutionStacks := aws.StringSlice(input)
+	tfelasticbeanstalk.SortSolutionStacksByRecency(solutionStacks)
+
+	if got := aws.StringValueSlice(solutionStacks); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestSolutionStackPlatformBranchName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"64bit Amazon Linux 2 v3.5.3 running Docker":         "Docker running on 64bit Amazon Linux 2",
+		"64bit Windows Server 2019 v2.11.2 running IIS 10.0": "IIS 10.0 running on 64bit Windows Server 2019",
+		"64bit Amazon Linux 2023 v4.0.0 running Python 3.11": "Python 3.11 running on 64bit Amazon Linux 2023",
+		"Unparsable": "",
+	}
+
+	for name, expected := range testCases {
+		if got := tfelasticbeanstalk.SolutionStackPlatformBranchName(name); got != expected {
+			t.Errorf("%s: expected %q, got %q", name, expected, got)
+		}
+	}
+}
+
 func TestAccElasticBeanstalkSolutionStackDataSource_basic(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
@@ -28,6 +78,26 @@ func TestAccElasticBeanstalkSolutionStackDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccElasticBeanstalkSolutionStackDataSource_platformBranchName(t *testing.T) {
+	dataSourceName := "data.aws_elastic_beanstalk_solution_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSolutionStackDataSourceConfig_platformBranchName,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSolutionStackIDDataSource(dataSourceName),
+					resource.TestMatchResourceAttr(dataSourceName, "name", regexp.MustCompile("^64bit Amazon Linux 2 v(.*) running Docker$")),
+					resource.TestCheckResourceAttr(dataSourceName, "platform_branch_name", "Docker running on 64bit Amazon Linux 2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSolutionStackIDDataSource(n string) resource.TestCheckFunc {
 	// Wait for solution stacks
 	return func(s *terraform.State) error {
@@ -49,3 +119,11 @@ data "aws_elastic_beanstalk_solution_stack" "multi_docker" {
   name_regex  = "^64bit Amazon Linux (.*) Multi-container Docker (.*)$"
 }
 `
+
+const testAccSolutionStackDataSourceConfig_platformBranchName = `
+data "aws_elastic_beanstalk_solution_stack" "test" {
+  most_recent          = true
+  name_regex           = "Docker$"
+  platform_branch_name = "Docker running on 64bit Amazon Linux 2"
+}
+`
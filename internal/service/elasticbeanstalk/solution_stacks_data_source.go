@@ -0,0 +1,117 @@
+package elasticbeanstalk
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceSolutionStacks() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSolutionStacksRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"platform_branch_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"solution_stacks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permitted_file_types": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"platform_branch_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSolutionStacksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
+
+	output, err := conn.ListAvailableSolutionStacksWithContext(ctx, &elasticbeanstalk.ListAvailableSolutionStacksInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Solution Stacks: %s", err)
+	}
+
+	var r *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		r = regexp.MustCompile(v.(string))
+	}
+
+	platformBranchName := d.Get("platform_branch_name").(string)
+	permittedFileTypes := make(map[string][]*string)
+	var names []*string
+
+	for _, v := range output.SolutionStackDetails {
+		permittedFileTypes[aws.StringValue(v.SolutionStackName)] = v.PermittedFileTypes
+	}
+
+	for _, v := range output.SolutionStacks {
+		name := aws.StringValue(v)
+
+		if r != nil && !r.MatchString(name) {
+			continue
+		}
+
+		if platformBranchName != "" && solutionStackPlatformBranchName(name) != platformBranchName {
+			continue
+		}
+
+		names = append(names, v)
+	}
+
+	sortSolutionStacksByRecency(names)
+
+	tfList := make([]interface{}, 0, len(names))
+	for _, v := range names {
+		name := aws.StringValue(v)
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":                 name,
+			"permitted_file_types": aws.StringValueSlice(permittedFileTypes[name]),
+			"platform_branch_name": solutionStackPlatformBranchName(name),
+		})
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("names", aws.StringValueSlice(names))
+	if err := d.Set("solution_stacks", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting solution_stacks: %s", err)
+	}
+
+	return diags
+}
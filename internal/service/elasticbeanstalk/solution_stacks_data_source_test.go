@@ -0,0 +1,63 @@
+package elasticbeanstalk_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccElasticBeanstalkSolutionStacksDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_elastic_beanstalk_solution_stacks.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSolutionStacksDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "names.#", "0"),
+					resource.TestMatchResourceAttr(dataSourceName, "names.0", regexp.MustCompile("^64bit Amazon Linux 2 v(.*) running Docker$")),
+					resource.TestCheckResourceAttrPair(dataSourceName, "solution_stacks.0.name", dataSourceName, "names.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "solution_stacks.0.platform_branch_name", "Docker running on 64bit Amazon Linux 2"),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "solution_stacks.0.permitted_file_types.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkSolutionStacksDataSource_nameRegex(t *testing.T) {
+	dataSourceName := "data.aws_elastic_beanstalk_solution_stacks.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSolutionStacksDataSourceConfig_nameRegex,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "solution_stacks.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSolutionStacksDataSourceConfig_basic = `
+data "aws_elastic_beanstalk_solution_stacks" "test" {
+  platform_branch_name = "Docker running on 64bit Amazon Linux 2"
+}
+`
+
+const testAccSolutionStacksDataSourceConfig_nameRegex = `
+data "aws_elastic_beanstalk_solution_stacks" "test" {
+  name_regex = "^tf-acc-test-does-not-exist$"
+}
+`
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -40,10 +41,7 @@ func ResourceFileCache() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"arn": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+			names.AttrARN: sdkv2.ARNSchemaComputed(),
 			"copy_tags_to_data_repository_associations": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -132,7 +130,7 @@ func ResourceFileCache() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
-						"tags": tftags.TagsSchemaComputed(),
+						names.AttrTags: tftags.TagsSchemaComputed(),
 					},
 				},
 			},
@@ -168,13 +166,7 @@ func ResourceFileCache() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`^[0-9](.[0-9]*)*$`), "invalid pattern"),
 				),
 			},
-			"kms_key_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
-			},
+			"kms_key_id": sdkv2.ARNSchemaOptionalComputedForceNew(),
 			"lustre_configuration": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -256,7 +248,7 @@ func ResourceFileCache() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"security_group_ids": {
+			names.AttrSecurityGroupIDs: {
 				Type:     schema.TypeSet,
 				Optional: true,
 				ForceNew: true,
@@ -271,15 +263,15 @@ func ResourceFileCache() *schema.Resource {
 					validation.IntBetween(0, 2147483647),
 				),
 			},
-			"subnet_ids": {
+			names.AttrSubnetIDs: {
 				Type:     schema.TypeList,
 				Required: true,
 				ForceNew: true,
 				MaxItems: 50,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tags":     tftags.TagsSchema(),
-			"tags_all": tftags.TagsSchemaComputed(),
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -389,7 +381,9 @@ func resourceFileCacheRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	//Cache tags do not get returned with describe call so need to make a separate list tags call
-	tags, tagserr := ListTags(ctx, conn, *filecache.ResourceARN)
+	tags, tagserr := meta.(*conns.AWSClient).BatchListTags(ctx, aws.StringValue(filecache.ResourceARN), d.IsNewResource() || d.HasChange("tags_all"), func() (tftags.KeyValueTags, error) {
+		return ListTags(ctx, conn, *filecache.ResourceARN)
+	})
 
 	if tagserr != nil {
 		return create.DiagError(names.FSx, create.ErrActionReading, ResNameFileCache, d.Id(), err)
@@ -16,9 +16,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func ResourceOntapStorageVirtualMachine() *schema.Resource {
@@ -47,10 +49,7 @@ func ResourceOntapStorageVirtualMachine() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"arn": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+			names.AttrARN: sdkv2.ARNSchemaComputed(),
 			"active_directory_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -219,8 +218,8 @@ func ResourceOntapStorageVirtualMachine() *schema.Resource {
 				Sensitive:    true,
 				ValidateFunc: validation.StringLenBetween(8, 50),
 			},
-			"tags":     tftags.TagsSchema(),
-			"tags_all": tftags.TagsSchemaComputed(),
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"uuid": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -309,7 +308,9 @@ func resourceOntapStorageVirtualMachineRead(ctx context.Context, d *schema.Resou
 	}
 
 	//SVM tags do not get returned with describe call so need to make a separate list tags call
-	tags, tagserr := ListTags(ctx, conn, *storageVirtualMachine.ResourceARN)
+	tags, tagserr := meta.(*conns.AWSClient).BatchListTags(ctx, aws.StringValue(storageVirtualMachine.ResourceARN), d.IsNewResource() || d.HasChange("tags_all"), func() (tftags.KeyValueTags, error) {
+		return ListTags(ctx, conn, *storageVirtualMachine.ResourceARN)
+	})
 
 	if tagserr != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Tags for FSx ONTAP Storage Virtual Machine (%s): %s", d.Id(), err)
@@ -14,9 +14,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func ResourceOntapVolume() *schema.Resource {
@@ -36,10 +39,7 @@ func ResourceOntapVolume() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"arn": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+			names.AttrARN: sdkv2.ARNSchemaComputed(),
 			"file_system_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -104,8 +104,8 @@ func ResourceOntapVolume() *schema.Resource {
 					},
 				},
 			},
-			"tags":     tftags.TagsSchema(),
-			"tags_all": tftags.TagsSchemaComputed(),
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"uuid": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -143,7 +143,10 @@ func resourceOntapVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if v, ok := d.GetOk("tiering_policy"); ok {
-		input.OntapConfiguration.TieringPolicy = expandOntapVolumeTieringPolicy(v.([]interface{}))
+		// Cooling period only accepts a minimum of 2 but int will return 0 not nil if unset.
+		if err := flex.Expand(v, &input.OntapConfiguration.TieringPolicy, flex.WithOmitEmpty()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating FSx Volume: %s", err)
+		}
 	}
 
 	if len(tags) > 0 {
@@ -201,12 +204,18 @@ func resourceOntapVolumeRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("uuid", ontapConfig.UUID)
 	d.Set("volume_type", volume.VolumeType)
 
-	if err := d.Set("tiering_policy", flattenOntapVolumeTieringPolicy(ontapConfig.TieringPolicy)); err != nil {
+	tieringPolicy, err := flex.Flatten(ontapConfig.TieringPolicy, flex.WithOmitEmpty())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading FSx ONTAP Volume (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("tiering_policy", tieringPolicy); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tiering_policy: %s", err)
 	}
 
 	//Volume tags do not get returned with describe call so need to make a separate list tags call
-	tags, tagserr := ListTags(ctx, conn, *volume.ResourceARN)
+	tags, tagserr := meta.(*conns.AWSClient).BatchListTags(ctx, aws.StringValue(volume.ResourceARN), d.IsNewResource() || d.HasChange("tags_all"), func() (tftags.KeyValueTags, error) {
+		return ListTags(ctx, conn, *volume.ResourceARN)
+	})
 
 	if tagserr != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Tags for FSx ONTAP Volume (%s): %s", d.Id(), err)
@@ -262,7 +271,9 @@ func resourceOntapVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if d.HasChange("tiering_policy") {
-			input.OntapConfiguration.TieringPolicy = expandOntapVolumeTieringPolicy(d.Get("tiering_policy").([]interface{}))
+			if err := flex.Expand(d.Get("tiering_policy"), &input.OntapConfiguration.TieringPolicy, flex.WithOmitEmpty()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating FSx ONTAP Volume (%s): %s", d.Id(), err)
+			}
 		}
 
 		_, err := conn.UpdateVolumeWithContext(ctx, input)
@@ -302,44 +313,3 @@ func resourceOntapVolumeDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	return diags
 }
-
-func expandOntapVolumeTieringPolicy(cfg []interface{}) *fsx.TieringPolicy {
-	if len(cfg) < 1 {
-		return nil
-	}
-
-	conf := cfg[0].(map[string]interface{})
-
-	out := fsx.TieringPolicy{}
-
-	//Cooling period only accepts a minimum of 2 but int will return 0 not nil if unset
-	//Therefore we only set it if it is 2 or more
-	if v, ok := conf["cooling_period"].(int); ok && v >= 2 {
-		out.CoolingPeriod = aws.Int64(int64(v))
-	}
-
-	if v, ok := conf["name"].(string); ok {
-		out.Name = aws.String(v)
-	}
-
-	return &out
-}
-
-func flattenOntapVolumeTieringPolicy(rs *fsx.TieringPolicy) []interface{} {
-	if rs == nil {
-		return []interface{}{}
-	}
-
-	minCoolingPeriod := 2
-
-	m := make(map[string]interface{})
-	if aws.Int64Value(rs.CoolingPeriod) >= int64(minCoolingPeriod) {
-		m["cooling_period"] = aws.Int64Value(rs.CoolingPeriod)
-	}
-
-	if rs.Name != nil {
-		m["name"] = aws.StringValue(rs.Name)
-	}
-
-	return []interface{}{m}
-}
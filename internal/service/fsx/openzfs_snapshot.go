@@ -15,9 +15,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func ResourceOpenzfsSnapshot() *schema.Resource {
@@ -38,10 +40,7 @@ func ResourceOpenzfsSnapshot() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"arn": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+			names.AttrARN: sdkv2.ARNSchemaComputed(),
 			"creation_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -51,8 +50,8 @@ func ResourceOpenzfsSnapshot() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(1, 203),
 			},
-			"tags":     tftags.TagsSchemaComputed(),
-			"tags_all": tftags.TagsSchemaComputed(),
+			names.AttrTags:    tftags.TagsSchemaComputed(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"volume_id": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -124,7 +123,9 @@ func resourceOpenzfsSnapshotRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	//Snapshot tags do not get returned with describe call so need to make a separate list tags call
-	tags, tagserr := ListTags(ctx, conn, *snapshot.ResourceARN)
+	tags, tagserr := meta.(*conns.AWSClient).BatchListTags(ctx, aws.StringValue(snapshot.ResourceARN), d.IsNewResource() || d.HasChange("tags_all"), func() (tftags.KeyValueTags, error) {
+		return ListTags(ctx, conn, *snapshot.ResourceARN)
+	})
 
 	if tagserr != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Tags for FSx OpenZFS Snapshot (%s): %s", d.Id(), err)
@@ -12,7 +12,9 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func DataSourceOpenzfsSnapshot() *schema.Resource {
@@ -20,10 +22,7 @@ func DataSourceOpenzfsSnapshot() *schema.Resource {
 		ReadWithoutTimeout: dataSourceOpenzfsSnapshotRead,
 
 		Schema: map[string]*schema.Schema{
-			"arn": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+			names.AttrARN: sdkv2.ARNSchemaComputed(),
 			"creation_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -47,7 +46,7 @@ func DataSourceOpenzfsSnapshot() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"tags": tftags.TagsSchemaComputed(),
+			names.AttrTags: tftags.TagsSchemaComputed(),
 			"volume_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -109,7 +108,9 @@ func dataSourceOpenzfsSnapshotRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	//Snapshot tags do not get returned with describe call so need to make a separate list tags call
-	tags, tagserr := ListTags(ctx, conn, *snapshot.ResourceARN)
+	tags, tagserr := meta.(*conns.AWSClient).BatchListTags(ctx, aws.StringValue(snapshot.ResourceARN), false, func() (tftags.KeyValueTags, error) {
+		return ListTags(ctx, conn, *snapshot.ResourceARN)
+	})
 
 	if tagserr != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Tags for FSx OpenZFS Snapshot (%s): %s", d.Id(), err)
@@ -275,6 +275,9 @@ func sweepOntapVolume(region string) error {
 			if v.OntapConfiguration != nil && aws.BoolValue(v.OntapConfiguration.StorageVirtualMachineRoot) {
 				continue
 			}
+			if aws.StringValue(v.Lifecycle) == fsx.VolumeLifecycleDeleting {
+				continue
+			}
 
 			r := ResourceOntapVolume()
 			d := r.Data(nil)
@@ -14,9 +14,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func ResourceContainerService() *schema.Resource {
@@ -38,10 +41,7 @@ func ResourceContainerService() *schema.Resource {
 		CustomizeDiff: verify.SetTagsDiff,
 
 		Schema: map[string]*schema.Schema{
-			"arn": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
+			names.AttrARN: sdkv2.ARNSchemaComputed(),
 			"availability_zone": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -151,8 +151,8 @@ func ResourceContainerService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags":     tftags.TagsSchema(),
-			"tags_all": tftags.TagsSchemaComputed(),
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -177,8 +177,10 @@ func resourceContainerServiceCreate(ctx context.Context, d *schema.ResourceData,
 		input.PublicDomainNames = expandContainerServicePublicDomainNames(v.([]interface{}))
 	}
 
-	if v, ok := d.GetOk("private_registry_access"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.PrivateRegistryAccess = expandPrivateRegistryAccess(v.([]interface{})[0].(map[string]interface{}))
+	if v, ok := d.GetOk("private_registry_access"); ok {
+		if err := flex.Expand(v, &input.PrivateRegistryAccess); err != nil {
+			return diag.Errorf("error creating Lightsail Container Service (%s): %s", serviceName, err)
+		}
 	}
 
 	if len(tags) > 0 {
@@ -241,7 +243,11 @@ func resourceContainerServiceRead(ctx context.Context, d *schema.ResourceData, m
 	if err := d.Set("public_domain_names", flattenContainerServicePublicDomainNames(cs.PublicDomainNames)); err != nil {
 		return diag.Errorf("error setting public_domain_names for Lightsail Container Service (%s): %s", d.Id(), err)
 	}
-	if err := d.Set("private_registry_access", []interface{}{flattenPrivateRegistryAccess(cs.PrivateRegistryAccess)}); err != nil {
+	privateRegistryAccess, err := flex.Flatten(cs.PrivateRegistryAccess)
+	if err != nil {
+		return diag.Errorf("error reading Lightsail Container Service (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("private_registry_access", privateRegistryAccess); err != nil {
 		return diag.Errorf("error setting private_registry_access for Lightsail Container Service (%s): %s", d.Id(), err)
 	}
 	d.Set("arn", cs.Arn)
@@ -360,66 +366,6 @@ func expandContainerServicePublicDomainNames(rawPublicDomainNames []interface{})
 	return resultMap
 }
 
-func expandPrivateRegistryAccess(tfMap map[string]interface{}) *lightsail.PrivateRegistryAccessRequest {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &lightsail.PrivateRegistryAccessRequest{}
-
-	if v, ok := tfMap["ecr_image_puller_role"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		apiObject.EcrImagePullerRole = expandECRImagePullerRole(v[0].(map[string]interface{}))
-	}
-
-	return apiObject
-}
-
-func expandECRImagePullerRole(tfMap map[string]interface{}) *lightsail.ContainerServiceECRImagePullerRoleRequest {
-	if tfMap == nil {
-		return nil
-	}
-
-	apiObject := &lightsail.ContainerServiceECRImagePullerRoleRequest{}
-
-	if v, ok := tfMap["is_active"].(bool); ok {
-		apiObject.IsActive = aws.Bool(v)
-	}
-
-	return apiObject
-}
-
-func flattenPrivateRegistryAccess(apiObject *lightsail.PrivateRegistryAccess) map[string]interface{} {
-	if apiObject == nil {
-		return nil
-	}
-
-	tfMap := map[string]interface{}{}
-
-	if v := apiObject.EcrImagePullerRole; v != nil {
-		tfMap["ecr_image_puller_role"] = []interface{}{flattenECRImagePullerRole(v)}
-	}
-
-	return tfMap
-}
-
-func flattenECRImagePullerRole(apiObject *lightsail.ContainerServiceECRImagePullerRole) map[string]interface{} {
-	if apiObject == nil {
-		return nil
-	}
-
-	tfMap := map[string]interface{}{}
-
-	if v := apiObject.IsActive; v != nil {
-		tfMap["is_active"] = aws.BoolValue(v)
-	}
-
-	if v := apiObject.PrincipalArn; v != nil {
-		tfMap["principal_arn"] = aws.StringValue(v)
-	}
-
-	return tfMap
-}
-
 func flattenContainerServicePublicDomainNames(domainNames map[string][]*string) []interface{} {
 	if domainNames == nil {
 		return []interface{}{}
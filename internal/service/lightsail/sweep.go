@@ -41,7 +41,6 @@ func sweepContainerServices(region string) error {
 	conn := client.(*conns.AWSClient).LightsailConn()
 
 	input := &lightsail.GetContainerServicesInput{}
-	var sweeperErrs *multierror.Error
 	sweepResources := make([]sweep.Sweepable, 0)
 
 	output, err := conn.GetContainerServicesWithContext(ctx, input)
@@ -52,7 +51,7 @@ func sweepContainerServices(region string) error {
 	}
 
 	if err != nil {
-		return fmt.Errorf("Error retrieving Lightsail Container Services: %s", err)
+		return fmt.Errorf("error listing Lightsail Container Services (%s): %w", region, err)
 	}
 
 	for _, service := range output.ContainerServices {
@@ -60,6 +59,10 @@ func sweepContainerServices(region string) error {
 			continue
 		}
 
+		if aws.StringValue(service.State) == lightsail.ContainerServiceStateDeleting {
+			continue
+		}
+
 		r := ResourceContainerService()
 		d := r.Data(nil)
 		d.SetId(aws.StringValue(service.ContainerServiceName))
@@ -67,20 +70,11 @@ func sweepContainerServices(region string) error {
 		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 	}
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Lightsail Container Services sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
-	}
-
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Lightsail Container Services  for %s: %w", region, err))
-	}
-
 	if err := sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Lightsail Container Services for %s: %w", region, err))
+		return fmt.Errorf("error sweeping Lightsail Container Services (%s): %w", region, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return nil
 }
 
 func sweepInstances(region string) error {
@@ -0,0 +1,15 @@
+package ram
+
+const (
+	PermissionTypeAll             = "ALL"
+	PermissionTypeAWSManaged      = "AWS_MANAGED"
+	PermissionTypeCustomerManaged = "CUSTOMER_MANAGED"
+)
+
+func PermissionType_Values() []string {
+	return []string{
+		PermissionTypeAll,
+		PermissionTypeAWSManaged,
+		PermissionTypeCustomerManaged,
+	}
+}
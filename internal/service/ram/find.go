@@ -2,12 +2,14 @@ package ram
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -111,41 +113,45 @@ func FindResourceShareInvitationByARN(ctx context.Context, conn *ram.RAM, arn st
 }
 
 func resourceShare(ctx context.Context, conn *ram.RAM, input *ram.GetResourceSharesInput) (*ram.ResourceShare, error) {
-	var shares *ram.GetResourceSharesOutput
-
 	// Retry for Ram resource share eventual consistency
-	err := resource.RetryContext(ctx, FindResourceShareTimeout, func() *resource.RetryError {
-		ss, err := conn.GetResourceSharesWithContext(ctx, input)
-		shares = ss
-
-		if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
-			return resource.RetryableError(err)
-		}
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, FindResourceShareTimeout, func() (interface{}, error) {
+		output, err := FindResourceShares(ctx, conn, input)
 
 		if err != nil {
-			return resource.NonRetryableError(err)
+			return nil, err
 		}
 
-		if len(shares.ResourceShares) == 0 {
-			return resource.RetryableError(&resource.NotFoundError{})
+		if len(output) == 0 {
+			return nil, tfresource.NewEmptyResultError(input)
 		}
 
-		return nil
+		return output[0], nil
 	})
 
-	if tfresource.TimedOut(err) {
-		shares, err = conn.GetResourceSharesWithContext(ctx, input)
+	if errors.Is(err, tfresource.ErrEmptyResult) {
+		return nil, nil
 	}
 
 	if err != nil {
 		return nil, err
 	}
 
-	if shares == nil || len(shares.ResourceShares) == 0 {
-		return nil, nil
-	}
+	return outputRaw.(*ram.ResourceShare), nil
+}
 
-	return shares.ResourceShares[0], nil
+// FindResourceShares returns all the resource shares matching the specified input and filters.
+// An UnknownResourceException is returned as a NotFoundError.
+func FindResourceShares(ctx context.Context, conn *ram.RAM, input *ram.GetResourceSharesInput, filters ...tfslices.FilterFunc[*ram.ResourceShare]) ([]*ram.ResourceShare, error) {
+	return tfresource.FindAllPages(input,
+		func(fn func(*ram.GetResourceSharesOutput, bool) bool) error {
+			return conn.GetResourceSharesPagesWithContext(ctx, input, fn)
+		},
+		func(page *ram.GetResourceSharesOutput) []*ram.ResourceShare {
+			return page.ResourceShares
+		},
+		[]string{ram.ErrCodeUnknownResourceException},
+		append([]tfslices.FilterFunc[*ram.ResourceShare]{tfresource.NotNil[ram.ResourceShare]}, filters...)...,
+	)
 }
 
 func resourceShareInvitationByResourceShareARNAndStatus(ctx context.Context, conn *ram.RAM, resourceShareArn, status string) (*ram.ResourceShareInvitation, error) {
@@ -210,3 +216,137 @@ func FindResourceSharePrincipalAssociationByShareARNPrincipal(ctx context.Contex
 
 	return output.ResourceShareAssociations[0], nil
 }
+
+// FindResourceSharePermissionByTwoPartKey returns the permission with the specified ARN associated with the specified resource share.
+func FindResourceSharePermissionByTwoPartKey(ctx context.Context, conn *ram.RAM, resourceShareARN, permissionARN string) (*ram.ResourceSharePermissionSummary, error) {
+	input := &ram.ListResourceSharePermissionsInput{
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+	var output *ram.ResourceSharePermissionSummary
+
+	err := conn.ListResourceSharePermissionsPagesWithContext(ctx, input, func(page *ram.ListResourceSharePermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Permissions {
+			if v != nil && aws.StringValue(v.Arn) == permissionARN {
+				output = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// FindResourceShareAssociatedEntities returns the entities (principals or resource ARNs) of the specified association type
+// that are associated, or being associated, with the specified resource share.
+func FindResourceShareAssociatedEntities(ctx context.Context, conn *ram.RAM, resourceShareARN, associationType string) ([]string, error) {
+	input := &ram.GetResourceShareAssociationsInput{
+		AssociationType:   aws.String(associationType),
+		ResourceShareArns: aws.StringSlice([]string{resourceShareARN}),
+	}
+	var output []string
+
+	err := conn.GetResourceShareAssociationsPagesWithContext(ctx, input, func(page *ram.GetResourceShareAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceShareAssociations {
+			if v == nil {
+				continue
+			}
+
+			switch aws.StringValue(v.Status) {
+			case ram.ResourceShareAssociationStatusAssociated, ram.ResourceShareAssociationStatusAssociating:
+				output = append(output, aws.StringValue(v.AssociatedEntity))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindPermissions returns the permissions matching the specified input.
+func FindPermissions(ctx context.Context, conn *ram.RAM, input *ram.ListPermissionsInput) ([]*ram.ResourceSharePermissionSummary, error) {
+	var output []*ram.ResourceSharePermissionSummary
+
+	err := conn.ListPermissionsPagesWithContext(ctx, input, func(page *ram.ListPermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Permissions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// FindPermissionByARN returns the default version of the permission with the specified ARN.
+func FindPermissionByARN(ctx context.Context, conn *ram.RAM, arn string) (*ram.ResourceSharePermissionDetail, error) {
+	input := &ram.GetPermissionInput{
+		PermissionArn: aws.String(arn),
+	}
+
+	output, err := conn.GetPermissionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Permission == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Permission, nil
+}
@@ -0,0 +1,239 @@
+package ram
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePermissionAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePermissionAssociationCreate,
+		ReadWithoutTimeout:   resourcePermissionAssociationRead,
+		UpdateWithoutTimeout: resourcePermissionAssociationUpdate,
+		DeleteWithoutTimeout: resourcePermissionAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"default_version": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"permission_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"permission_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"replace": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"resource_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePermissionAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn()
+
+	resourceShareARN := d.Get("resource_share_arn").(string)
+	permissionARN := d.Get("permission_arn").(string)
+	id := PermissionAssociationCreateID(resourceShareARN, permissionARN)
+	input := &ram.AssociateResourceSharePermissionInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		PermissionArn:    aws.String(permissionARN),
+		Replace:          aws.Bool(d.Get("replace").(bool)),
+		ResourceShareArn: aws.String(resourceShareARN),
+	}
+
+	if v, ok := d.GetOk("permission_version"); ok {
+		input.PermissionVersion = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Creating RAM Permission Association: %s", input)
+	_, err := conn.AssociateResourceSharePermissionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RAM Permission Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := WaitPermissionAssociated(ctx, conn, resourceShareARN, permissionARN, input.PermissionVersion, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RAM Permission Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePermissionAssociationRead(ctx, d, meta)...)
+}
+
+func resourcePermissionAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn()
+
+	resourceShareARN, permissionARN, err := PermissionAssociationParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permission Association (%s): %s", d.Id(), err)
+	}
+
+	permission, err := FindResourceSharePermissionByTwoPartKey(ctx, conn, resourceShareARN, permissionARN)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RAM Permission Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permission Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("default_version", permission.DefaultVersion)
+	d.Set("name", permission.Name)
+	d.Set("permission_arn", permission.Arn)
+	if v, err := strconv.Atoi(aws.StringValue(permission.Version)); err == nil {
+		d.Set("permission_version", v)
+	}
+	d.Set("resource_share_arn", resourceShareARN)
+	d.Set("resource_type", permission.ResourceType)
+	d.Set("status", permission.Status)
+
+	return diags
+}
+
+func resourcePermissionAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn()
+
+	if d.HasChange("permission_version") {
+		resourceShareARN, permissionARN, err := PermissionAssociationParseID(d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RAM Permission Association (%s): %s", d.Id(), err)
+		}
+
+		// Replacing an associated permission with another version of the same permission
+		// always requires the replace flag, independent of the configured value.
+		input := &ram.AssociateResourceSharePermissionInput{
+			ClientToken:      aws.String(resource.UniqueId()),
+			PermissionArn:    aws.String(permissionARN),
+			Replace:          aws.Bool(true),
+			ResourceShareArn: aws.String(resourceShareARN),
+		}
+
+		if v, ok := d.GetOk("permission_version"); ok {
+			input.PermissionVersion = aws.Int64(int64(v.(int)))
+		}
+
+		log.Printf("[DEBUG] Updating RAM Permission Association: %s", input)
+		_, err = conn.AssociateResourceSharePermissionWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RAM Permission Association (%s): %s", d.Id(), err)
+		}
+
+		if _, err := WaitPermissionAssociated(ctx, conn, resourceShareARN, permissionARN, input.PermissionVersion, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RAM Permission Association (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePermissionAssociationRead(ctx, d, meta)...)
+}
+
+func resourcePermissionAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn()
+
+	resourceShareARN, permissionARN, err := PermissionAssociationParseID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RAM Permission Association (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting RAM Permission Association: %s", d.Id())
+	_, err = conn.DisassociateResourceSharePermissionWithContext(ctx, &ram.DisassociateResourceSharePermissionInput{
+		ClientToken:      aws.String(resource.UniqueId()),
+		PermissionArn:    aws.String(permissionARN),
+		ResourceShareArn: aws.String(resourceShareARN),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RAM Permission Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitPermissionDisassociated(ctx, conn, resourceShareARN, permissionARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RAM Permission Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const permissionAssociationIDSeparator = ","
+
+func PermissionAssociationCreateID(resourceShareARN, permissionARN string) string {
+	return strings.Join([]string{resourceShareARN, permissionARN}, permissionAssociationIDSeparator)
+}
+
+func PermissionAssociationParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, permissionAssociationIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected SHARE%[2]sPERMISSION", id, permissionAssociationIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
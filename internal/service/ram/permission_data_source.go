@@ -0,0 +1,133 @@
+package ram
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourcePermission() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePermissionRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  verify.ValidARN,
+				ConflictsWith: []string{"name"},
+			},
+			"default_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_resource_type_default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"arn"},
+			},
+			"permission": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"permission_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      PermissionTypeAll,
+				ValidateFunc: validation.StringInSlice(PermissionType_Values(), false),
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn()
+
+	input := &ram.ListPermissionsInput{}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.ResourceType = aws.String(v.(string))
+	}
+
+	permissions, err := FindPermissions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permissions: %s", err)
+	}
+
+	arn, name, permissionType := d.Get("arn").(string), d.Get("name").(string), d.Get("permission_type").(string)
+	var results []*ram.ResourceSharePermissionSummary
+
+	for _, v := range permissions {
+		if arn != "" && aws.StringValue(v.Arn) != arn {
+			continue
+		}
+
+		if name != "" && aws.StringValue(v.Name) != name {
+			continue
+		}
+
+		if permissionType != PermissionTypeAll && permissionTypeFromARN(aws.StringValue(v.Arn)) != permissionType {
+			continue
+		}
+
+		results = append(results, v)
+	}
+
+	var summary *ram.ResourceSharePermissionSummary
+
+	switch count := len(results); count {
+	case 0:
+		err = tfresource.NewEmptyResultError(input)
+	case 1:
+		summary = results[0]
+	default:
+		err = tfresource.NewTooManyResultsError(count, input)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("RAM Permission", err))
+	}
+
+	permission, err := FindPermissionByARN(ctx, conn, aws.StringValue(summary.Arn))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permission (%s): %s", aws.StringValue(summary.Arn), err)
+	}
+
+	d.SetId(aws.StringValue(summary.Arn))
+	d.Set("arn", summary.Arn)
+	d.Set("default_version", summary.Version)
+	d.Set("is_resource_type_default", summary.IsResourceTypeDefault)
+	d.Set("name", summary.Name)
+	d.Set("permission", permission.Permission)
+	d.Set("permission_type", permissionTypeFromARN(aws.StringValue(summary.Arn)))
+	d.Set("resource_type", summary.ResourceType)
+	d.Set("status", summary.Status)
+
+	return diags
+}
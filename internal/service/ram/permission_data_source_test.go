@@ -0,0 +1,40 @@
+package ram_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRAMPermissionDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ram_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ram.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "default_version"),
+					resource.TestCheckResourceAttr(dataSourceName, "is_resource_type_default", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "AWSRAMDefaultPermissionSubnet"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "permission"),
+					resource.TestCheckResourceAttr(dataSourceName, "permission_type", "AWS_MANAGED"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_type", "ec2:Subnet"),
+				),
+			},
+		},
+	})
+}
+
+const testAccPermissionDataSourceConfig_basic = `
+data "aws_ram_permission" "test" {
+  name          = "AWSRAMDefaultPermissionSubnet"
+  resource_type = "ec2:Subnet"
+}
+`
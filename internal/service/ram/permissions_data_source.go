@@ -0,0 +1,147 @@
+package ram
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourcePermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePermissionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"permission_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      PermissionTypeAll,
+				ValidateFunc: validation.StringInSlice(PermissionType_Values(), false),
+			},
+			"permissions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_resource_type_default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permission_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourcePermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn()
+
+	input := &ram.ListPermissionsInput{}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.ResourceType = aws.String(v.(string))
+	}
+
+	permissions, err := FindPermissions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Permissions: %s", err)
+	}
+
+	permissionType := d.Get("permission_type").(string)
+	var arns, names []string
+	var tfList []interface{}
+
+	for _, v := range permissions {
+		if t := permissionTypeFromARN(aws.StringValue(v.Arn)); permissionType != PermissionTypeAll && t != permissionType {
+			continue
+		}
+
+		arns = append(arns, aws.StringValue(v.Arn))
+		names = append(names, aws.StringValue(v.Name))
+		tfList = append(tfList, flattenResourceSharePermissionSummary(v))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("arns", arns)
+	d.Set("names", names)
+	if err := d.Set("permissions", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting permissions: %s", err)
+	}
+
+	return diags
+}
+
+func flattenResourceSharePermissionSummary(apiObject *ram.ResourceSharePermissionSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":                      aws.StringValue(apiObject.Arn),
+		"default_version":          aws.StringValue(apiObject.Version),
+		"is_resource_type_default": aws.BoolValue(apiObject.IsResourceTypeDefault),
+		"name":                     aws.StringValue(apiObject.Name),
+		"permission_type":          permissionTypeFromARN(aws.StringValue(apiObject.Arn)),
+		"resource_type":            aws.StringValue(apiObject.ResourceType),
+		"status":                   aws.StringValue(apiObject.Status),
+	}
+
+	return tfMap
+}
+
+// permissionTypeFromARN returns whether the permission with the specified ARN is AWS or customer managed.
+// AWS managed permissions are owned by the "aws" pseudo-account, e.g. arn:aws:ram::aws:permission/AWSRAMDefaultPermissionSubnet.
+func permissionTypeFromARN(s string) string {
+	if v, err := arn.Parse(s); err == nil && v.AccountID != "aws" {
+		return PermissionTypeCustomerManaged
+	}
+
+	return PermissionTypeAWSManaged
+}
@@ -0,0 +1,40 @@
+package ram_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRAMPermissionsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ram_permissions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ram.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "arns.#", "0"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "names.*", "AWSRAMDefaultPermissionSubnet"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "permissions.*", map[string]string{
+						"name":            "AWSRAMDefaultPermissionSubnet",
+						"permission_type": "AWS_MANAGED",
+						"resource_type":   "ec2:Subnet",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccPermissionsDataSourceConfig_basic = `
+data "aws_ram_permissions" "test" {
+  permission_type = "AWS_MANAGED"
+  resource_type   = "ec2:Subnet"
+}
+`
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -29,6 +30,10 @@ func ResourcePrincipalAssociation() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(PrincipalDisassociationTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"resource_share_arn": {
 				Type:         schema.TypeString,
@@ -142,7 +147,10 @@ func resourcePrincipalAssociationDelete(ctx context.Context, d *schema.ResourceD
 	}
 
 	log.Println("[DEBUG] Delete RAM principal association request:", request)
-	_, err = conn.DisassociateResourceShareWithContext(ctx, request)
+	// Retry for eventual consistency, e.g. an association that is still being established.
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DisassociateResourceShareWithContext(ctx, request)
+	}, ram.ErrCodeInvalidStateTransitionException)
 
 	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
 		return diags
@@ -152,7 +160,7 @@ func resourcePrincipalAssociationDelete(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendErrorf(diags, "deleting RAM Resource Share Principal Association (%s): %s", d.Id(), err)
 	}
 
-	if _, err := WaitResourceSharePrincipalDisassociated(ctx, conn, resourceShareArn, principal); err != nil {
+	if _, err := WaitResourceSharePrincipalDisassociated(ctx, conn, resourceShareArn, principal, d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting RAM Resource Share Principal Association (%s): waiting for completion: %s", d.Id(), err)
 	}
 
@@ -128,14 +128,10 @@ func testAccCheckPrincipalAssociationDestroy(ctx context.Context) resource.TestC
 				return err
 			}
 
-			association, err := tfram.WaitResourceSharePrincipalDisassociated(ctx, conn, resourceShareARN, principal)
+			_, err = tfram.WaitResourceSharePrincipalDisassociated(ctx, conn, resourceShareARN, principal, tfram.PrincipalDisassociationTimeout)
 
 			if err != nil {
-				return err
-			}
-
-			if association != nil && aws.StringValue(association.Status) != ram.ResourceShareAssociationStatusDisassociated {
-				return fmt.Errorf("RAM Resource Share (%s) Principal Association (%s) not disassociated: %s", resourceShareARN, principal, aws.StringValue(association.Status))
+				return fmt.Errorf("RAM Resource Share (%s) Principal Association (%s) not disassociated: %w", resourceShareARN, principal, err)
 			}
 		}
 
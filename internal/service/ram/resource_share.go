@@ -2,14 +2,19 @@ package ram
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -57,11 +62,38 @@ func ResourceResourceShare() *schema.Resource {
 					ValidateFunc: verify.ValidARN,
 				},
 			},
+			"principals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.Any(
+						verify.ValidAccountID,
+						verify.ValidARN,
+					),
+				},
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"strict": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			verify.ValidARNInPartitionDiff("permission_arns", "ram"),
+		),
 	}
 }
 
@@ -81,6 +113,14 @@ func resourceResourceShareCreate(ctx context.Context, d *schema.ResourceData, me
 		input.PermissionArns = flex.ExpandStringSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("principals"); ok && v.(*schema.Set).Len() > 0 {
+		input.Principals = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("resource_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.ResourceArns = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -98,6 +138,10 @@ func resourceResourceShareCreate(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "waiting for RAM Resource Share (%s) to become ready: %s", d.Id(), err)
 	}
 
+	if err := waitResourceShareAssociations(ctx, conn, d.Id(), aws.StringValueSlice(input.Principals), aws.StringValueSlice(input.ResourceArns)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RAM Resource Share (%s) associations: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceResourceShareRead(ctx, d, meta)...)
 }
 
@@ -156,6 +200,29 @@ func resourceResourceShareRead(ctx context.Context, d *schema.ResourceData, meta
 
 	d.Set("permission_arns", aws.StringValueSlice(permissionARNs))
 
+	principals, err := FindResourceShareAssociatedEntities(ctx, conn, d.Id(), ram.ResourceShareAssociationTypePrincipal)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Resource Share (%s) principal associations: %s", d.Id(), err)
+	}
+
+	resourceARNs, err := FindResourceShareAssociatedEntities(ctx, conn, d.Id(), ram.ResourceShareAssociationTypeResource)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Resource Share (%s) resource associations: %s", d.Id(), err)
+	}
+
+	// In strict mode all associations are reported, so that any association made outside of this
+	// resource shows up as drift and is removed on the next apply.
+	// Otherwise only associations also present in configuration are reported.
+	if !d.Get("strict").(bool) {
+		principals = intersectStringSet(principals, d.Get("principals").(*schema.Set))
+		resourceARNs = intersectStringSet(resourceARNs, d.Get("resource_arns").(*schema.Set))
+	}
+
+	d.Set("principals", principals)
+	d.Set("resource_arns", resourceARNs)
+
 	return diags
 }
 
@@ -178,6 +245,76 @@ func resourceResourceShareUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChanges("principals", "resource_arns") {
+		o, n := d.GetChange("principals")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		addPrincipals, delPrincipals := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+		o, n = d.GetChange("resource_arns")
+		os, ns = o.(*schema.Set), n.(*schema.Set)
+		addResourceARNs, delResourceARNs := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+		if len(delPrincipals) > 0 || len(delResourceARNs) > 0 {
+			input := &ram.DisassociateResourceShareInput{
+				ClientToken:      aws.String(resource.UniqueId()),
+				ResourceShareArn: aws.String(d.Id()),
+			}
+
+			if len(delPrincipals) > 0 {
+				input.Principals = aws.StringSlice(delPrincipals)
+			}
+
+			if len(delResourceARNs) > 0 {
+				input.ResourceArns = aws.StringSlice(delResourceARNs)
+			}
+
+			log.Printf("[DEBUG] Disassociating RAM Resource Share: %s", input)
+			_, err := conn.DisassociateResourceShareWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "disassociating RAM Resource Share (%s): %s", d.Id(), err)
+			}
+
+			for _, v := range delPrincipals {
+				if _, err := WaitResourceSharePrincipalDisassociated(ctx, conn, d.Id(), v, PrincipalDisassociationTimeout); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for RAM Resource Share (%s) Principal (%s) disassociation: %s", d.Id(), v, err)
+				}
+			}
+
+			for _, v := range delResourceARNs {
+				if err := WaitForResourceShareResourceDisassociation(ctx, conn, d.Id(), v); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for RAM Resource Share (%s) Resource (%s) disassociation: %s", d.Id(), v, err)
+				}
+			}
+		}
+
+		if len(addPrincipals) > 0 || len(addResourceARNs) > 0 {
+			input := &ram.AssociateResourceShareInput{
+				ClientToken:      aws.String(resource.UniqueId()),
+				ResourceShareArn: aws.String(d.Id()),
+			}
+
+			if len(addPrincipals) > 0 {
+				input.Principals = aws.StringSlice(addPrincipals)
+			}
+
+			if len(addResourceARNs) > 0 {
+				input.ResourceArns = aws.StringSlice(addResourceARNs)
+			}
+
+			log.Printf("[DEBUG] Associating RAM Resource Share: %s", input)
+			_, err := conn.AssociateResourceShareWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "associating RAM Resource Share (%s): %s", d.Id(), err)
+			}
+
+			if err := waitResourceShareAssociations(ctx, conn, d.Id(), addPrincipals, addResourceARNs); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for RAM Resource Share (%s) associations: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -212,3 +349,37 @@ func resourceResourceShareDelete(ctx context.Context, d *schema.ResourceData, me
 
 	return diags
 }
+
+// waitResourceShareAssociations waits for the specified principals and resources to be associated with a resource share.
+// AWS account ID principals are not waited for as they need to accept the invitation before becoming associated.
+func waitResourceShareAssociations(ctx context.Context, conn *ram.RAM, resourceShareARN string, principals, resourceARNs []string) error {
+	for _, v := range principals {
+		if ok, _ := regexp.MatchString(`^\d{12}$`, v); ok {
+			continue
+		}
+
+		if _, err := WaitResourceSharePrincipalAssociated(ctx, conn, resourceShareARN, v); err != nil {
+			return fmt.Errorf("principal (%s): %w", v, err)
+		}
+	}
+
+	for _, v := range resourceARNs {
+		if err := waitForResourceShareResourceAssociation(ctx, conn, resourceShareARN, v); err != nil {
+			return fmt.Errorf("resource (%s): %w", v, err)
+		}
+	}
+
+	return nil
+}
+
+func intersectStringSet(values []string, set *schema.Set) []string {
+	var output []string
+
+	for _, v := range values {
+		if set.Contains(v) {
+			output = append(output, v)
+		}
+	}
+
+	return output
+}
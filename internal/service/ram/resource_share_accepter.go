@@ -78,6 +78,31 @@ func ResourceResourceShareAccepter() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+
+			"shared_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_group_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -173,9 +198,11 @@ func resourceResourceShareAccepterRead(ctx context.Context, d *schema.ResourceDa
 	}
 
 	var resourceARNs []*string
+	var sharedResources []interface{}
 	err = conn.ListResourcesPagesWithContext(ctx, listInput, func(page *ram.ListResourcesOutput, lastPage bool) bool {
 		for _, resource := range page.Resources {
 			resourceARNs = append(resourceARNs, resource.Arn)
+			sharedResources = append(sharedResources, flattenResource(resource))
 		}
 
 		return !lastPage
@@ -189,6 +216,10 @@ func resourceResourceShareAccepterRead(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendErrorf(diags, "unable to set resources: %s", err)
 	}
 
+	if err := d.Set("shared_resources", sharedResources); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting shared_resources: %s", err)
+	}
+
 	return diags
 }
 
@@ -228,6 +259,21 @@ func resourceResourceShareAccepterDelete(ctx context.Context, d *schema.Resource
 	return diags
 }
 
+func flattenResource(apiObject *ram.Resource) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"arn":                aws.StringValue(apiObject.Arn),
+		"resource_group_arn": aws.StringValue(apiObject.ResourceGroupArn),
+		"status":             aws.StringValue(apiObject.Status),
+		"type":               aws.StringValue(apiObject.Type),
+	}
+
+	return tfMap
+}
+
 func resourceResourceShareGetIDFromARN(arn string) string {
 	return strings.Replace(arn[strings.LastIndex(arn, ":")+1:], "resource-share/", "rs-", -1)
 }
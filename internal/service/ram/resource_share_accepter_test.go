@@ -45,6 +45,7 @@ func TestAccRAMResourceShareAccepter_basic(t *testing.T) {
 					resource.TestMatchResourceAttr(resourceName, "sender_account_id", regexp.MustCompile(`\d{12}`)),
 					resource.TestCheckResourceAttr(resourceName, "share_name", rName),
 					resource.TestCheckResourceAttr(resourceName, "resources.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "shared_resources.#", "0"),
 				),
 			},
 			{
@@ -110,6 +111,9 @@ func TestAccRAMResourceShareAccepter_resourceAssociation(t *testing.T) {
 					resource.TestMatchResourceAttr(resourceName, "sender_account_id", regexp.MustCompile(`\d{12}`)),
 					resource.TestCheckResourceAttr(resourceName, "share_name", rName),
 					resource.TestCheckResourceAttr(resourceName, "resources.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "shared_resources.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "shared_resources.0.arn", "aws_codebuild_project.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "shared_resources.0.type", "codebuild:Project"),
 				),
 			},
 			{
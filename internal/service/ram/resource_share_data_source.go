@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func DataSourceResourceShare() *schema.Resource {
@@ -96,41 +97,27 @@ func dataSourceResourceShareRead(ctx context.Context, d *schema.ResourceData, me
 		params.TagFilters = buildTagFilters(filters.(*schema.Set))
 	}
 
-	for {
-		resp, err := conn.GetResourceSharesWithContext(ctx, params)
+	output, err := FindResourceShares(ctx, conn, params, func(v *ram.ResourceShare) bool {
+		return aws.StringValue(v.Name) == name
+	})
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "retrieving resource share: empty response for: %s", params)
-		}
-
-		if len(resp.ResourceShares) > 1 {
-			return sdkdiag.AppendErrorf(diags, "Multiple resource shares found for: %s", name)
-		}
-
-		if resp == nil || len(resp.ResourceShares) == 0 {
-			return sdkdiag.AppendErrorf(diags, "No matching resource found: %s", err)
-		}
-
-		for _, r := range resp.ResourceShares {
-			if aws.StringValue(r.Name) == name {
-				d.SetId(aws.StringValue(r.ResourceShareArn))
-				d.Set("arn", r.ResourceShareArn)
-				d.Set("owning_account_id", r.OwningAccountId)
-				d.Set("status", r.Status)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RAM Resource Shares: %s", err)
+	}
 
-				if err := d.Set("tags", KeyValueTags(ctx, r.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
-					return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
-				}
+	r, err := tfresource.AssertSinglePtrResult(output, params)
 
-				break
-			}
-		}
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("RAM Resource Share", err))
+	}
 
-		if resp.NextToken == nil {
-			break
-		}
+	d.SetId(aws.StringValue(r.ResourceShareArn))
+	d.Set("arn", r.ResourceShareArn)
+	d.Set("owning_account_id", r.OwningAccountId)
+	d.Set("status", r.Status)
 
-		params.NextToken = resp.NextToken
+	if err := d.Set("tags", KeyValueTags(ctx, r.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
 	return diags
@@ -23,7 +23,7 @@ func TestAccRAMResourceShareDataSource_basic(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccResourceShareDataSourceConfig_nonExistent,
-				ExpectError: regexp.MustCompile(`No matching resource found`),
+				ExpectError: regexp.MustCompile(`no matching RAM Resource Share found`),
 			},
 			{
 				Config: testAccResourceShareDataSourceConfig_name(rName),
@@ -197,6 +197,78 @@ func TestAccRAMResourceShare_tags(t *testing.T) {
 	})
 }
 
+func TestAccRAMResourceShare_strict(t *testing.T) {
+	ctx := acctest.Context(t)
+	var resourceShare ram.ResourceShare
+	resourceName := "aws_ram_resource_share.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ram.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceShareConfig_strict(rName, "111111111111"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareExists(ctx, resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "principals.*", "111111111111"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "strict", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"principals", "strict"},
+			},
+			{
+				Config: testAccResourceShareConfig_strict(rName, "222222222222"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareExists(ctx, resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "principals.*", "222222222222"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRAMResourceShare_strictUnmanagedPrincipal(t *testing.T) {
+	ctx := acctest.Context(t)
+	var resourceShare ram.ResourceShare
+	resourceName := "aws_ram_resource_share.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ram.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceShareConfig_strict(rName, "111111111111"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareExists(ctx, resourceName, &resourceShare),
+					testAccCheckResourceShareAssociatePrincipal(ctx, &resourceShare, "222222222222"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceShareConfig_strict(rName, "111111111111"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceShareExists(ctx, resourceName, &resourceShare),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "principals.*", "111111111111"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRAMResourceShare_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var resourceShare ram.ResourceShare
@@ -250,6 +322,19 @@ func testAccCheckResourceShareExists(ctx context.Context, resourceName string, v
 	}
 }
 
+func testAccCheckResourceShareAssociatePrincipal(ctx context.Context, v *ram.ResourceShare, principal string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMConn()
+
+		_, err := conn.AssociateResourceShareWithContext(ctx, &ram.AssociateResourceShareInput{
+			Principals:       aws.StringSlice([]string{principal}),
+			ResourceShareArn: v.ResourceShareArn,
+		})
+
+		return err
+	}
+}
+
 func testAccCheckResourceShareDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMConn()
@@ -291,6 +376,17 @@ resource "aws_ram_resource_share" "test" {
 `, rName)
 }
 
+func testAccResourceShareConfig_strict(rName, principal string) string {
+	return fmt.Sprintf(`
+resource "aws_ram_resource_share" "test" {
+  allow_external_principals = true
+  name                      = %[1]q
+  principals                = [%[2]q]
+  strict                    = true
+}
+`, rName, principal)
+}
+
 func testAccResourceShareConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_ram_resource_share" "test" {
@@ -3,11 +3,13 @@ package ram
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ram"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -18,6 +20,8 @@ const (
 	ResourceShareStatusUnknown  = "Unknown"
 
 	PrincipalAssociationStatusNotFound = "NotFound"
+
+	PermissionAssociationStatusPending = "Pending"
 )
 
 // StatusResourceShareInvitation fetches the ResourceShareInvitation and its Status
@@ -82,3 +86,51 @@ func StatusResourceSharePrincipalAssociation(ctx context.Context, conn *ram.RAM,
 		return association, aws.StringValue(association.Status), nil
 	}
 }
+
+// StatusPermissionAssociation fetches the permission associated with a resource share.
+// A permission associated with a version other than the expected one (if any) is reported as pending.
+func StatusPermissionAssociation(ctx context.Context, conn *ram.RAM, resourceShareARN, permissionARN string, permissionVersion *int64) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		permission, err := FindResourceSharePermissionByTwoPartKey(ctx, conn, resourceShareARN, permissionARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if permissionVersion != nil && aws.StringValue(permission.Version) != strconv.FormatInt(aws.Int64Value(permissionVersion), 10) {
+			return permission, PermissionAssociationStatusPending, nil
+		}
+
+		return permission, ram.ResourceShareAssociationStatusAssociated, nil
+	}
+}
+
+// StatusResourceSharePrincipalDisassociation fetches the principal association and its Status.
+// An association that no longer exists or is DISASSOCIATED is reported as not found.
+func StatusResourceSharePrincipalDisassociation(ctx context.Context, conn *ram.RAM, resourceShareArn, principal string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		association, err := FindResourceSharePrincipalAssociationByShareARNPrincipal(ctx, conn, resourceShareArn, principal)
+
+		if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if association == nil || aws.StringValue(association.Status) == ram.ResourceShareAssociationStatusDisassociated {
+			return nil, "", nil
+		}
+
+		if aws.StringValue(association.Status) == ram.ResourceShareAssociationStatusFailed {
+			return association, aws.StringValue(association.Status), fmt.Errorf("association status message: %s", aws.StringValue(association.StatusMessage))
+		}
+
+		return association, aws.StringValue(association.Status), nil
+	}
+}
@@ -102,12 +102,13 @@ func WaitResourceSharePrincipalAssociated(ctx context.Context, conn *ram.RAM, re
 	return nil, err
 }
 
-func WaitResourceSharePrincipalDisassociated(ctx context.Context, conn *ram.RAM, resourceShareARN, principal string) (*ram.ResourceShareAssociation, error) {
+// WaitResourceSharePrincipalDisassociated waits for a principal association to be DISASSOCIATED or to disappear
+func WaitResourceSharePrincipalDisassociated(ctx context.Context, conn *ram.RAM, resourceShareARN, principal string, timeout time.Duration) (*ram.ResourceShareAssociation, error) {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{ram.ResourceShareAssociationStatusAssociated, ram.ResourceShareAssociationStatusDisassociating},
-		Target:  []string{ram.ResourceShareAssociationStatusDisassociated, PrincipalAssociationStatusNotFound},
-		Refresh: StatusResourceSharePrincipalAssociation(ctx, conn, resourceShareARN, principal),
-		Timeout: PrincipalDisassociationTimeout,
+		Pending: []string{ram.ResourceShareAssociationStatusAssociated, ram.ResourceShareAssociationStatusAssociating, ram.ResourceShareAssociationStatusDisassociating},
+		Target:  []string{},
+		Refresh: StatusResourceSharePrincipalDisassociation(ctx, conn, resourceShareARN, principal),
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -118,3 +119,39 @@ func WaitResourceSharePrincipalDisassociated(ctx context.Context, conn *ram.RAM,
 
 	return nil, err
 }
+
+// WaitPermissionAssociated waits for a permission (optionally with a specific version) to be associated with a resource share
+func WaitPermissionAssociated(ctx context.Context, conn *ram.RAM, resourceShareARN, permissionARN string, permissionVersion *int64, timeout time.Duration) (*ram.ResourceSharePermissionSummary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{PermissionAssociationStatusPending},
+		Target:  []string{ram.ResourceShareAssociationStatusAssociated},
+		Refresh: StatusPermissionAssociation(ctx, conn, resourceShareARN, permissionARN, permissionVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*ram.ResourceSharePermissionSummary); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// WaitPermissionDisassociated waits for a permission to be disassociated from a resource share
+func WaitPermissionDisassociated(ctx context.Context, conn *ram.RAM, resourceShareARN, permissionARN string, timeout time.Duration) (*ram.ResourceSharePermissionSummary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ram.ResourceShareAssociationStatusAssociated},
+		Target:  []string{},
+		Refresh: StatusPermissionAssociation(ctx, conn, resourceShareARN, permissionARN, nil),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*ram.ResourceSharePermissionSummary); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,117 @@
+package route53
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+// @FrameworkDataSource
+func newDataSourceCIDRCollection(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &dataSourceCIDRCollection{}
+
+	return d, nil
+}
+
+type dataSourceCIDRCollection struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceCIDRCollection) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_route53_cidr_collection"
+}
+
+func (d *dataSourceCIDRCollection) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"version": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceCIDRCollection) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceCIDRCollectionData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().Route53Conn()
+
+	var results []*route53.CollectionSummary
+	err := conn.ListCidrCollectionsPagesWithContext(ctx, &route53.ListCidrCollectionsInput{}, func(page *route53.ListCidrCollectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CidrCollections {
+			if v == nil {
+				continue
+			}
+
+			if !data.ID.IsNull() && data.ID.ValueString() != aws.StringValue(v.Id) {
+				continue
+			}
+
+			if !data.Name.IsNull() && data.Name.ValueString() != aws.StringValue(v.Name) {
+				continue
+			}
+
+			results = append(results, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError("listing Route 53 CIDR Collections", err.Error())
+
+		return
+	}
+
+	if n := len(results); n == 0 {
+		response.Diagnostics.AddError("no matching Route 53 CIDR Collection found", "")
+
+		return
+	} else if n > 1 {
+		response.Diagnostics.AddError("multiple Route 53 CIDR Collections matched; use additional constraints to reduce matches to a single Route 53 CIDR Collection", "")
+
+		return
+	}
+
+	collection := results[0]
+	data.ARN = flex.StringToFramework(ctx, collection.Arn)
+	data.ID = flex.StringToFramework(ctx, collection.Id)
+	data.Name = flex.StringToFramework(ctx, collection.Name)
+	data.Version = flex.Int64ToFramework(ctx, collection.Version)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceCIDRCollectionData struct {
+	ARN     types.String `tfsdk:"arn"`
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Version types.Int64  `tfsdk:"version"`
+}
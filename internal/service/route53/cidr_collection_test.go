@@ -7,11 +7,9 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/route53"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -20,18 +18,18 @@ func TestAccRoute53CIDRCollection_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v route53.CollectionSummary
 	resourceName := "aws_route53_cidr_collection.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckCIDRCollectionDestroy(ctx),
+		CheckDestroy:             testAccCheckCIDRCollectionDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCIDRCollection_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCIDRCollectionExists(ctx, resourceName, &v),
+					testAccCheckCIDRCollectionExists(ctx, t, resourceName, &v),
 					acctest.MatchResourceAttrGlobalARNNoAccount(resourceName, "arn", "route53", regexp.MustCompile(`cidrcollection/.+`)),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 				),
@@ -49,18 +47,18 @@ func TestAccRoute53CIDRCollection_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v route53.CollectionSummary
 	resourceName := "aws_route53_cidr_collection.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckCIDRCollectionDestroy(ctx),
+		CheckDestroy:             testAccCheckCIDRCollectionDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCIDRCollection_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCIDRCollectionExists(ctx, resourceName, &v),
+					testAccCheckCIDRCollectionExists(ctx, t, resourceName, &v),
 					acctest.CheckFrameworkResourceDisappears(acctest.Provider, tfroute53.ResourceCIDRCollection, resourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -69,9 +67,9 @@ func TestAccRoute53CIDRCollection_disappears(t *testing.T) {
 	})
 }
 
-func testAccCheckCIDRCollectionDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckCIDRCollectionDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_route53_cidr_collection" {
@@ -95,7 +93,7 @@ func testAccCheckCIDRCollectionDestroy(ctx context.Context) resource.TestCheckFu
 	}
 }
 
-func testAccCheckCIDRCollectionExists(ctx context.Context, n string, v *route53.CollectionSummary) resource.TestCheckFunc {
+func testAccCheckCIDRCollectionExists(ctx context.Context, t *testing.T, n string, v *route53.CollectionSummary) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -106,7 +104,7 @@ func testAccCheckCIDRCollectionExists(ctx context.Context, n string, v *route53.
 			return fmt.Errorf("No Route 53 CIDR Collection ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 
 		output, err := tfroute53.FindCIDRCollectionByID(ctx, conn, rs.Primary.ID)
 
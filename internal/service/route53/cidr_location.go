@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
@@ -81,7 +82,7 @@ func (r *resourceCIDRLocation) Create(ctx context.Context, request resource.Crea
 	conn := r.Meta().Route53Conn()
 
 	collectionID := data.CIDRCollectionID.ValueString()
-	collection, err := findCIDRCollectionByID(ctx, conn, collectionID)
+	_, err := findCIDRCollectionByID(ctx, conn, collectionID)
 
 	if err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 CIDR Collection (%s)", collectionID), err.Error())
@@ -90,17 +91,9 @@ func (r *resourceCIDRLocation) Create(ctx context.Context, request resource.Crea
 	}
 
 	name := data.Name.ValueString()
-	input := &route53.ChangeCidrCollectionInput{
-		Changes: []*route53.CidrCollectionChange{{
-			Action:       aws.String(route53.CidrCollectionChangeActionPut),
-			CidrList:     flex.ExpandFrameworkStringSet(ctx, data.CIDRBlocks),
-			LocationName: aws.String(name),
-		}},
-		CollectionVersion: collection.Version,
-		Id:                aws.String(collectionID),
-	}
+	cidrBlocks := flex.ExpandFrameworkStringValueSet(ctx, data.CIDRBlocks)
 
-	_, err = conn.ChangeCidrCollectionWithContext(ctx, input)
+	err = changeCIDRLocationInBatches(ctx, conn, collectionID, name, route53.CidrCollectionChangeActionPut, cidrBlocks)
 
 	if err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("creating Route 53 CIDR Location (%s)", name), err.Error())
@@ -179,54 +172,24 @@ func (r *resourceCIDRLocation) Update(ctx context.Context, request resource.Upda
 
 	conn := r.Meta().Route53Conn()
 
-	collection, err := findCIDRCollectionByID(ctx, conn, collectionID)
-
-	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 CIDR Collection (%s)", collectionID), err.Error())
-
-		return
-	}
-
 	oldCIDRBlocks := flex.ExpandFrameworkStringValueSet(ctx, old.CIDRBlocks)
 	newCIDRBlocks := flex.ExpandFrameworkStringValueSet(ctx, new.CIDRBlocks)
 	add := newCIDRBlocks.Difference(oldCIDRBlocks)
 	del := oldCIDRBlocks.Difference(newCIDRBlocks)
-	collectionVersion := collection.Version
 
+	// Add CIDR blocks before removing any so that the location is never left empty.
 	if len(add) > 0 {
-		input := &route53.ChangeCidrCollectionInput{
-			Changes: []*route53.CidrCollectionChange{{
-				Action:       aws.String(route53.CidrCollectionChangeActionPut),
-				CidrList:     aws.StringSlice(add),
-				LocationName: aws.String(name),
-			}},
-			CollectionVersion: collectionVersion,
-			Id:                aws.String(collectionID),
-		}
-
-		_, err = conn.ChangeCidrCollectionWithContext(ctx, input)
+		err = changeCIDRLocationInBatches(ctx, conn, collectionID, name, route53.CidrCollectionChangeActionPut, add)
 
 		if err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("adding CIDR blocks to Route 53 CIDR Location (%s)", new.ID.ValueString()), err.Error())
 
 			return
 		}
-
-		collectionVersion = nil // Clear the collection version as it will have changed after the last operation.
 	}
 
 	if len(del) > 0 {
-		input := &route53.ChangeCidrCollectionInput{
-			Changes: []*route53.CidrCollectionChange{{
-				Action:       aws.String(route53.CidrCollectionChangeActionDeleteIfExists),
-				CidrList:     aws.StringSlice(del),
-				LocationName: aws.String(name),
-			}},
-			CollectionVersion: collectionVersion,
-			Id:                aws.String(collectionID),
-		}
-
-		_, err = conn.ChangeCidrCollectionWithContext(ctx, input)
+		err = changeCIDRLocationInBatches(ctx, conn, collectionID, name, route53.CidrCollectionChangeActionDeleteIfExists, del)
 
 		if err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("removing CIDR blocks from Route 53 CIDR Location (%s)", new.ID.ValueString()), err.Error())
@@ -257,10 +220,16 @@ func (r *resourceCIDRLocation) Delete(ctx context.Context, request resource.Dele
 
 	conn := r.Meta().Route53Conn()
 
-	collection, err := findCIDRCollectionByID(ctx, conn, collectionID)
+	// The API requires the CIDR blocks to be deleted to be listed explicitly.
+	// Use the location's current CIDR blocks rather than those in state, which may be stale.
+	cidrBlocks, err := findCIDRLocationByTwoPartKey(ctx, conn, collectionID, name)
+
+	if tfresource.NotFound(err) {
+		return
+	}
 
 	if err != nil {
-		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 CIDR Collection (%s)", collectionID), err.Error())
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 CIDR Location (%s)", data.ID.ValueString()), err.Error())
 
 		return
 	}
@@ -269,17 +238,11 @@ func (r *resourceCIDRLocation) Delete(ctx context.Context, request resource.Dele
 		"id": data.ID.ValueString(),
 	})
 
-	input := &route53.ChangeCidrCollectionInput{
-		Changes: []*route53.CidrCollectionChange{{
-			Action:       aws.String(route53.CidrCollectionChangeActionDeleteIfExists),
-			CidrList:     flex.ExpandFrameworkStringSet(ctx, data.CIDRBlocks),
-			LocationName: aws.String(name),
-		}},
-		CollectionVersion: collection.Version,
-		Id:                aws.String(collectionID),
-	}
+	err = changeCIDRLocationInBatches(ctx, conn, collectionID, name, route53.CidrCollectionChangeActionDeleteIfExists, cidrBlocks)
 
-	_, err = conn.ChangeCidrCollectionWithContext(ctx, input)
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchCidrCollectionException, route53.ErrCodeNoSuchCidrLocationException) {
+		return
+	}
 
 	if err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("deleting Route 53 CIDR Location (%s)", data.ID.ValueString()), err.Error())
@@ -340,6 +303,64 @@ func findCIDRLocationByTwoPartKey(ctx context.Context, conn *route53.Route53, co
 	return output, nil
 }
 
+const (
+	// cidrLocationChangeBatchMaxCIDRBlocks caps the number of CIDR blocks sent in a single ChangeCidrCollection request.
+	cidrLocationChangeBatchMaxCIDRBlocks = 100
+
+	// cidrCollectionChangeConflictTimeout bounds how long a change is retried while other changes to the same
+	// collection, e.g. from locations applied in parallel, keep moving its version.
+	cidrCollectionChangeConflictTimeout = 5 * time.Minute
+)
+
+// changeCIDRLocationInBatches applies the specified action to the CIDR blocks of a location in capped batches.
+// Each batch is submitted against the collection's current version and retried if the collection was modified concurrently.
+func changeCIDRLocationInBatches(ctx context.Context, conn *route53.Route53, collectionID, locationName, action string, cidrBlocks []string) error {
+	for _, batch := range chunkCIDRBlocks(cidrBlocks, cidrLocationChangeBatchMaxCIDRBlocks) {
+		batch := batch
+
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, cidrCollectionChangeConflictTimeout, func() (interface{}, error) {
+			collection, err := findCIDRCollectionByID(ctx, conn, collectionID)
+
+			if err != nil {
+				return nil, err
+			}
+
+			input := &route53.ChangeCidrCollectionInput{
+				Changes: []*route53.CidrCollectionChange{{
+					Action:       aws.String(action),
+					CidrList:     aws.StringSlice(batch),
+					LocationName: aws.String(locationName),
+				}},
+				CollectionVersion: collection.Version,
+				Id:                aws.String(collectionID),
+			}
+
+			return conn.ChangeCidrCollectionWithContext(ctx, input)
+		}, route53.ErrCodeCidrCollectionVersionMismatchException, route53.ErrCodeConcurrentModification)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func chunkCIDRBlocks(cidrBlocks []string, size int) [][]string {
+	var chunks [][]string
+
+	for i := 0; i < len(cidrBlocks); i += size {
+		end := i + size
+		if end > len(cidrBlocks) {
+			end = len(cidrBlocks)
+		}
+
+		chunks = append(chunks, cidrBlocks[i:end])
+	}
+
+	return chunks
+}
+
 const cidrLocationResourceIDSeparator = ","
 
 func cidrLocationCreateResourceID(collectionID, locationName string) string {
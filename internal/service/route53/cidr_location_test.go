@@ -6,11 +6,9 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/route53"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -18,19 +16,19 @@ import (
 func TestAccRoute53CIDRLocation_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_route53_cidr_location.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	locationName := sdkacctest.RandString(16)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	locationName := acctest.RandString(t, 16)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckCIDRLocationDestroy(ctx),
+		CheckDestroy:             testAccCheckCIDRLocationDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCIDRLocation_basic(rName, locationName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCIDRLocationExists(ctx, resourceName),
+					testAccCheckCIDRLocationExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "cidr_blocks.#", "2"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "cidr_blocks.*", "200.5.3.0/24"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "cidr_blocks.*", "200.6.3.0/24"),
@@ -59,19 +57,19 @@ acctest.CheckFrameworkResourceDisappears() cannot currently set top-level list/s
 func TestAccRoute53CIDRLocation_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_route53_cidr_location.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	locationName := sdkacctest.RandString(16)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	locationName := acctest.RandString(t, 16)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckCIDRLocationDestroy(ctx),
+		CheckDestroy:             testAccCheckCIDRLocationDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCIDRLocation_basic(rName, locationName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCIDRLocationExists(ctx, resourceName),
+					testAccCheckCIDRLocationExists(ctx, t, resourceName),
 					acctest.CheckFrameworkResourceDisappears(acctest.Provider, tfroute53.ResourceCIDRLocation, resourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -84,19 +82,19 @@ func TestAccRoute53CIDRLocation_disappears(t *testing.T) {
 func TestAccRoute53CIDRLocation_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_route53_cidr_location.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	locationName := sdkacctest.RandString(16)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	locationName := acctest.RandString(t, 16)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckCIDRLocationDestroy(ctx),
+		CheckDestroy:             testAccCheckCIDRLocationDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCIDRLocation_basic(rName, locationName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCIDRLocationExists(ctx, resourceName),
+					testAccCheckCIDRLocationExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "cidr_blocks.#", "2"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "cidr_blocks.*", "200.5.3.0/24"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "cidr_blocks.*", "200.6.3.0/24"),
@@ -111,7 +109,7 @@ func TestAccRoute53CIDRLocation_update(t *testing.T) {
 			{
 				Config: testAccCIDRLocation_updated(rName, locationName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckCIDRLocationExists(ctx, resourceName),
+					testAccCheckCIDRLocationExists(ctx, t, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "cidr_blocks.#", "3"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "cidr_blocks.*", "200.5.2.0/24"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "cidr_blocks.*", "200.6.3.0/24"),
@@ -123,9 +121,68 @@ func TestAccRoute53CIDRLocation_update(t *testing.T) {
 	})
 }
 
-func testAccCheckCIDRLocationDestroy(ctx context.Context) resource.TestCheckFunc {
+func TestAccRoute53CIDRLocation_manyCIDRBlocks(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_cidr_location.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	locationName := acctest.RandString(t, 16)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCIDRLocationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCIDRLocation_many(rName, locationName, 0, 250),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCIDRLocationExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cidr_blocks.#", "250"),
+				),
+			},
+			{
+				Config: testAccCIDRLocation_many(rName, locationName, 120, 230),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCIDRLocationExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cidr_blocks.#", "230"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cidr_blocks.*", "10.0.120.0/24"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cidr_blocks.*", "10.1.93.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53CIDRLocation_parallel(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCIDRLocationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCIDRLocation_parallel(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCIDRLocationExists(ctx, t, "aws_route53_cidr_location.test.0"),
+					testAccCheckCIDRLocationExists(ctx, t, "aws_route53_cidr_location.test.9"),
+				),
+			},
+			{
+				Config: testAccCIDRLocation_parallel(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_route53_cidr_collection.test", "version", "11"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCIDRLocationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_route53_cidr_location" {
@@ -155,7 +212,7 @@ func testAccCheckCIDRLocationDestroy(ctx context.Context) resource.TestCheckFunc
 	}
 }
 
-func testAccCheckCIDRLocationExists(ctx context.Context, n string) resource.TestCheckFunc {
+func testAccCheckCIDRLocationExists(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -172,7 +229,7 @@ func testAccCheckCIDRLocationExists(ctx context.Context, n string) resource.Test
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 
 		_, err = tfroute53.FindCIDRLocationByTwoPartKey(ctx, conn, collectionID, name)
 
@@ -207,3 +264,33 @@ resource "aws_route53_cidr_location" "test" {
 }
 `, rName, locationName)
 }
+
+func testAccCIDRLocation_many(rName, locationName string, start, count int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_cidr_collection" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_cidr_location" "test" {
+  cidr_collection_id = aws_route53_cidr_collection.test.id
+  name               = %[2]q
+  cidr_blocks        = [for i in range(%[3]d, %[3]d + %[4]d) : "10.${floor(i / 256)}.${i %% 256}.0/24"]
+}
+`, rName, locationName, start, count)
+}
+
+func testAccCIDRLocation_parallel(rName string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_cidr_collection" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_cidr_location" "test" {
+  count = %[2]d
+
+  cidr_collection_id = aws_route53_cidr_collection.test.id
+  name               = "location${count.index}"
+  cidr_blocks        = ["10.${count.index}.0.0/16"]
+}
+`, rName, count)
+}
@@ -0,0 +1,142 @@
+package route53
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkDataSource
+func newDataSourceCIDRLocations(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &dataSourceCIDRLocations{}
+
+	return d, nil
+}
+
+type dataSourceCIDRLocations struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceCIDRLocations) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_route53_cidr_locations"
+}
+
+func (d *dataSourceCIDRLocations) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cidr_collection_id": schema.StringAttribute{
+				Required: true,
+			},
+			"id": framework.IDAttribute(),
+			"locations": schema.ListAttribute{
+				ElementType: types.ObjectType{
+					AttrTypes: cidrLocationAttrTypes,
+				},
+				Computed: true,
+			},
+			"names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceCIDRLocations) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceCIDRLocationsData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().Route53Conn()
+
+	collectionID := data.CIDRCollectionID.ValueString()
+	names, err := findCIDRLocationNamesByCollectionID(ctx, conn, collectionID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing Route 53 CIDR Locations (%s)", collectionID), err.Error())
+
+		return
+	}
+
+	elementType := types.ObjectType{AttrTypes: cidrLocationAttrTypes}
+	var elements []attr.Value
+
+	for _, name := range names {
+		cidrBlocks, err := findCIDRLocationByTwoPartKey(ctx, conn, collectionID, name)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 CIDR Location (%s)", cidrLocationCreateResourceID(collectionID, name)), err.Error())
+
+			return
+		}
+
+		elements = append(elements, types.ObjectValueMust(cidrLocationAttrTypes, map[string]attr.Value{
+			"cidr_blocks": flex.FlattenFrameworkStringValueSet(ctx, cidrBlocks),
+			"name":        types.StringValue(name),
+		}))
+	}
+
+	data.ID = types.StringValue(collectionID)
+	data.Locations = types.ListValueMust(elementType, elements)
+	data.Names = flex.FlattenFrameworkStringValueList(ctx, names)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+var cidrLocationAttrTypes = map[string]attr.Type{
+	"cidr_blocks": types.SetType{ElemType: types.StringType},
+	"name":        types.StringType,
+}
+
+type dataSourceCIDRLocationsData struct {
+	CIDRCollectionID types.String `tfsdk:"cidr_collection_id"`
+	ID               types.String `tfsdk:"id"`
+	Locations        types.List   `tfsdk:"locations"`
+	Names            types.List   `tfsdk:"names"`
+}
+
+func findCIDRLocationNamesByCollectionID(ctx context.Context, conn *route53.Route53, collectionID string) ([]string, error) {
+	input := &route53.ListCidrLocationsInput{
+		CollectionId: aws.String(collectionID),
+	}
+	var output []string
+
+	err := conn.ListCidrLocationsPagesWithContext(ctx, input, func(page *route53.ListCidrLocationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CidrLocations {
+			if v == nil {
+				continue
+			}
+
+			output = append(output, aws.StringValue(v.LocationName))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
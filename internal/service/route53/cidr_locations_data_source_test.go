@@ -0,0 +1,71 @@
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53CIDRLocationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	locationName1 := acctest.RandString(t, 16)
+	locationName2 := acctest.RandString(t, 16)
+	dataSourceName := "data.aws_route53_cidr_locations.test"
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCIDRLocationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCIDRLocationsDataSourceConfig_basic(rName, locationName1, locationName2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "aws_route53_cidr_collection.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "locations.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "locations.*", map[string]string{
+						"cidr_blocks.#": "2",
+						"name":          locationName1,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "locations.*", map[string]string{
+						"cidr_blocks.#": "1",
+						"name":          locationName2,
+					}),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "names.*", locationName1),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "names.*", locationName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCIDRLocationsDataSourceConfig_basic(rName, locationName1, locationName2 string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_cidr_collection" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_cidr_location" "test1" {
+  cidr_collection_id = aws_route53_cidr_collection.test.id
+  name               = %[2]q
+  cidr_blocks        = ["200.5.3.0/24", "200.6.3.0/24"]
+}
+
+resource "aws_route53_cidr_location" "test2" {
+  cidr_collection_id = aws_route53_cidr_collection.test.id
+  name               = %[3]q
+  cidr_blocks        = ["200.7.3.0/24"]
+}
+
+data "aws_route53_cidr_locations" "test" {
+  cidr_collection_id = aws_route53_cidr_collection.test.id
+
+  depends_on = [aws_route53_cidr_location.test1, aws_route53_cidr_location.test2]
+}
+`, rName, locationName1, locationName2)
+}
@@ -31,6 +31,11 @@ func ResourceDelegationSet() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"hosted_zone_ids": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
 			"reference_name": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -98,6 +103,18 @@ func resourceDelegationSetRead(ctx context.Context, d *schema.ResourceData, meta
 	}.String()
 	d.Set("arn", arn)
 
+	hostedZones, err := FindHostedZonesByDelegationSetID(ctx, r53, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Route 53 Hosted Zones using Reusable Delegation Set (%s): %s", d.Id(), err)
+	}
+
+	var hostedZoneIDs []string
+	for _, v := range hostedZones {
+		hostedZoneIDs = append(hostedZoneIDs, CleanZoneID(aws.StringValue(v.Id)))
+	}
+	d.Set("hosted_zone_ids", hostedZoneIDs)
+
 	return diags
 }
 
@@ -113,6 +130,17 @@ func resourceDelegationSetDelete(ctx context.Context, d *schema.ResourceData, me
 		return diags
 	}
 
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeDelegationSetInUse) {
+		if hostedZones, findErr := FindHostedZonesByDelegationSetID(ctx, r53, d.Id()); findErr == nil && len(hostedZones) > 0 {
+			var hostedZoneIDs []string
+			for _, v := range hostedZones {
+				hostedZoneIDs = append(hostedZoneIDs, CleanZoneID(aws.StringValue(v.Id)))
+			}
+
+			return sdkdiag.AppendErrorf(diags, "deleting Route53 Reusable Delegation Set (%s): still used by Hosted Zones %s: %s", d.Id(), strings.Join(hostedZoneIDs, ", "), err)
+		}
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting Route53 Reusable Delegation Set (%s): %s", d.Id(), err)
 	}
@@ -9,29 +9,27 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 )
 
 func TestAccRoute53DelegationSet_basic(t *testing.T) {
 	ctx := acctest.Context(t)
-	refName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	refName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_route53_delegation_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckDelegationSetDestroy(ctx),
+		CheckDestroy:             testAccCheckDelegationSetDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDelegationSetConfig_basic(refName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDelegationSetExists(ctx, resourceName),
+					testAccCheckDelegationSetExists(ctx, t, resourceName),
 					acctest.MatchResourceAttrGlobalARNNoAccount(resourceName, "arn", "route53", regexp.MustCompile("delegationset/.+")),
 				),
 			},
@@ -49,7 +47,7 @@ func TestAccRoute53DelegationSet_withZones(t *testing.T) {
 	ctx := acctest.Context(t)
 	var zone route53.GetHostedZoneOutput
 
-	refName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	refName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_route53_delegation_set.test"
 	primaryZoneResourceName := "aws_route53_zone.primary"
 	secondaryZoneResourceName := "aws_route53_zone.secondary"
@@ -58,20 +56,28 @@ func TestAccRoute53DelegationSet_withZones(t *testing.T) {
 	zoneName1 := fmt.Sprintf("primary.%s", domain)
 	zoneName2 := fmt.Sprintf("secondary.%s", domain)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckDelegationSetDestroy(ctx),
+		CheckDestroy:             testAccCheckDelegationSetDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDelegationSetConfig_zones(refName, zoneName1, zoneName2),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDelegationSetExists(ctx, resourceName),
+					testAccCheckDelegationSetExists(ctx, t, resourceName),
 					testAccCheckZoneExists(ctx, primaryZoneResourceName, &zone),
 					testAccCheckZoneExists(ctx, secondaryZoneResourceName, &zone),
-					testAccCheckNameServersMatch(ctx, resourceName, primaryZoneResourceName),
-					testAccCheckNameServersMatch(ctx, resourceName, secondaryZoneResourceName),
+					testAccCheckNameServersMatch(ctx, t, resourceName, primaryZoneResourceName),
+					testAccCheckNameServersMatch(ctx, t, resourceName, secondaryZoneResourceName),
+				),
+			},
+			{
+				Config: testAccDelegationSetConfig_zones(refName, zoneName1, zoneName2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hosted_zone_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "hosted_zone_ids.*", primaryZoneResourceName, "zone_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "hosted_zone_ids.*", secondaryZoneResourceName, "zone_id"),
 				),
 			},
 			{
@@ -84,21 +90,47 @@ func TestAccRoute53DelegationSet_withZones(t *testing.T) {
 	})
 }
 
+func TestAccRoute53DelegationSet_duplicateZoneName(t *testing.T) {
+	ctx := acctest.Context(t)
+	refName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_route53_delegation_set.test"
+	zoneName := acctest.RandomDomainName()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDelegationSetDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDelegationSetConfig_zone(refName, zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDelegationSetExists(ctx, t, resourceName),
+				),
+			},
+			{
+				Config:      testAccDelegationSetConfig_duplicateZoneName(refName, zoneName),
+				ExpectError: regexp.MustCompile(`is already used by Hosted Zone`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53DelegationSet_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
-	refName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	refName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_route53_delegation_set.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckDelegationSetDestroy(ctx),
+		CheckDestroy:             testAccCheckDelegationSetDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDelegationSetConfig_basic(refName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDelegationSetExists(ctx, resourceName),
+					testAccCheckDelegationSetExists(ctx, t, resourceName),
 					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfroute53.ResourceDelegationSet(), resourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -107,9 +139,9 @@ func TestAccRoute53DelegationSet_disappears(t *testing.T) {
 	})
 }
 
-func testAccCheckDelegationSetDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckDelegationSetDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_route53_delegation_set" {
 				continue
@@ -124,9 +156,9 @@ func testAccCheckDelegationSetDestroy(ctx context.Context) resource.TestCheckFun
 	}
 }
 
-func testAccCheckDelegationSetExists(ctx context.Context, n string) resource.TestCheckFunc {
+func testAccCheckDelegationSetExists(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
 			return fmt.Errorf("Not found: %s", n)
@@ -153,9 +185,9 @@ func testAccCheckDelegationSetExists(ctx context.Context, n string) resource.Tes
 	}
 }
 
-func testAccCheckNameServersMatch(ctx context.Context, delegationSetName, zoneName string) resource.TestCheckFunc {
+func testAccCheckNameServersMatch(ctx context.Context, t *testing.T, delegationSetName, zoneName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 
 		delegationSetLocal, ok := s.RootModule().Resources[delegationSetName]
 		if !ok {
@@ -213,3 +245,25 @@ resource "aws_route53_zone" "secondary" {
 }
 `, refName, zoneName1, zoneName2)
 }
+
+func testAccDelegationSetConfig_zone(refName, zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_delegation_set" "test" {
+  reference_name = %[1]q
+}
+
+resource "aws_route53_zone" "primary" {
+  name              = %[2]q
+  delegation_set_id = aws_route53_delegation_set.test.id
+}
+`, refName, zoneName)
+}
+
+func testAccDelegationSetConfig_duplicateZoneName(refName, zoneName string) string {
+	return acctest.ConfigCompose(testAccDelegationSetConfig_zone(refName, zoneName), fmt.Sprintf(`
+resource "aws_route53_zone" "duplicate" {
+  name              = %[1]q
+  delegation_set_id = aws_route53_delegation_set.test.id
+}
+`, zoneName))
+}
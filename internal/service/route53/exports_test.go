@@ -2,9 +2,15 @@ package route53
 
 // Exports for use in tests only.
 var (
-	CIDRLocationParseResourceID  = cidrLocationParseResourceID
-	FindCIDRCollectionByID       = findCIDRCollectionByID
-	FindCIDRLocationByTwoPartKey = findCIDRLocationByTwoPartKey
-	ResourceCIDRCollection       = newResourceCIDRCollection
-	ResourceCIDRLocation         = newResourceCIDRLocation
+	CIDRLocationParseResourceID               = cidrLocationParseResourceID
+	ChunkChanges                              = chunkChanges
+	FindCIDRCollectionByID                    = findCIDRCollectionByID
+	FindCIDRLocationByTwoPartKey              = findCIDRLocationByTwoPartKey
+	FindResourceRecordSetsByZoneID            = findResourceRecordSetsByZoneID
+	FlattenRecordsResourceRecordSetsForImport = flattenRecordsResourceRecordSetsForImport
+	HealthCheckReferenceName                  = healthCheckReferenceName
+	RecordsChanges                            = recordsChanges
+	ResourceCIDRCollection                    = newResourceCIDRCollection
+	ResourceCIDRLocation                      = newResourceCIDRLocation
+	ValidRecordAliasTarget                    = validRecordAliasTarget
 )
@@ -3,6 +3,7 @@ package route53
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
@@ -36,6 +37,31 @@ func FindHealthCheckByID(ctx context.Context, conn *route53.Route53, id string)
 	return output.HealthCheck, nil
 }
 
+func FindHealthCheckStatusByID(ctx context.Context, conn *route53.Route53, id string) ([]*route53.HealthCheckObservation, error) {
+	input := &route53.GetHealthCheckStatusInput{
+		HealthCheckId: aws.String(id),
+	}
+
+	output, err := conn.GetHealthCheckStatusWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHealthCheck) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.HealthCheckObservations, nil
+}
+
 func FindHostedZoneByID(ctx context.Context, conn *route53.Route53, id string) (*route53.GetHostedZoneOutput, error) {
 	input := &route53.GetHostedZoneInput{
 		Id: aws.String(id),
@@ -61,6 +87,115 @@ func FindHostedZoneByID(ctx context.Context, conn *route53.Route53, id string) (
 	return output, nil
 }
 
+// FindHostedZonesByName returns all public and private hosted zones with the specified name.
+func FindHostedZonesByName(ctx context.Context, conn *route53.Route53, name string) ([]*route53.HostedZone, error) {
+	name = strings.ToLower(FQDN(name))
+	input := &route53.ListHostedZonesByNameInput{
+		DNSName: aws.String(name),
+	}
+	var output []*route53.HostedZone
+
+	for {
+		page, err := conn.ListHostedZonesByNameWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Hosted zones are returned in name order, starting at the requested name.
+		for _, v := range page.HostedZones {
+			if v == nil {
+				continue
+			}
+
+			if strings.ToLower(aws.StringValue(v.Name)) != name {
+				return output, nil
+			}
+
+			output = append(output, v)
+		}
+
+		if !aws.BoolValue(page.IsTruncated) {
+			break
+		}
+
+		input.DNSName = page.NextDNSName
+		input.HostedZoneId = page.NextHostedZoneId
+	}
+
+	return output, nil
+}
+
+func FindVPCAssociationAuthorizationsByZoneID(ctx context.Context, conn *route53.Route53, zoneID string) ([]*route53.VPC, error) {
+	input := &route53.ListVPCAssociationAuthorizationsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	var output []*route53.VPC
+
+	for {
+		page, err := conn.ListVPCAssociationAuthorizationsWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+			return nil, &resource.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.VPCs {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func FindHostedZonesByDelegationSetID(ctx context.Context, conn *route53.Route53, delegationSetID string) ([]*route53.HostedZone, error) {
+	input := &route53.ListHostedZonesInput{
+		DelegationSetId: aws.String(delegationSetID),
+	}
+	var output []*route53.HostedZone
+
+	err := conn.ListHostedZonesPagesWithContext(ctx, input, func(page *route53.ListHostedZonesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.HostedZones {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchDelegationSet) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindHostedZoneDNSSEC(ctx context.Context, conn *route53.Route53, hostedZoneID string) (*route53.GetDNSSECOutput, error) {
 	input := &route53.GetDNSSECInput{
 		HostedZoneId: aws.String(hostedZoneID),
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -129,9 +130,10 @@ func ResourceHealthCheck() *schema.Resource {
 			},
 
 			"insufficient_data_health_status": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringInSlice(route53.InsufficientDataHealthStatus_Values(), true),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringInSlice(route53.InsufficientDataHealthStatus_Values(), true),
+				DiffSuppressFunc: verify.SuppressEquivalentStringCaseInsensitive,
 			},
 			"reference_name": {
 				Type:     schema.TypeString,
@@ -187,8 +189,31 @@ func ResourceHealthCheck() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceHealthCheckCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceHealthCheckCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	healthCheckType := strings.ToUpper(diff.Get("type").(string))
+	routingControlARN := diff.Get("routing_control_arn").(string)
+
+	// Unknown values are validated by the API.
+	if !diff.NewValueKnown("type") || !diff.NewValueKnown("routing_control_arn") {
+		return nil
 	}
+
+	if healthCheckType == route53.HealthCheckTypeRecoveryControl && routingControlARN == "" {
+		return fmt.Errorf(`"routing_control_arn" is required when "type" is %q`, route53.HealthCheckTypeRecoveryControl)
+	}
+
+	if healthCheckType != route53.HealthCheckTypeRecoveryControl && routingControlARN != "" {
+		return fmt.Errorf(`"routing_control_arn" can only be set when "type" is %q`, route53.HealthCheckTypeRecoveryControl)
+	}
+
+	return nil
 }
 
 func resourceHealthCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -338,6 +363,7 @@ func resourceHealthCheckRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("invert_healthcheck", healthCheckConfig.Inverted)
 	d.Set("disabled", healthCheckConfig.Disabled)
 	d.Set("routing_control_arn", healthCheckConfig.RoutingControlArn)
+	d.Set("reference_name", healthCheckReferenceName(aws.StringValue(output.CallerReference)))
 
 	if err := d.Set("child_healthchecks", flex.FlattenStringList(healthCheckConfig.ChildHealthChecks)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting child_healthchecks: %s", err)
@@ -410,11 +436,15 @@ func resourceHealthCheckUpdate(ctx context.Context, d *schema.ResourceData, meta
 			updateHealthCheck.Inverted = aws.Bool(d.Get("invert_healthcheck").(bool))
 		}
 
-		if d.HasChange("child_healthchecks") {
-			updateHealthCheck.ChildHealthChecks = flex.ExpandStringSet(d.Get("child_healthchecks").(*schema.Set))
-		}
+		// All child health check additions and removals are sent as one batch together with the
+		// threshold, which must never exceed the number of child health checks.
+		if d.HasChanges("child_healthchecks", "child_health_threshold") {
+			if v := d.Get("child_healthchecks").(*schema.Set); v.Len() > 0 {
+				updateHealthCheck.ChildHealthChecks = flex.ExpandStringSet(v)
+			} else if d.HasChange("child_healthchecks") {
+				updateHealthCheck.ResetElements = append(updateHealthCheck.ResetElements, aws.String(route53.ResettableElementNameChildHealthChecks))
+			}
 
-		if d.HasChange("child_health_threshold") {
 			updateHealthCheck.HealthThreshold = aws.Int64(int64(d.Get("child_health_threshold").(int)))
 		}
 
@@ -440,7 +470,11 @@ func resourceHealthCheckUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if d.HasChange("regions") {
-			updateHealthCheck.Regions = flex.ExpandStringSet(d.Get("regions").(*schema.Set))
+			if v := d.Get("regions").(*schema.Set); v.Len() > 0 {
+				updateHealthCheck.Regions = flex.ExpandStringSet(v)
+			} else {
+				updateHealthCheck.ResetElements = append(updateHealthCheck.ResetElements, aws.String(route53.ResettableElementNameRegions))
+			}
 		}
 
 		if d.HasChange("disabled") {
@@ -469,6 +503,24 @@ func resourceHealthCheckUpdate(ctx context.Context, d *schema.ResourceData, meta
 	return append(diags, resourceHealthCheckRead(ctx, d, meta)...)
 }
 
+// healthCheckReferenceName returns the reference_name that was used to build a health check's caller reference.
+// Caller references generated by Terraform have the form "<reference_name>-terraform-<suffix>".
+func healthCheckReferenceName(callerReference string) string {
+	suffixLength := len(resource.UniqueIdPrefix) + resource.UniqueIDSuffixLength
+
+	if len(callerReference) <= suffixLength+1 {
+		return ""
+	}
+
+	i := len(callerReference) - suffixLength
+
+	if !strings.HasPrefix(callerReference[i:], resource.UniqueIdPrefix) || callerReference[i-1] != '-' {
+		return ""
+	}
+
+	return callerReference[:i-1]
+}
+
 func resourceHealthCheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53Conn()
@@ -0,0 +1,118 @@
+package route53
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceHealthCheckStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceHealthCheckStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"health_check_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"healthy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"healthy_observation_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"observations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"checked_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceHealthCheckStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	id := d.Get("health_check_id").(string)
+	observations, err := FindHealthCheckStatusByID(ctx, conn, id)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route53 Health Check (%s) status: %s", id, err)
+	}
+
+	var healthyCount int
+	tfList := make([]interface{}, 0, len(observations))
+
+	for _, v := range observations {
+		if v == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"ip_address": aws.StringValue(v.IPAddress),
+			"region":     aws.StringValue(v.Region),
+		}
+
+		if report := v.StatusReport; report != nil {
+			if report.CheckedTime != nil {
+				tfMap["checked_time"] = aws.TimeValue(report.CheckedTime).Format(time.RFC3339)
+			}
+
+			status := aws.StringValue(report.Status)
+			tfMap["status"] = status
+
+			if healthCheckObservationHealthy(status) {
+				healthyCount++
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(id)
+	// Route 53 considers an endpoint healthy if more than 18% of the health checkers report it healthy.
+	d.Set("healthy", len(tfList) > 0 && float64(healthyCount) > 0.18*float64(len(tfList)))
+	d.Set("healthy_observation_count", healthyCount)
+
+	if err := d.Set("observations", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting observations: %s", err)
+	}
+
+	return diags
+}
+
+// healthCheckObservationHealthy returns whether a health checker status report,
+// e.g. "Success: HTTP Status Code 200, OK", describes a healthy endpoint.
+func healthCheckObservationHealthy(status string) bool {
+	return strings.HasPrefix(status, "Success")
+}
@@ -7,32 +7,52 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/route53"
 	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestHealthCheckReferenceName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		CallerReference string
+		Expected        string
+	}{
+		{CallerReference: "", Expected: ""},
+		{CallerReference: "terraform-20190122200019880700000001", Expected: ""},
+		{CallerReference: "example-terraform-20190122200019880700000001", Expected: "example"},
+		{CallerReference: "my-check-terraform-20190122200019880700000001", Expected: "my-check"},
+		{CallerReference: "created-outside-terraform", Expected: ""},
+	}
+
+	for _, testCase := range testCases {
+		if got := tfroute53.HealthCheckReferenceName(testCase.CallerReference); got != testCase.Expected {
+			t.Errorf("HealthCheckReferenceName(%q) = %q, expected %q", testCase.CallerReference, got, testCase.Expected)
+		}
+	}
+}
+
 func TestAccRoute53HealthCheck_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check route53.HealthCheck
 	resourceName := "aws_route53_health_check.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHealthCheckConfig_basic("2", true),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					acctest.MatchResourceAttrGlobalARNNoAccount(resourceName, "arn", "route53", regexp.MustCompile("healthcheck/.+")),
 					resource.TestCheckResourceAttr(resourceName, "measure_latency", "true"),
 					resource.TestCheckResourceAttr(resourceName, "port", "80"),
@@ -49,7 +69,7 @@ func TestAccRoute53HealthCheck_basic(t *testing.T) {
 			{
 				Config: testAccHealthCheckConfig_basic("5", false),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "failure_threshold", "5"),
 					resource.TestCheckResourceAttr(resourceName, "invert_healthcheck", "false"),
 				),
@@ -62,16 +82,16 @@ func TestAccRoute53HealthCheck_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check route53.HealthCheck
 	resourceName := "aws_route53_health_check.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHealthCheckConfig_tags1("key1", "value1"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
 				),
@@ -84,7 +104,7 @@ func TestAccRoute53HealthCheck_tags(t *testing.T) {
 			{
 				Config: testAccHealthCheckConfig_tags2("key1", "value1updated", "key2", "value2"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
@@ -93,7 +113,7 @@ func TestAccRoute53HealthCheck_tags(t *testing.T) {
 			{
 				Config: testAccHealthCheckConfig_tags1("key2", "value2"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
 				),
@@ -106,16 +126,16 @@ func TestAccRoute53HealthCheck_withSearchString(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check route53.HealthCheck
 	resourceName := "aws_route53_health_check.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHealthCheckConfig_searchString("OK", false),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "invert_healthcheck", "false"),
 					resource.TestCheckResourceAttr(resourceName, "search_string", "OK"),
 				),
@@ -128,7 +148,7 @@ func TestAccRoute53HealthCheck_withSearchString(t *testing.T) {
 			{
 				Config: testAccHealthCheckConfig_searchString("FAILED", true),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "invert_healthcheck", "true"),
 					resource.TestCheckResourceAttr(resourceName, "search_string", "FAILED"),
 				),
@@ -141,16 +161,16 @@ func TestAccRoute53HealthCheck_withChildHealthChecks(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check route53.HealthCheck
 	resourceName := "aws_route53_health_check.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHealthCheckConfig_childs,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 				),
 			},
 			{
@@ -162,20 +182,58 @@ func TestAccRoute53HealthCheck_withChildHealthChecks(t *testing.T) {
 	})
 }
 
+func TestAccRoute53HealthCheck_updateChildHealthChecks(t *testing.T) {
+	ctx := acctest.Context(t)
+	var check route53.HealthCheck
+	resourceName := "aws_route53_health_check.test"
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHealthCheckConfig_childCount(2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
+					resource.TestCheckResourceAttr(resourceName, "child_healthchecks.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "child_health_threshold", "2"),
+				),
+			},
+			{
+				Config: testAccHealthCheckConfig_childCount(3, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
+					resource.TestCheckResourceAttr(resourceName, "child_healthchecks.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "child_health_threshold", "1"),
+				),
+			},
+			{
+				Config: testAccHealthCheckConfig_childCount(0, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
+					resource.TestCheckResourceAttr(resourceName, "child_healthchecks.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "child_health_threshold", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoute53HealthCheck_withHealthCheckRegions(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check route53.HealthCheck
 	resourceName := "aws_route53_health_check.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartition(t, endpoints.AwsPartitionID) }, // GovCloud has 2 regions, test requires 3
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHealthCheckConfig_regions(endpoints.UsWest2RegionID, endpoints.UsEast1RegionID, endpoints.EuWest1RegionID),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "regions.#", "3"),
 				),
 			},
@@ -192,16 +250,16 @@ func TestAccRoute53HealthCheck_ip(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check route53.HealthCheck
 	resourceName := "aws_route53_health_check.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHealthCheckConfig_ip("1.2.3.4"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "ip_address", "1.2.3.4"),
 				),
 			},
@@ -213,7 +271,7 @@ func TestAccRoute53HealthCheck_ip(t *testing.T) {
 			{
 				Config: testAccHealthCheckConfig_ip("1.2.3.5"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "ip_address", "1.2.3.5"),
 				),
 			},
@@ -225,16 +283,16 @@ func TestAccRoute53HealthCheck_ipv6(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check route53.HealthCheck
 	resourceName := "aws_route53_health_check.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHealthCheckConfig_ip("1234:5678:9abc:6811:0:0:0:4"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "ip_address", "1234:5678:9abc:6811:0:0:0:4"),
 				),
 			},
@@ -255,16 +313,16 @@ func TestAccRoute53HealthCheck_cloudWatchAlarmCheck(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check route53.HealthCheck
 	resourceName := "aws_route53_health_check.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHealthCheckConfig_cloudWatchAlarm,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "cloudwatch_alarm_name", "cloudwatch-healthcheck-alarm"),
 				),
 			},
@@ -277,20 +335,54 @@ func TestAccRoute53HealthCheck_cloudWatchAlarmCheck(t *testing.T) {
 	})
 }
 
+func TestAccRoute53HealthCheck_insufficientDataHealthStatus(t *testing.T) {
+	ctx := acctest.Context(t)
+	var check1, check2 route53.HealthCheck
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_route53_health_check.test"
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHealthCheckConfig_insufficientDataHealthStatus(rName, "Healthy"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check1),
+					resource.TestCheckResourceAttr(resourceName, "insufficient_data_health_status", "Healthy"),
+				),
+			},
+			{
+				Config: testAccHealthCheckConfig_insufficientDataHealthStatus(rName, "LastKnownStatus"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check2),
+					testAccCheckHealthCheckNotRecreated(&check1, &check2),
+					resource.TestCheckResourceAttr(resourceName, "insufficient_data_health_status", "LastKnownStatus"),
+				),
+			},
+			{
+				Config:   testAccHealthCheckConfig_insufficientDataHealthStatus(rName, "lastknownstatus"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccRoute53HealthCheck_withSNI(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check route53.HealthCheck
 	resourceName := "aws_route53_health_check.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHealthCheckConfig_noSNI,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "enable_sni", "true"),
 				),
 			},
@@ -302,14 +394,14 @@ func TestAccRoute53HealthCheck_withSNI(t *testing.T) {
 			{
 				Config: testAccHealthCheckConfig_sni(false),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "enable_sni", "false"),
 				),
 			},
 			{
 				Config: testAccHealthCheckConfig_sni(true),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "enable_sni", "true"),
 				),
 			},
@@ -322,16 +414,16 @@ func TestAccRoute53HealthCheck_disabled(t *testing.T) {
 	var check route53.HealthCheck
 	resourceName := "aws_route53_health_check.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHealthCheckConfig_disabled(true),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
 				),
 			},
@@ -343,14 +435,14 @@ func TestAccRoute53HealthCheck_disabled(t *testing.T) {
 			{
 				Config: testAccHealthCheckConfig_disabled(false),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
 				),
 			},
 			{
 				Config: testAccHealthCheckConfig_disabled(true),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
 				),
 			},
@@ -361,19 +453,21 @@ func TestAccRoute53HealthCheck_disabled(t *testing.T) {
 func TestAccRoute53HealthCheck_withRoutingControlARN(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check route53.HealthCheck
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_route53_health_check.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(r53rcc.EndpointsID, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHealthCheckConfig_routingControlARN(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					resource.TestCheckResourceAttr(resourceName, "type", "RECOVERY_CONTROL"),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_control_arn", "aws_route53recoverycontrolconfig_routing_control.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "reference_name", "arc"),
 				),
 			},
 			{
@@ -385,20 +479,35 @@ func TestAccRoute53HealthCheck_withRoutingControlARN(t *testing.T) {
 	})
 }
 
+func TestAccRoute53HealthCheck_routingControlARNRequired(t *testing.T) {
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHealthCheckConfig_routingControlARNMissing,
+				ExpectError: regexp.MustCompile(`"routing_control_arn" is required`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53HealthCheck_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var check route53.HealthCheck
 	resourceName := "aws_route53_health_check.test"
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx),
+		CheckDestroy:             testAccCheckHealthCheckDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccHealthCheckConfig_basic("2", true),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckHealthCheckExists(ctx, resourceName, &check),
+					testAccCheckHealthCheckExists(ctx, t, resourceName, &check),
 					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfroute53.ResourceHealthCheck(), resourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -407,9 +516,9 @@ func TestAccRoute53HealthCheck_disappears(t *testing.T) {
 	})
 }
 
-func testAccCheckHealthCheckDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckHealthCheckDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_route53_health_check" {
@@ -433,7 +542,7 @@ func testAccCheckHealthCheckDestroy(ctx context.Context) resource.TestCheckFunc
 	}
 }
 
-func testAccCheckHealthCheckExists(ctx context.Context, n string, v *route53.HealthCheck) resource.TestCheckFunc {
+func testAccCheckHealthCheckExists(ctx context.Context, t *testing.T, n string, v *route53.HealthCheck) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -444,7 +553,7 @@ func testAccCheckHealthCheckExists(ctx context.Context, n string, v *route53.Hea
 			return fmt.Errorf("No Route53 Health Check ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 
 		output, err := tfroute53.FindHealthCheckByID(ctx, conn, rs.Primary.ID)
 
@@ -458,6 +567,16 @@ func testAccCheckHealthCheckExists(ctx context.Context, n string, v *route53.Hea
 	}
 }
 
+func testAccCheckHealthCheckNotRecreated(before, after *route53.HealthCheck) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.Id), aws.StringValue(after.Id); before != after {
+			return fmt.Errorf("Route53 Health Check (%s) recreated (%s)", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccHealthCheckConfig_basic(thershold string, invert bool) string {
 	return fmt.Sprintf(`
 resource "aws_route53_health_check" "test" {
@@ -550,6 +669,27 @@ resource "aws_route53_health_check" "test" {
 }
 `
 
+func testAccHealthCheckConfig_childCount(count, threshold int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_health_check" "child" {
+  count = %[1]d
+
+  fqdn              = "child${count.index}.example.com"
+  port              = 80
+  type              = "HTTP"
+  resource_path     = "/"
+  failure_threshold = "2"
+  request_interval  = "30"
+}
+
+resource "aws_route53_health_check" "test" {
+  type                   = "CALCULATED"
+  child_health_threshold = %[2]d
+  child_healthchecks     = aws_route53_health_check.child[*].id
+}
+`, count, threshold)
+}
+
 func testAccHealthCheckConfig_regions(regions ...string) string {
 	return fmt.Sprintf(`
 resource "aws_route53_health_check" "test" {
@@ -592,6 +732,30 @@ resource "aws_route53_health_check" "test" {
 }
 `
 
+func testAccHealthCheckConfig_insufficientDataHealthStatus(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = "2"
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/EC2"
+  period              = "120"
+  statistic           = "Average"
+  threshold           = "80"
+}
+
+data "aws_region" "current" {}
+
+resource "aws_route53_health_check" "test" {
+  type                            = "CLOUDWATCH_METRIC"
+  cloudwatch_alarm_name           = aws_cloudwatch_metric_alarm.test.alarm_name
+  cloudwatch_alarm_region         = data.aws_region.current.name
+  insufficient_data_health_status = %[2]q
+}
+`, rName, status)
+}
+
 func testAccHealthCheckConfig_searchString(search string, invert bool) string {
 	return fmt.Sprintf(`
 resource "aws_route53_health_check" "test" {
@@ -675,6 +839,13 @@ resource "aws_route53recoverycontrolconfig_routing_control" "test" {
 resource "aws_route53_health_check" "test" {
   type                = "RECOVERY_CONTROL"
   routing_control_arn = aws_route53recoverycontrolconfig_routing_control.test.arn
+  reference_name      = "arc"
 }
 `, rName)
 }
+
+const testAccHealthCheckConfig_routingControlARNMissing = `
+resource "aws_route53_health_check" "test" {
+  type = "RECOVERY_CONTROL"
+}
+`
@@ -4,15 +4,18 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceHostedZoneDNSSEC() *schema.Resource {
@@ -27,11 +30,32 @@ func ResourceHostedZoneDNSSEC() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"ds_record": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"hosted_zone_id": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"key_management_service_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"key_signing_key_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 128),
+					validation.StringMatch(regexp.MustCompile("^[a-zA-Z0-9._-]+$"), "must contain only alphanumeric characters, periods, underscores, or hyphens"),
+				),
+				RequiredWith: []string{"key_management_service_arn"},
+			},
 			"signing_status": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -54,6 +78,20 @@ func resourceHostedZoneDNSSECCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(hostedZoneID)
 
+	// Bootstrap an active key-signing key so that signing can be enabled.
+	if v, ok := d.GetOk("key_management_service_arn"); ok {
+		name := d.Get("key_signing_key_name").(string)
+		if name == "" {
+			name = resource.PrefixedUniqueId("tf-ksk-")
+		}
+
+		if err := hostedZoneDNSSECCreateKeySigningKey(ctx, conn, hostedZoneID, name, v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Route 53 Hosted Zone DNSSEC (%s) Key Signing Key (%s): %s", d.Id(), name, err)
+		}
+
+		d.Set("key_signing_key_name", name)
+	}
+
 	switch signingStatus {
 	default:
 		return sdkdiag.AppendErrorf(diags, "updating Route 53 Hosted Zone DNSSEC (%s) signing status: unknown status (%s)", d.Id(), signingStatus)
@@ -112,6 +150,29 @@ func resourceHostedZoneDNSSECRead(ctx context.Context, d *schema.ResourceData, m
 		d.Set("signing_status", hostedZoneDnssec.Status.ServeSignature)
 	}
 
+	if name := d.Get("key_signing_key_name").(string); name != "" {
+		var ksk *route53.KeySigningKey
+
+		for _, v := range hostedZoneDnssec.KeySigningKeys {
+			if aws.StringValue(v.Name) == name {
+				ksk = v
+				break
+			}
+		}
+
+		// A missing managed key-signing key clears the KMS key ARN so that the resource is recreated.
+		if ksk == nil {
+			log.Printf("[WARN] Route 53 Hosted Zone DNSSEC (%s) Key Signing Key (%s) not found", d.Id(), name)
+			d.Set("ds_record", nil)
+			d.Set("key_management_service_arn", nil)
+		} else {
+			d.Set("ds_record", ksk.DSRecord)
+			d.Set("key_management_service_arn", ksk.KmsArn)
+		}
+	} else {
+		d.Set("ds_record", nil)
+	}
+
 	return diags
 }
 
@@ -153,15 +214,11 @@ func resourceHostedZoneDNSSECDelete(ctx context.Context, d *schema.ResourceData,
 
 	output, err := conn.DisableHostedZoneDNSSECWithContext(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, route53.ErrCodeDNSSECNotFound) {
-		return diags
-	}
-
 	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
 		return diags
 	}
 
-	if err != nil {
+	if err != nil && !tfawserr.ErrCodeEquals(err, route53.ErrCodeDNSSECNotFound) {
 		return sdkdiag.AppendErrorf(diags, "disabling Route 53 Hosted Zone DNSSEC (%s): %s", d.Id(), err)
 	}
 
@@ -171,9 +228,80 @@ func resourceHostedZoneDNSSECDelete(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if _, ok := d.GetOk("key_management_service_arn"); ok {
+		name := d.Get("key_signing_key_name").(string)
+
+		if err := hostedZoneDNSSECDeleteKeySigningKey(ctx, conn, d.Id(), name); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting Route 53 Hosted Zone DNSSEC (%s) Key Signing Key (%s): %s", d.Id(), name, err)
+		}
+	}
+
 	return diags
 }
 
+// hostedZoneDNSSECCreateKeySigningKey creates an active key-signing key and waits for it to become usable.
+func hostedZoneDNSSECCreateKeySigningKey(ctx context.Context, conn *route53.Route53, hostedZoneID, name, kmsKeyARN string) error {
+	input := &route53.CreateKeySigningKeyInput{
+		CallerReference:         aws.String(resource.UniqueId()),
+		HostedZoneId:            aws.String(hostedZoneID),
+		KeyManagementServiceArn: aws.String(kmsKeyARN),
+		Name:                    aws.String(name),
+		Status:                  aws.String(KeySigningKeyStatusActive),
+	}
+
+	output, err := conn.CreateKeySigningKeyWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if output != nil && output.ChangeInfo != nil {
+		if _, err := waitChangeInfoStatusInsync(ctx, conn, aws.StringValue(output.ChangeInfo.Id)); err != nil {
+			return fmt.Errorf("waiting for change: %w", err)
+		}
+	}
+
+	if _, err := waitKeySigningKeyStatusUpdated(ctx, conn, hostedZoneID, name, KeySigningKeyStatusActive); err != nil {
+		return fmt.Errorf("waiting for status: %w", err)
+	}
+
+	return nil
+}
+
+// hostedZoneDNSSECDeleteKeySigningKey deactivates and deletes a key-signing key once signing has been disabled.
+func hostedZoneDNSSECDeleteKeySigningKey(ctx context.Context, conn *route53.Route53, hostedZoneID, name string) error {
+	err := deactivateKeySigningKey(ctx, conn, hostedZoneID, name)
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone, route53.ErrCodeNoSuchKeySigningKey) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deactivating: %w", err)
+	}
+
+	output, err := conn.DeleteKeySigningKeyWithContext(ctx, &route53.DeleteKeySigningKeyInput{
+		HostedZoneId: aws.String(hostedZoneID),
+		Name:         aws.String(name),
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone, route53.ErrCodeNoSuchKeySigningKey) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if output != nil && output.ChangeInfo != nil {
+		if _, err := waitChangeInfoStatusInsync(ctx, conn, aws.StringValue(output.ChangeInfo.Id)); err != nil {
+			return fmt.Errorf("waiting for change: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func hostedZoneDNSSECDisable(ctx context.Context, conn *route53.Route53, hostedZoneID string) error {
 	input := &route53.DisableHostedZoneDNSSECInput{
 		HostedZoneId: aws.String(hostedZoneID),
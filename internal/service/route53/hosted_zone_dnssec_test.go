@@ -3,12 +3,12 @@ package route53_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -20,11 +20,11 @@ func TestAccRoute53HostedZoneDNSSEC_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	route53ZoneResourceName := "aws_route53_zone.test"
 	resourceName := "aws_route53_hosted_zone_dnssec.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	domainName := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckKeySigningKey(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -47,14 +47,41 @@ func TestAccRoute53HostedZoneDNSSEC_basic(t *testing.T) {
 	})
 }
 
+func TestAccRoute53HostedZoneDNSSEC_keyManagementServiceARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_hosted_zone_dnssec.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	domainName := acctest.RandomDomainName()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckKeySigningKey(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckHostedZoneDNSSECDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostedZoneDNSSECConfig_keyManagementServiceARN(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccHostedZoneDNSSECExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "key_management_service_arn", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "key_signing_key_name", rName),
+					resource.TestMatchResourceAttr(resourceName, "ds_record", regexp.MustCompile(`^[0-9]+ [0-9]+ [0-9]+ [0-9A-F]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "signing_status", tfroute53.ServeSignatureSigning),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoute53HostedZoneDNSSEC_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_route53_hosted_zone_dnssec.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	domainName := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckKeySigningKey(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -75,11 +102,11 @@ func TestAccRoute53HostedZoneDNSSEC_disappears(t *testing.T) {
 func TestAccRoute53HostedZoneDNSSEC_signingStatus(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_route53_hosted_zone_dnssec.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
 	domainName := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckKeySigningKey(ctx, t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
@@ -175,7 +202,7 @@ func testAccHostedZoneDNSSECExists(ctx context.Context, resourceName string) res
 	}
 }
 
-func testAccHostedZoneDNSSECConfig_Base(rName, domainName string) string {
+func testAccHostedZoneDNSSECConfig_keyBase(domainName string) string {
 	return acctest.ConfigCompose(
 		testAccKeySigningKeyRegionProviderConfig(),
 		fmt.Sprintf(`
@@ -212,15 +239,21 @@ resource "aws_kms_key" "test" {
 }
 
 resource "aws_route53_zone" "test" {
-  name = %[2]q
+  name = %[1]q
+}
+`, domainName))
 }
 
+func testAccHostedZoneDNSSECConfig_Base(rName, domainName string) string {
+	return acctest.ConfigCompose(
+		testAccHostedZoneDNSSECConfig_keyBase(domainName),
+		fmt.Sprintf(`
 resource "aws_route53_key_signing_key" "test" {
   hosted_zone_id             = aws_route53_zone.test.id
   key_management_service_arn = aws_kms_key.test.arn
   name                       = %[1]q
 }
-`, rName, domainName))
+`, rName))
 }
 
 func testAccHostedZoneDNSSECConfig_basic(rName, domainName string) string {
@@ -232,6 +265,18 @@ resource "aws_route53_hosted_zone_dnssec" "test" {
 `)
 }
 
+func testAccHostedZoneDNSSECConfig_keyManagementServiceARN(rName, domainName string) string {
+	return acctest.ConfigCompose(
+		testAccHostedZoneDNSSECConfig_keyBase(domainName),
+		fmt.Sprintf(`
+resource "aws_route53_hosted_zone_dnssec" "test" {
+  hosted_zone_id             = aws_route53_zone.test.id
+  key_management_service_arn = aws_kms_key.test.arn
+  key_signing_key_name       = %[1]q
+}
+`, rName))
+}
+
 func testAccHostedZoneDNSSECConfig_signingStatus(rName, domainName, signingStatus string) string {
 	return acctest.ConfigCompose(
 		testAccHostedZoneDNSSECConfig_Base(rName, domainName),
@@ -2,8 +2,10 @@ package route53
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53"
@@ -14,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -29,6 +32,10 @@ func ResourceKeySigningKey() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"digest_algorithm_mnemonic": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -67,6 +74,10 @@ func ResourceKeySigningKey() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -97,6 +108,10 @@ func ResourceKeySigningKey() *schema.Resource {
 					KeySigningKeyStatusInactive,
 				}, false),
 			},
+			"status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -138,6 +153,10 @@ func resourceKeySigningKeyCreate(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Key Signing Key (%s) status (%s): %s", d.Id(), status, err)
 	}
 
+	if _, err := waitHostedZoneDNSSECStatusSettled(ctx, conn, hostedZoneID); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Hosted Zone (%s) DNSSEC status: %s", hostedZoneID, err)
+	}
+
 	return append(diags, resourceKeySigningKeyRead(ctx, d, meta)...)
 }
 
@@ -179,6 +198,11 @@ func resourceKeySigningKeyRead(ctx context.Context, d *schema.ResourceData, meta
 		return diags
 	}
 
+	if keySigningKey.CreatedDate != nil {
+		d.Set("created_date", aws.TimeValue(keySigningKey.CreatedDate).Format(time.RFC3339))
+	} else {
+		d.Set("created_date", nil)
+	}
 	d.Set("digest_algorithm_mnemonic", keySigningKey.DigestAlgorithmMnemonic)
 	d.Set("digest_algorithm_type", keySigningKey.DigestAlgorithmType)
 	d.Set("digest_value", keySigningKey.DigestValue)
@@ -188,11 +212,17 @@ func resourceKeySigningKeyRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("hosted_zone_id", hostedZoneID)
 	d.Set("key_management_service_arn", keySigningKey.KmsArn)
 	d.Set("key_tag", keySigningKey.KeyTag)
+	if keySigningKey.LastModifiedDate != nil {
+		d.Set("last_modified_date", aws.TimeValue(keySigningKey.LastModifiedDate).Format(time.RFC3339))
+	} else {
+		d.Set("last_modified_date", nil)
+	}
 	d.Set("name", keySigningKey.Name)
 	d.Set("public_key", keySigningKey.PublicKey)
 	d.Set("signing_algorithm_mnemonic", keySigningKey.SigningAlgorithmMnemonic)
 	d.Set("signing_algorithm_type", keySigningKey.SigningAlgorithmType)
 	d.Set("status", keySigningKey.Status)
+	d.Set("status_message", keySigningKey.StatusMessage)
 
 	return diags
 }
@@ -202,49 +232,21 @@ func resourceKeySigningKeyUpdate(ctx context.Context, d *schema.ResourceData, me
 	conn := meta.(*conns.AWSClient).Route53Conn()
 
 	if d.HasChange("status") {
+		hostedZoneID := d.Get("hosted_zone_id").(string)
+		name := d.Get("name").(string)
 		status := d.Get("status").(string)
 
 		switch status {
 		default:
 			return sdkdiag.AppendErrorf(diags, "updating Route 53 Key Signing Key (%s) status: unknown status (%s)", d.Id(), status)
 		case KeySigningKeyStatusActive:
-			input := &route53.ActivateKeySigningKeyInput{
-				HostedZoneId: aws.String(d.Get("hosted_zone_id").(string)),
-				Name:         aws.String(d.Get("name").(string)),
-			}
-
-			output, err := conn.ActivateKeySigningKeyWithContext(ctx, input)
-
-			if err != nil {
+			if err := activateKeySigningKey(ctx, conn, hostedZoneID, name); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating Route 53 Key Signing Key (%s) status (%s): %s", d.Id(), status, err)
 			}
-
-			if output != nil && output.ChangeInfo != nil {
-				if _, err := waitChangeInfoStatusInsync(ctx, conn, aws.StringValue(output.ChangeInfo.Id)); err != nil {
-					return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Key Signing Key (%s) status (%s) update: %s", d.Id(), status, err)
-				}
-			}
 		case KeySigningKeyStatusInactive:
-			input := &route53.DeactivateKeySigningKeyInput{
-				HostedZoneId: aws.String(d.Get("hosted_zone_id").(string)),
-				Name:         aws.String(d.Get("name").(string)),
-			}
-
-			output, err := conn.DeactivateKeySigningKeyWithContext(ctx, input)
-
-			if err != nil {
+			if err := deactivateKeySigningKey(ctx, conn, hostedZoneID, name); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating Route 53 Key Signing Key (%s) status (%s): %s", d.Id(), status, err)
 			}
-
-			if output != nil && output.ChangeInfo != nil {
-				if _, err := waitChangeInfoStatusInsync(ctx, conn, aws.StringValue(output.ChangeInfo.Id)); err != nil {
-					return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Key Signing Key (%s) status (%s) update: %s", d.Id(), status, err)
-				}
-			}
-		}
-
-		if _, err := waitKeySigningKeyStatusUpdated(ctx, conn, d.Get("hosted_zone_id").(string), d.Get("name").(string), status); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Key Signing Key (%s) status (%s): %s", d.Id(), status, err)
 		}
 	}
 
@@ -255,30 +257,25 @@ func resourceKeySigningKeyDelete(ctx context.Context, d *schema.ResourceData, me
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53Conn()
 
+	hostedZoneID := d.Get("hosted_zone_id").(string)
+	name := d.Get("name").(string)
 	status := d.Get("status").(string)
 
 	if status == KeySigningKeyStatusActive || status == KeySigningKeyStatusActionNeeded {
-		input := &route53.DeactivateKeySigningKeyInput{
-			HostedZoneId: aws.String(d.Get("hosted_zone_id").(string)),
-			Name:         aws.String(d.Get("name").(string)),
-		}
+		err := deactivateKeySigningKey(ctx, conn, hostedZoneID, name)
 
-		output, err := conn.DeactivateKeySigningKeyWithContext(ctx, input)
-
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating Route 53 Key Signing Key (%s) status (%s): %s", d.Id(), status, err)
+		if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) || tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchKeySigningKey) {
+			return diags
 		}
 
-		if output != nil && output.ChangeInfo != nil {
-			if _, err := waitChangeInfoStatusInsync(ctx, conn, aws.StringValue(output.ChangeInfo.Id)); err != nil {
-				return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Key Signing Key (%s) status (%s) update: %s", d.Id(), status, err)
-			}
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Route 53 Key Signing Key (%s) status (%s): %s", d.Id(), KeySigningKeyStatusInactive, err)
 		}
 	}
 
 	input := &route53.DeleteKeySigningKeyInput{
-		HostedZoneId: aws.String(d.Get("hosted_zone_id").(string)),
-		Name:         aws.String(d.Get("name").(string)),
+		HostedZoneId: aws.String(hostedZoneID),
+		Name:         aws.String(name),
 	}
 
 	output, err := conn.DeleteKeySigningKeyWithContext(ctx, input)
@@ -303,3 +300,73 @@ func resourceKeySigningKeyDelete(ctx context.Context, d *schema.ResourceData, me
 
 	return diags
 }
+
+// activateKeySigningKey activates the specified key-signing key and waits for both the key
+// and the hosted zone's DNSSEC signing status to settle.
+func activateKeySigningKey(ctx context.Context, conn *route53.Route53, hostedZoneID, name string) error {
+	input := &route53.ActivateKeySigningKeyInput{
+		HostedZoneId: aws.String(hostedZoneID),
+		Name:         aws.String(name),
+	}
+
+	output, err := conn.ActivateKeySigningKeyWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if output != nil && output.ChangeInfo != nil {
+		if _, err := waitChangeInfoStatusInsync(ctx, conn, aws.StringValue(output.ChangeInfo.Id)); err != nil {
+			return fmt.Errorf("waiting for change: %w", err)
+		}
+	}
+
+	if _, err := waitKeySigningKeyStatusUpdated(ctx, conn, hostedZoneID, name, KeySigningKeyStatusActive); err != nil {
+		return fmt.Errorf("waiting for status: %w", err)
+	}
+
+	if _, err := waitHostedZoneDNSSECStatusSettled(ctx, conn, hostedZoneID); err != nil {
+		return fmt.Errorf("waiting for Hosted Zone DNSSEC status: %w", err)
+	}
+
+	return nil
+}
+
+// deactivateKeySigningKey deactivates the specified key-signing key and waits for both the key
+// and the hosted zone's DNSSEC signing status to settle.
+// While a replacement key-signing key is still being activated (e.g. during a create-before-destroy rotation),
+// Route 53 refuses to deactivate the last active key of a signing zone; that error is retried.
+func deactivateKeySigningKey(ctx context.Context, conn *route53.Route53, hostedZoneID, name string) error {
+	input := &route53.DeactivateKeySigningKeyInput{
+		HostedZoneId: aws.String(hostedZoneID),
+		Name:         aws.String(name),
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, keySigningKeyInUseTimeout, func() (interface{}, error) {
+		return conn.DeactivateKeySigningKeyWithContext(ctx, input)
+	}, route53.ErrCodeKeySigningKeyInUse)
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeKeySigningKeyInParentDSRecord) {
+		return fmt.Errorf("the key-signing key is referenced by a DS record in the parent zone, remove the DS record from the parent zone first: %w", err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if output := outputRaw.(*route53.DeactivateKeySigningKeyOutput); output != nil && output.ChangeInfo != nil {
+		if _, err := waitChangeInfoStatusInsync(ctx, conn, aws.StringValue(output.ChangeInfo.Id)); err != nil {
+			return fmt.Errorf("waiting for change: %w", err)
+		}
+	}
+
+	if _, err := waitKeySigningKeyStatusUpdated(ctx, conn, hostedZoneID, name, KeySigningKeyStatusInactive); err != nil {
+		return fmt.Errorf("waiting for status: %w", err)
+	}
+
+	if _, err := waitHostedZoneDNSSECStatusSettled(ctx, conn, hostedZoneID); err != nil {
+		return fmt.Errorf("waiting for Hosted Zone DNSSEC status: %w", err)
+	}
+
+	return nil
+}
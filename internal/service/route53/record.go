@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"math/rand"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -18,12 +19,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfelb "github.com/hashicorp/terraform-provider-aws/internal/service/elb"
+	tfelbv2 "github.com/hashicorp/terraform-provider-aws/internal/service/elbv2"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
 	recordSetSyncMinDelay = 10
 	recordSetSyncMaxDelay = 30
+	recordSetSyncTimeout  = 30 * time.Minute
+
+	// cloudFrontHostedZoneID is the hosted zone ID used for all CloudFront distribution alias targets.
+	cloudFrontHostedZoneID = "Z2FDTNDATAQYW2"
 )
 
 func ResourceRecord() *schema.Resource {
@@ -40,16 +48,33 @@ func ResourceRecord() *schema.Resource {
 				// We check that we have parsed the id into the correct number of segments.
 				// We need at least 3 segments!
 				if parts[0] == "" || parts[1] == "" || parts[2] == "" {
-					return nil, fmt.Errorf("unexpected format of ID (%q), expected ZONEID_RECORDNAME_TYPE_SET-IDENTIFIER (e.g. Z4KAPRWWNC7JR_dev.example.com_NS_dev), where SET-IDENTIFIER is optional", d.Id())
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected ZONE_RECORDNAME_TYPE_SET-IDENTIFIER (e.g. Z4KAPRWWNC7JR_dev.example.com_NS_dev or example.com_dev.example.com_NS_dev), where ZONE is a zone ID or zone name and SET-IDENTIFIER is optional", d.Id())
 				}
 
-				d.Set("zone_id", parts[0])
+				zoneID, err := hostedZoneIDForImport(ctx, meta.(*conns.AWSClient).Route53Conn(), parts[0])
+
+				if err != nil {
+					return nil, err
+				}
+
+				d.Set("zone_id", zoneID)
 				d.Set("name", parts[1])
 				d.Set("type", parts[2])
 				if parts[3] != "" {
 					d.Set("set_identifier", parts[3])
 				}
 
+				// Normalize the ID to the form generated on create.
+				vars := []string{
+					zoneID,
+					strings.ToLower(parts[1]),
+					parts[2],
+				}
+				if parts[3] != "" {
+					vars = append(vars, parts[3])
+				}
+				d.SetId(strings.Join(vars, "_"))
+
 				return []*schema.ResourceData{d}, nil
 			},
 		},
@@ -57,6 +82,14 @@ func ResourceRecord() *schema.Resource {
 		SchemaVersion: 2,
 		MigrateState:  RecordMigrateState,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(recordSetSyncTimeout),
+			Update: schema.DefaultTimeout(recordSetSyncTimeout),
+			Delete: schema.DefaultTimeout(recordSetSyncTimeout),
+		},
+
+		CustomizeDiff: resourceRecordCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"alias": {
 				Type:     schema.TypeList,
@@ -99,12 +132,14 @@ func ResourceRecord() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"collection_id": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validCIDRCollectionID,
 						},
 						"location_name": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validCIDRLocationName,
 						},
 					},
 				},
@@ -273,6 +308,89 @@ func ResourceRecord() *schema.Resource {
 	}
 }
 
+func resourceRecordCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Alias targets that reference resources created in the same plan are validated by the API.
+	for _, k := range []string{"alias.0.evaluate_target_health", "alias.0.name", "alias.0.zone_id"} {
+		if !diff.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	aliases := diff.Get("alias").([]interface{})
+
+	if len(aliases) == 0 || aliases[0] == nil {
+		return nil
+	}
+
+	alias := aliases[0].(map[string]interface{})
+
+	return validRecordAliasTarget(alias["name"].(string), alias["zone_id"].(string), alias["evaluate_target_health"].(bool))
+}
+
+var (
+	// <name>-<id>.<region>.elb.amazonaws.com (Classic and Application Load Balancers).
+	aliasTargetELBRegexp = regexp.MustCompile(`\.([a-z0-9-]+)\.elb\.amazonaws\.com$`)
+	// <name>-<id>.elb.<region>.amazonaws.com (Network Load Balancers).
+	aliasTargetNLBRegexp = regexp.MustCompile(`\.elb\.([a-z0-9-]+)\.amazonaws\.com$`)
+	// s3-website-<region>.amazonaws.com or s3-website.<region>.amazonaws.com, optionally prefixed by the bucket name.
+	aliasTargetS3WebsiteRegexp = regexp.MustCompile(`(?:^|\.)s3-website[.-]([a-z0-9-]+)\.amazonaws\.com$`)
+)
+
+// validRecordAliasTarget checks the alias target's hosted zone ID against the well-known AWS alias hosted zones.
+// Alias targets whose DNS name doesn't identify the AWS service and Region are not validated.
+func validRecordAliasTarget(name, zoneID string, evaluateTargetHealth bool) error {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	name = strings.TrimPrefix(name, "dualstack.")
+
+	var service string
+	var expected []string
+
+	switch {
+	case strings.HasSuffix(name, ".cloudfront.net"):
+		if evaluateTargetHealth {
+			return fmt.Errorf("alias target (%s): evaluate_target_health must be false for CloudFront distributions", name)
+		}
+
+		service, expected = "CloudFront", []string{cloudFrontHostedZoneID}
+	case aliasTargetS3WebsiteRegexp.MatchString(name):
+		region := aliasTargetS3WebsiteRegexp.FindStringSubmatch(name)[1]
+
+		if v, err := tfs3.HostedZoneIDForRegion(region); err == nil {
+			service, expected = "S3 website", []string{v}
+		}
+	case aliasTargetNLBRegexp.MatchString(name):
+		region := aliasTargetNLBRegexp.FindStringSubmatch(name)[1]
+
+		if v, ok := tfelbv2.HostedZoneIdPerRegionNLBMap[region]; ok {
+			service, expected = "Network Load Balancer", []string{v}
+		}
+	case aliasTargetELBRegexp.MatchString(name):
+		region := aliasTargetELBRegexp.FindStringSubmatch(name)[1]
+
+		for _, m := range []map[string]string{tfelb.HostedZoneIdPerRegionMap, tfelbv2.HostedZoneIdPerRegionALBMap} {
+			if v, ok := m[region]; ok {
+				expected = append(expected, v)
+			}
+		}
+
+		if len(expected) > 0 {
+			service = "Elastic Load Balancer"
+		}
+	}
+
+	if service == "" {
+		return nil
+	}
+
+	for _, v := range expected {
+		if zoneID == v {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("alias target (%s): zone_id (%s) is not the %s hosted zone ID (%s)", name, zoneID, service, strings.Join(expected, " or "))
+}
+
 func resourceRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53Conn()
@@ -331,7 +449,13 @@ func resourceRecordCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	d.SetId(strings.Join(vars, "_"))
 
-	if err := WaitForRecordSetToSync(ctx, conn, CleanChangeID(aws.StringValue(changeInfo.Id))); err != nil {
+	// Updates that don't change the record's identity are UPSERTs performed here.
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutUpdate)
+	}
+
+	if err := WaitForRecordSetToSync(ctx, conn, CleanChangeID(aws.StringValue(changeInfo.Id)), timeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) create: %s", d.Id(), err)
 	}
 
@@ -615,7 +739,7 @@ func resourceRecordUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.SetId(strings.Join(vars, "_"))
 
-	if err := WaitForRecordSetToSync(ctx, conn, CleanChangeID(aws.StringValue(changeInfo.Id))); err != nil {
+	if err := WaitForRecordSetToSync(ctx, conn, CleanChangeID(aws.StringValue(changeInfo.Id)), d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) update: %s", d.Id(), err)
 	}
 
@@ -673,7 +797,7 @@ func resourceRecordDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return diags
 	}
 
-	if err := WaitForRecordSetToSync(ctx, conn, CleanChangeID(aws.StringValue(changeInfo.Id))); err != nil {
+	if err := WaitForRecordSetToSync(ctx, conn, CleanChangeID(aws.StringValue(changeInfo.Id)), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) delete: %s", d.Id(), err)
 	}
 
@@ -756,7 +880,7 @@ func ChangeResourceRecordSets(ctx context.Context, conn *route53.Route53, input
 	return outputRaw.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo, nil
 }
 
-func WaitForRecordSetToSync(ctx context.Context, conn *route53.Route53, requestId string) error {
+func WaitForRecordSetToSync(ctx context.Context, conn *route53.Route53, requestId string, timeout time.Duration) error {
 	rand.Seed(time.Now().UTC().UnixNano())
 
 	wait := resource.StateChangeConf{
@@ -765,7 +889,7 @@ func WaitForRecordSetToSync(ctx context.Context, conn *route53.Route53, requestI
 		Delay:        time.Duration(rand.Int63n(recordSetSyncMaxDelay-recordSetSyncMinDelay)+recordSetSyncMinDelay) * time.Second,
 		MinTimeout:   5 * time.Second,
 		PollInterval: 20 * time.Second,
-		Timeout:      30 * time.Minute,
+		Timeout:      timeout,
 		Refresh: func() (result interface{}, state string, err error) {
 			changeRequest := &route53.GetChangeInput{
 				Id: aws.String(requestId),
@@ -953,6 +1077,35 @@ func ParseRecordID(id string) [4]string {
 	return [4]string{recZone, recName, recType, recSet}
 }
 
+// hostedZoneIDForImport returns the ID of the hosted zone identified in an import ID,
+// which is either a hosted zone ID or, if it contains a period, a hosted zone name.
+// Single-label zone names can be specified with a trailing period, e.g. "internal.".
+func hostedZoneIDForImport(ctx context.Context, conn *route53.Route53, zone string) (string, error) {
+	if !strings.Contains(zone, ".") {
+		return CleanZoneID(zone), nil
+	}
+
+	hostedZones, err := FindHostedZonesByName(ctx, conn, zone)
+
+	if err != nil {
+		return "", fmt.Errorf("reading Route 53 Hosted Zones (%s): %w", zone, err)
+	}
+
+	switch n := len(hostedZones); n {
+	case 0:
+		return "", fmt.Errorf("no Route 53 Hosted Zone named %s found", zone)
+	case 1:
+		return CleanZoneID(aws.StringValue(hostedZones[0].Id)), nil
+	default:
+		var ids []string
+		for _, v := range hostedZones {
+			ids = append(ids, CleanZoneID(aws.StringValue(v.Id)))
+		}
+
+		return "", fmt.Errorf("%d Route 53 Hosted Zones named %s found (%s), import using the zone ID instead", n, zone, strings.Join(ids, ", "))
+	}
+}
+
 func validRecordType(s string) bool {
 	for _, v := range route53.RRType_Values() {
 		if v == s {
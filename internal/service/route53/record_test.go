@@ -10,11 +10,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/route53"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -133,6 +131,48 @@ func TestParseRecordId(t *testing.T) {
 	}
 }
 
+func TestValidRecordAliasTarget(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Name, ZoneID         string
+		EvaluateTargetHealth bool
+		ExpectError          bool
+	}{
+		{"d111111abcdef8.cloudfront.net", "Z2FDTNDATAQYW2", false, false},
+		{"d111111abcdef8.cloudfront.net.", "Z2FDTNDATAQYW2", false, false},
+		{"d111111abcdef8.cloudfront.net", "Z2FDTNDATAQYW2", true, true},
+		{"d111111abcdef8.cloudfront.net", "Z35SXDOTRQ7X7K", false, true},
+		{"s3-website-us-west-2.amazonaws.com", "Z3BJ6K6RIION7M", true, false},
+		{"example-bucket.s3-website.eu-central-1.amazonaws.com", "Z21DNDUVLTQW6Q", false, false},
+		{"s3-website-us-west-2.amazonaws.com", "Z2FDTNDATAQYW2", false, true},
+		{"my-alb-1234567890.us-east-1.elb.amazonaws.com", "Z35SXDOTRQ7X7K", true, false},
+		{"dualstack.my-alb-1234567890.us-east-1.elb.amazonaws.com", "Z35SXDOTRQ7X7K", true, false},
+		{"my-alb-1234567890.us-east-1.elb.amazonaws.com", "Z26RNL4JYFTOTI", true, true},
+		{"my-nlb-1234567890abcdef.elb.us-east-1.amazonaws.com", "Z26RNL4JYFTOTI", true, false},
+		{"my-nlb-1234567890abcdef.elb.us-east-1.amazonaws.com", "Z35SXDOTRQ7X7K", true, true},
+		{"www.example.com", "Z1234567890ABC", true, false},
+		{"d-abcdef1234.execute-api.us-east-1.amazonaws.com", "Z1UJRXOUMOOFQ8", false, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(fmt.Sprintf("%s_%s", tc.Name, tc.ZoneID), func(t *testing.T) {
+			t.Parallel()
+
+			err := tfroute53.ValidRecordAliasTarget(tc.Name, tc.ZoneID, tc.EvaluateTargetHealth)
+
+			if tc.ExpectError && err == nil {
+				t.Fatalf("expected error, got none")
+			}
+
+			if !tc.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccRoute53Record_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v route53.ResourceRecordSet
@@ -140,16 +180,16 @@ func TestAccRoute53Record_basic(t *testing.T) {
 	zoneName := acctest.RandomDomain()
 	recordName := zoneName.RandomSubdomain()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_basic(zoneName.String(), strings.ToUpper(recordName.String())),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &v),
+					testAccCheckRecordExists(ctx, t, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "alias.#", "0"),
 					resource.TestCheckNoResourceAttr(resourceName, "allow_overwrite"),
 					resource.TestCheckResourceAttr(resourceName, "cidr_routing_policy.#", "0"),
@@ -180,6 +220,42 @@ func TestAccRoute53Record_basic(t *testing.T) {
 	})
 }
 
+func TestAccRoute53Record_importByZoneName(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v route53.ResourceRecordSet
+	resourceName := "aws_route53_record.test"
+	zoneName := acctest.RandomDomain()
+	recordName := zoneName.RandomSubdomain()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordConfig_basic(zoneName.String(), recordName.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordExists(ctx, t, resourceName, &v),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s_%s_A", zoneName.String(), recordName.String()),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_overwrite", "weight"},
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s_%s_A", acctest.RandomDomainName(), recordName.String()),
+				ExpectError:   regexp.MustCompile(`no Route 53 Hosted Zone named`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53Record_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v route53.ResourceRecordSet
@@ -187,16 +263,16 @@ func TestAccRoute53Record_disappears(t *testing.T) {
 	zoneName := acctest.RandomDomain()
 	recordName := zoneName.RandomSubdomain()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_basic(zoneName.String(), recordName.String()),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &v),
+					testAccCheckRecordExists(ctx, t, resourceName, &v),
 					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfroute53.ResourceRecord(), resourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -210,20 +286,20 @@ func TestAccRoute53Record_Disappears_multipleRecords(t *testing.T) {
 	var v1, v2, v3, v4, v5 route53.ResourceRecordSet
 	zoneName := acctest.RandomDomain()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_multiple(zoneName.String()),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, "aws_route53_record.test.0", &v1),
-					testAccCheckRecordExists(ctx, "aws_route53_record.test.1", &v2),
-					testAccCheckRecordExists(ctx, "aws_route53_record.test.2", &v3),
-					testAccCheckRecordExists(ctx, "aws_route53_record.test.3", &v4),
-					testAccCheckRecordExists(ctx, "aws_route53_record.test.4", &v5),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.test.0", &v1),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.test.1", &v2),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.test.2", &v3),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.test.3", &v4),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.test.4", &v5),
 					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfroute53.ResourceRecord(), "aws_route53_record.test.0"),
 				),
 				ExpectNonEmptyPlan: true,
@@ -237,16 +313,16 @@ func TestAccRoute53Record_underscored(t *testing.T) {
 	var record1 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.underscore"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_underscoreInName,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -264,16 +340,16 @@ func TestAccRoute53Record_fqdn(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_fqdn,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -292,7 +368,7 @@ func TestAccRoute53Record_fqdn(t *testing.T) {
 			{
 				Config: testAccRecordConfig_fqdnNoOp,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 				),
 			},
 		},
@@ -306,16 +382,16 @@ func TestAccRoute53Record_trailingPeriodAndZoneID(t *testing.T) {
 	var record1 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_nameTrailingPeriod,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -333,16 +409,16 @@ func TestAccRoute53Record_Support_txt(t *testing.T) {
 	var record1 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_txt,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -360,16 +436,16 @@ func TestAccRoute53Record_Support_spf(t *testing.T) {
 	var record1 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_spf,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 					resource.TestCheckTypeSetElemAttr(resourceName, "records.*", "include:domain.test"),
 				),
 			},
@@ -388,16 +464,16 @@ func TestAccRoute53Record_Support_caa(t *testing.T) {
 	var record1 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_caa,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 					resource.TestCheckTypeSetElemAttr(resourceName, "records.*", "0 issue \"domainca.test;\""),
 				),
 			},
@@ -416,16 +492,16 @@ func TestAccRoute53Record_Support_ds(t *testing.T) {
 	var record1 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_ds,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -443,16 +519,16 @@ func TestAccRoute53Record_generatesSuffix(t *testing.T) {
 	var record1 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_suffix,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -470,16 +546,16 @@ func TestAccRoute53Record_wildcard(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.wildcard"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_wildcard,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -493,7 +569,7 @@ func TestAccRoute53Record_wildcard(t *testing.T) {
 			{
 				Config: testAccRecordConfig_wildcardUpdate,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 				),
 			},
 		},
@@ -505,17 +581,17 @@ func TestAccRoute53Record_failover(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.www-primary"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_failoverCNAME,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
-					testAccCheckRecordExists(ctx, "aws_route53_record.www-secondary", &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.www-secondary", &record2),
 				),
 			},
 			{
@@ -533,18 +609,18 @@ func TestAccRoute53Record_Weighted_basic(t *testing.T) {
 	var record1, record2, record3 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.www-live"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_weightedCNAME,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, "aws_route53_record.www-dev", &record1),
-					testAccCheckRecordExists(ctx, resourceName, &record2),
-					testAccCheckRecordExists(ctx, "aws_route53_record.www-off", &record3),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.www-dev", &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.www-off", &record3),
 				),
 			},
 			{
@@ -562,16 +638,16 @@ func TestAccRoute53Record_WeightedToSimple_basic(t *testing.T) {
 	var record1 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.www-server1"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_weightedRoutingPolicy,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -583,7 +659,7 @@ func TestAccRoute53Record_WeightedToSimple_basic(t *testing.T) {
 			{
 				Config: testAccRecordConfig_simpleRoutingPolicy,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 		},
@@ -595,18 +671,18 @@ func TestAccRoute53Record_Alias_elb(t *testing.T) {
 	var record1 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.alias"
 
-	rs := sdkacctest.RandString(10)
+	rs := acctest.RandString(t, 10)
 	testAccRecordConfig_config := fmt.Sprintf(testAccRecordConfig_aliasELB, rs)
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_config,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -619,22 +695,39 @@ func TestAccRoute53Record_Alias_elb(t *testing.T) {
 	})
 }
 
+func TestAccRoute53Record_Alias_invalidZoneID(t *testing.T) {
+	zoneName := acctest.RandomDomain()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRecordConfig_aliasInvalidZoneID(zoneName.String()),
+				ExpectError: regexp.MustCompile(`is not the CloudFront hosted zone ID`),
+			},
+		},
+	})
+}
+
 func TestAccRoute53Record_Alias_s3(t *testing.T) {
 	ctx := acctest.Context(t)
 	var record1 route53.ResourceRecordSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_route53_record.alias"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_aliasS3(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -650,14 +743,14 @@ func TestAccRoute53Record_Alias_s3(t *testing.T) {
 func TestAccRoute53Record_Alias_vpcEndpoint(t *testing.T) {
 	ctx := acctest.Context(t)
 	var record1 route53.ResourceRecordSet
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	resourceName := "aws_route53_record.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccRecordConfig_aliasCustomVPCEndpointSwappedAliasAttributes(rName),
@@ -666,7 +759,7 @@ func TestAccRoute53Record_Alias_vpcEndpoint(t *testing.T) {
 			{
 				Config: testAccRecordConfig_customVPCEndpoint(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -684,18 +777,18 @@ func TestAccRoute53Record_Alias_uppercase(t *testing.T) {
 	var record1 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.alias"
 
-	rs := sdkacctest.RandString(10)
+	rs := acctest.RandString(t, 10)
 	testAccRecordConfig_config := fmt.Sprintf(testAccRecordConfig_aliasELBUppercase, rs)
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_config,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -713,17 +806,17 @@ func TestAccRoute53Record_Weighted_alias(t *testing.T) {
 	var record1, record2, record3, record4, record5, record6 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.elb_weighted_alias_live"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_weightedELBAlias,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
-					testAccCheckRecordExists(ctx, "aws_route53_record.elb_weighted_alias_dev", &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.elb_weighted_alias_dev", &record2),
 				),
 			},
 			{
@@ -736,10 +829,10 @@ func TestAccRoute53Record_Weighted_alias(t *testing.T) {
 			{
 				Config: testAccRecordConfig_weightedAlias,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, "aws_route53_record.green_origin", &record3),
-					testAccCheckRecordExists(ctx, "aws_route53_record.r53_weighted_alias_live", &record4),
-					testAccCheckRecordExists(ctx, "aws_route53_record.blue_origin", &record5),
-					testAccCheckRecordExists(ctx, "aws_route53_record.r53_weighted_alias_dev", &record6),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.green_origin", &record3),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.r53_weighted_alias_live", &record4),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.blue_origin", &record5),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.r53_weighted_alias_dev", &record6),
 				),
 			},
 		},
@@ -750,21 +843,21 @@ func TestAccRoute53Record_cidr(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v route53.ResourceRecordSet
 	resourceName := "aws_route53_record.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	locationName := sdkacctest.RandString(16)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	locationName := acctest.RandString(t, 16)
 	zoneName := acctest.RandomDomain()
 	recordName := zoneName.RandomSubdomain()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_cidr(rName, locationName, zoneName.String(), recordName.String(), "cidr-location-1"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &v),
+					testAccCheckRecordExists(ctx, t, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "alias.#", "0"),
 					resource.TestCheckNoResourceAttr(resourceName, "allow_overwrite"),
 					resource.TestCheckResourceAttr(resourceName, "cidr_routing_policy.#", "1"),
@@ -795,7 +888,7 @@ func TestAccRoute53Record_cidr(t *testing.T) {
 			{
 				Config: testAccRecordConfig_cidr(rName, locationName, zoneName.String(), recordName.String(), "cidr-location-2"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &v),
+					testAccCheckRecordExists(ctx, t, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "cidr_routing_policy.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "cidr_routing_policy.0.collection_id", "aws_route53_cidr_collection.test", "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "cidr_routing_policy.0.location_name", "aws_route53_cidr_location.test", "name"),
@@ -805,7 +898,7 @@ func TestAccRoute53Record_cidr(t *testing.T) {
 			{
 				Config: testAccRecordConfig_cidrDefaultLocation(rName, locationName, zoneName.String(), recordName.String(), "cidr-location-3"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &v),
+					testAccCheckRecordExists(ctx, t, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "cidr_routing_policy.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "cidr_routing_policy.0.collection_id", "aws_route53_cidr_collection.test", "id"),
 					resource.TestCheckResourceAttr(resourceName, "cidr_routing_policy.0.location_name", "*"),
@@ -821,19 +914,19 @@ func TestAccRoute53Record_Geolocation_basic(t *testing.T) {
 	var record1, record2, record3, record4 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.default"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_geolocationCNAME,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, "aws_route53_record.default", &record1),
-					testAccCheckRecordExists(ctx, "aws_route53_record.california", &record2),
-					testAccCheckRecordExists(ctx, "aws_route53_record.oceania", &record3),
-					testAccCheckRecordExists(ctx, "aws_route53_record.denmark", &record4),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.default", &record1),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.california", &record2),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.oceania", &record3),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.denmark", &record4),
 				),
 			},
 			{
@@ -851,16 +944,16 @@ func TestAccRoute53Record_HealthCheckID_setIdentifierChange(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_healthCheckIdSetIdentifier("test1"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -872,7 +965,7 @@ func TestAccRoute53Record_HealthCheckID_setIdentifierChange(t *testing.T) {
 			{
 				Config: testAccRecordConfig_healthCheckIdSetIdentifier("test2"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 				),
 			},
 		},
@@ -884,16 +977,16 @@ func TestAccRoute53Record_HealthCheckID_typeChange(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.test"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_healthCheckIdTypeCNAME(),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -905,7 +998,7 @@ func TestAccRoute53Record_HealthCheckID_typeChange(t *testing.T) {
 			{
 				Config: testAccRecordConfig_healthCheckIdTypeA(),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 				),
 			},
 		},
@@ -917,18 +1010,18 @@ func TestAccRoute53Record_Latency_basic(t *testing.T) {
 	var record1, record2, record3 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.first_region"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_latencyCNAME(endpoints.UsEast1RegionID, endpoints.EuWest1RegionID, endpoints.ApNortheast1RegionID),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
-					testAccCheckRecordExists(ctx, "aws_route53_record.second_region", &record2),
-					testAccCheckRecordExists(ctx, "aws_route53_record.third_region", &record3),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.second_region", &record2),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.third_region", &record3),
 				),
 			},
 			{
@@ -946,16 +1039,16 @@ func TestAccRoute53Record_typeChange(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.sample"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_typeChangePre,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -969,7 +1062,7 @@ func TestAccRoute53Record_typeChange(t *testing.T) {
 			{
 				Config: testAccRecordConfig_typeChangePost,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 				),
 			},
 		},
@@ -981,16 +1074,16 @@ func TestAccRoute53Record_nameChange(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.sample"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_nameChangePre,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -1004,8 +1097,8 @@ func TestAccRoute53Record_nameChange(t *testing.T) {
 			{
 				Config: testAccRecordConfig_nameChangePost,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
-					testAccCheckRecordDoesNotExist(ctx, "aws_route53_zone.main", "sample", "CNAME"),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
+					testAccCheckRecordDoesNotExist(ctx, t, "aws_route53_zone.main", "sample", "CNAME"),
 				),
 			},
 		},
@@ -1017,16 +1110,16 @@ func TestAccRoute53Record_setIdentifierChangeBasicToWeighted(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.basic_to_weighted"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_setIdentifierChangeBasicToWeightedPre,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -1040,7 +1133,7 @@ func TestAccRoute53Record_setIdentifierChangeBasicToWeighted(t *testing.T) {
 			{
 				Config: testAccRecordConfig_setIdentifierChangeBasicToWeightedPost,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 				),
 			},
 		},
@@ -1052,16 +1145,16 @@ func TestAccRoute53Record_SetIdentifierRename_geolocationContinent(t *testing.T)
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.set_identifier_rename_geolocation"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_setIdentifierRenameGeolocationContinent("AN", "before"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -1073,7 +1166,7 @@ func TestAccRoute53Record_SetIdentifierRename_geolocationContinent(t *testing.T)
 			{
 				Config: testAccRecordConfig_setIdentifierRenameGeolocationContinent("AN", "after"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 				),
 			},
 		},
@@ -1085,16 +1178,16 @@ func TestAccRoute53Record_SetIdentifierRename_geolocationCountryDefault(t *testi
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.set_identifier_rename_geolocation"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_setIdentifierRenameGeolocationCountry("*", "before"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -1106,7 +1199,7 @@ func TestAccRoute53Record_SetIdentifierRename_geolocationCountryDefault(t *testi
 			{
 				Config: testAccRecordConfig_setIdentifierRenameGeolocationCountry("*", "after"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 				),
 			},
 		},
@@ -1118,16 +1211,16 @@ func TestAccRoute53Record_SetIdentifierRename_geolocationCountrySpecified(t *tes
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.set_identifier_rename_geolocation"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_setIdentifierRenameGeolocationCountry("US", "before"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -1139,7 +1232,7 @@ func TestAccRoute53Record_SetIdentifierRename_geolocationCountrySpecified(t *tes
 			{
 				Config: testAccRecordConfig_setIdentifierRenameGeolocationCountry("US", "after"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 				),
 			},
 		},
@@ -1151,16 +1244,16 @@ func TestAccRoute53Record_SetIdentifierRename_geolocationCountrySubdivision(t *t
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.set_identifier_rename_geolocation"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_setIdentifierRenameGeolocationCountrySubdivision("US", "CA", "before"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -1172,7 +1265,7 @@ func TestAccRoute53Record_SetIdentifierRename_geolocationCountrySubdivision(t *t
 			{
 				Config: testAccRecordConfig_setIdentifierRenameGeolocationCountrySubdivision("US", "CA", "after"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 				),
 			},
 		},
@@ -1184,16 +1277,16 @@ func TestAccRoute53Record_SetIdentifierRename_failover(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.set_identifier_rename_failover"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_setIdentifierRenameFailover("before"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -1205,7 +1298,7 @@ func TestAccRoute53Record_SetIdentifierRename_failover(t *testing.T) {
 			{
 				Config: testAccRecordConfig_setIdentifierRenameFailover("after"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 				),
 			},
 		},
@@ -1217,16 +1310,16 @@ func TestAccRoute53Record_SetIdentifierRename_latency(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.set_identifier_rename_latency"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_setIdentifierRenameLatency(endpoints.UsEast1RegionID, "before"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -1238,7 +1331,7 @@ func TestAccRoute53Record_SetIdentifierRename_latency(t *testing.T) {
 			{
 				Config: testAccRecordConfig_setIdentifierRenameLatency(endpoints.UsEast1RegionID, "after"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 				),
 			},
 		},
@@ -1250,16 +1343,16 @@ func TestAccRoute53Record_SetIdentifierRename_multiValueAnswer(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.set_identifier_rename_multivalue_answer"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_setIdentifierRenameMultiValueAnswer("before"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -1271,7 +1364,7 @@ func TestAccRoute53Record_SetIdentifierRename_multiValueAnswer(t *testing.T) {
 			{
 				Config: testAccRecordConfig_setIdentifierRenameMultiValueAnswer("after"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 				),
 			},
 		},
@@ -1283,16 +1376,16 @@ func TestAccRoute53Record_SetIdentifierRename_weighted(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.set_identifier_rename_weighted"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_setIdentifierRenameWeighted("before"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -1304,7 +1397,7 @@ func TestAccRoute53Record_SetIdentifierRename_weighted(t *testing.T) {
 			{
 				Config: testAccRecordConfig_setIdentifierRenameWeighted("after"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 				),
 			},
 		},
@@ -1315,18 +1408,18 @@ func TestAccRoute53Record_Alias_change(t *testing.T) {
 	ctx := acctest.Context(t)
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_aliasChangePre(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -1340,7 +1433,7 @@ func TestAccRoute53Record_Alias_change(t *testing.T) {
 			{
 				Config: testAccRecordConfig_aliasChangePost(),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 				),
 			},
 		},
@@ -1351,18 +1444,18 @@ func TestAccRoute53Record_Alias_changeDualstack(t *testing.T) {
 	ctx := acctest.Context(t)
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_aliasChangeDualstackPre(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 					testAccCheckRecordAliasNameDualstack(&record1, true),
 				),
 			},
@@ -1376,7 +1469,7 @@ func TestAccRoute53Record_Alias_changeDualstack(t *testing.T) {
 			{
 				Config: testAccRecordConfig_aliasChangeDualstackPost(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record2),
+					testAccCheckRecordExists(ctx, t, resourceName, &record2),
 					testAccCheckRecordAliasNameDualstack(&record2, false),
 				),
 			},
@@ -1389,16 +1482,16 @@ func TestAccRoute53Record_empty(t *testing.T) {
 	var record1 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.empty"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_emptyName,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 		},
@@ -1411,16 +1504,16 @@ func TestAccRoute53Record_longTXTrecord(t *testing.T) {
 	var record1 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.long_txt"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_longTxt,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, resourceName, &record1),
+					testAccCheckRecordExists(ctx, t, resourceName, &record1),
 				),
 			},
 			{
@@ -1438,17 +1531,17 @@ func TestAccRoute53Record_MultiValueAnswer_basic(t *testing.T) {
 	var record1, record2 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.www-server1"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_multiValueAnswerA,
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordExists(ctx, "aws_route53_record.www-server1", &record1),
-					testAccCheckRecordExists(ctx, "aws_route53_record.www-server2", &record2),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.www-server1", &record1),
+					testAccCheckRecordExists(ctx, t, "aws_route53_record.www-server2", &record2),
 				),
 			},
 			{
@@ -1463,11 +1556,11 @@ func TestAccRoute53Record_MultiValueAnswer_basic(t *testing.T) {
 
 func TestAccRoute53Record_Allow_doNotOverwrite(t *testing.T) {
 	ctx := acctest.Context(t)
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               testAccRecordOverwriteExpectErrorCheck(t),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_allowOverwrite(false),
@@ -1481,15 +1574,15 @@ func TestAccRoute53Record_Allow_overwrite(t *testing.T) {
 	var record1 route53.ResourceRecordSet
 	resourceName := "aws_route53_record.overwriting"
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		CheckDestroy:             testAccCheckRecordDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccRecordConfig_allowOverwrite(true),
-				Check:  resource.ComposeTestCheckFunc(testAccCheckRecordExists(ctx, "aws_route53_record.overwriting", &record1)),
+				Check:  resource.ComposeTestCheckFunc(testAccCheckRecordExists(ctx, t, "aws_route53_record.overwriting", &record1)),
 			},
 			{
 				ResourceName:            resourceName,
@@ -1528,9 +1621,9 @@ func testAccRecordOverwriteExpectErrorCheck(t *testing.T) resource.ErrorCheckFun
 	}
 }
 
-func testAccCheckRecordDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckRecordDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_route53_record" {
@@ -1559,7 +1652,7 @@ func testAccCheckRecordDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
-func testAccCheckRecordExists(ctx context.Context, n string, v *route53.ResourceRecordSet) resource.TestCheckFunc {
+func testAccCheckRecordExists(ctx context.Context, t *testing.T, n string, v *route53.ResourceRecordSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -1570,7 +1663,7 @@ func testAccCheckRecordExists(ctx context.Context, n string, v *route53.Resource
 			return fmt.Errorf("No Route 53 Record ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 
 		parts := tfroute53.ParseRecordID(rs.Primary.ID)
 		zone := parts[0]
@@ -1589,9 +1682,9 @@ func testAccCheckRecordExists(ctx context.Context, n string, v *route53.Resource
 	}
 }
 
-func testAccCheckRecordDoesNotExist(ctx context.Context, zoneResourceName string, recordName string, recordType string) resource.TestCheckFunc {
+func testAccCheckRecordDoesNotExist(ctx context.Context, t *testing.T, zoneResourceName string, recordName string, recordType string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 		zoneResource, ok := s.RootModule().Resources[zoneResourceName]
 		if !ok {
 			return fmt.Errorf("Not found: %s", zoneResourceName)
@@ -2211,6 +2304,26 @@ resource "aws_elb" "main" {
 }
 `
 
+func testAccRecordConfig_aliasInvalidZoneID(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www"
+  type    = "A"
+
+  alias {
+    name                   = "d111111abcdef8.cloudfront.net"
+    zone_id                = "Z35SXDOTRQ7X7K"
+    evaluate_target_health = false
+  }
+}
+`, zoneName)
+}
+
 func testAccRecordConfig_aliasS3(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_route53_zone" "main" {
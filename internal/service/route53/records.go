@@ -0,0 +1,548 @@
+package route53
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Route 53 limits the number of ResourceRecord elements in a single change batch to 1,000.
+	// UPSERT actions count twice towards this limit.
+	recordsChangeBatchMaxResourceRecords = 1000
+)
+
+func ResourceRecords() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRecordsCreate,
+		ReadWithoutTimeout:   resourceRecordsRead,
+		UpdateWithoutTimeout: resourceRecordsUpdate,
+		DeleteWithoutTimeout: resourceRecordsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRecordsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_overwrite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"record": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										StateFunc:    NormalizeAliasName,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"zone_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 32),
+									},
+								},
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							StateFunc: func(v interface{}) string {
+								// AWS Provider aws_acm_certification.domain_validation_options.resource_record_name
+								// references (and perhaps others) contain a trailing period, requiring a custom StateFunc
+								// to trim the string to prevent Route53 API error.
+								value := strings.TrimSuffix(v.(string), ".")
+								return strings.ToLower(value)
+							},
+						},
+						"records": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+						},
+					},
+				},
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return CleanZoneID(v.(string))
+				},
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	zone, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", zoneID, err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	action := route53.ChangeActionCreate
+	if d.Get("allow_overwrite").(bool) {
+		action = route53.ChangeActionUpsert
+	}
+
+	var changes []*route53.Change
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		changes = append(changes, &route53.Change{
+			Action:            aws.String(action),
+			ResourceRecordSet: expandRecordsResourceRecordSet(tfMapRaw.(map[string]interface{}), zoneName),
+		})
+	}
+
+	// Set the ID before any change batch is submitted so that the records of batches that succeed
+	// before a later batch fails are tracked in state and are deleted when the tainted resource is replaced.
+	d.SetId(zoneID)
+
+	if err := changeResourceRecordSetsInBatches(ctx, conn, zoneID, changes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route 53 Records (%s): %s", zoneID, err)
+	}
+
+	return append(diags, resourceRecordsRead(ctx, d, meta)...)
+}
+
+func resourceRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	zone, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Hosted Zone (%s) not found, removing Route 53 Records from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	recordSets, err := findResourceRecordSetsByZoneID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	// Only records known to this resource are reported.
+	// A record that has been removed or changed outside of Terraform shows up as drift.
+	var tfList []interface{}
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		key := recordsResourceRecordSetKey(expandRecordsResourceRecordSet(tfMap, zoneName))
+
+		recordSet, ok := recordSets[key]
+
+		if !ok {
+			log.Printf("[WARN] Route 53 Record (%s) not found in Hosted Zone (%s), removing from state", key, d.Id())
+			continue
+		}
+
+		tfList = append(tfList, flattenRecordsResourceRecordSet(recordSet, tfMap["name"].(string)))
+	}
+
+	d.Set("zone_id", d.Id())
+	if err := d.Set("record", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting record: %s", err)
+	}
+
+	return diags
+}
+
+func resourceRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	if d.HasChange("record") {
+		zone, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+		}
+
+		zoneName := aws.StringValue(zone.HostedZone.Name)
+		o, n := d.GetChange("record")
+		oldRecordSets, newRecordSets := make(map[string]*route53.ResourceRecordSet), make(map[string]*route53.ResourceRecordSet)
+
+		for _, tfMapRaw := range o.(*schema.Set).List() {
+			v := expandRecordsResourceRecordSet(tfMapRaw.(map[string]interface{}), zoneName)
+			oldRecordSets[recordsResourceRecordSetKey(v)] = v
+		}
+
+		for _, tfMapRaw := range n.(*schema.Set).List() {
+			v := expandRecordsResourceRecordSet(tfMapRaw.(map[string]interface{}), zoneName)
+			newRecordSets[recordsResourceRecordSetKey(v)] = v
+		}
+
+		changes := recordsChanges(oldRecordSets, newRecordSets, d.Get("allow_overwrite").(bool))
+
+		if err := changeResourceRecordSetsInBatches(ctx, conn, d.Id(), changes); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Route 53 Records (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRecordsRead(ctx, d, meta)...)
+}
+
+func resourceRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	zone, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	recordSets, err := findResourceRecordSetsByZoneID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	// Delete the record sets as they currently exist, as a DELETE action must match exactly.
+	var changes []*route53.Change
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		key := recordsResourceRecordSetKey(expandRecordsResourceRecordSet(tfMapRaw.(map[string]interface{}), zoneName))
+
+		if v, ok := recordSets[key]; ok {
+			changes = append(changes, &route53.Change{
+				Action:            aws.String(route53.ChangeActionDelete),
+				ResourceRecordSet: v,
+			})
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Route 53 Records: %s", d.Id())
+	if err := changeResourceRecordSetsInBatches(ctx, conn, d.Id(), changes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceRecordsImport imports all record sets of a hosted zone, optionally limited to a single record type.
+// The import ID is ZONE or ZONE_TYPE, where ZONE is either a hosted zone ID or a hosted zone name.
+// The SOA record and the NS record at the zone apex are managed by Route 53 and never imported.
+func resourceRecordsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	zone, recordType := d.Id(), ""
+	if i := strings.LastIndex(zone, "_"); i != -1 {
+		zone, recordType = zone[:i], zone[i+1:]
+
+		if !validRecordType(recordType) {
+			return nil, fmt.Errorf("unexpected format of ID (%q), expected ZONE or ZONE_TYPE (e.g. Z4KAPRWWNC7JR_CNAME or example.com_CNAME)", d.Id())
+		}
+	}
+
+	zoneID, err := hostedZoneIDForImport(ctx, conn, zone)
+
+	if err != nil {
+		return nil, err
+	}
+
+	hostedZone, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Route 53 Hosted Zone (%s): %w", zoneID, err)
+	}
+
+	zoneName := strings.ToLower(aws.StringValue(hostedZone.HostedZone.Name))
+	recordSets, err := findResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Route 53 Records (%s): %w", zoneID, err)
+	}
+
+	tfList, err := flattenRecordsResourceRecordSetsForImport(recordSets, zoneName, recordType)
+
+	if err != nil {
+		return nil, fmt.Errorf("importing Route 53 Records (%s): %w", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+	d.Set("zone_id", zoneID)
+	if err := d.Set("record", tfList); err != nil {
+		return nil, fmt.Errorf("setting record: %w", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// flattenRecordsResourceRecordSetsForImport returns the record sets to import, optionally limited to a single record type.
+// Record sets with a routing policy can't be represented by the resource; an error naming them is returned
+// rather than importing them without their routing policies, which the next apply would then remove.
+func flattenRecordsResourceRecordSetsForImport(recordSets map[string]*route53.ResourceRecordSet, zoneName, recordType string) ([]interface{}, error) {
+	var tfList []interface{}
+	var unsupported []string
+
+	for _, v := range recordSets {
+		rrType := aws.StringValue(v.Type)
+
+		if recordType != "" && rrType != recordType {
+			continue
+		}
+
+		name := strings.ToLower(CleanRecordName(aws.StringValue(v.Name)))
+
+		if rrType == route53.RRTypeSoa || (rrType == route53.RRTypeNs && FQDN(name) == zoneName) {
+			continue
+		}
+
+		// All routing policies require a set identifier.
+		if v.SetIdentifier != nil {
+			unsupported = append(unsupported, fmt.Sprintf("%s %s (%s)", strings.TrimSuffix(name, "."), rrType, aws.StringValue(v.SetIdentifier)))
+			continue
+		}
+
+		tfList = append(tfList, flattenRecordsResourceRecordSet(v, strings.TrimSuffix(name, ".")))
+	}
+
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+
+		return nil, fmt.Errorf("record sets with routing policies are not supported, use aws_route53_record or import a record type without them: %s", strings.Join(unsupported, ", "))
+	}
+
+	return tfList, nil
+}
+
+// recordsChanges returns the changes that turn the old record sets into the new ones.
+// Record sets that are only in the new set are created, or upserted if allowOverwrite is set, so that
+// an existing record set not managed by the resource is only overwritten when explicitly allowed.
+// Record sets that have changed are upserted. Deletions precede any creation of a record set with the same name.
+func recordsChanges(oldRecordSets, newRecordSets map[string]*route53.ResourceRecordSet, allowOverwrite bool) []*route53.Change {
+	var deletes, creates []*route53.Change
+
+	for key, v := range oldRecordSets {
+		if _, ok := newRecordSets[key]; !ok {
+			deletes = append(deletes, &route53.Change{
+				Action:            aws.String(route53.ChangeActionDelete),
+				ResourceRecordSet: v,
+			})
+		}
+	}
+
+	for key, v := range newRecordSets {
+		action := route53.ChangeActionUpsert
+
+		if old, ok := oldRecordSets[key]; ok {
+			if old.String() == v.String() {
+				continue
+			}
+		} else if !allowOverwrite {
+			action = route53.ChangeActionCreate
+		}
+
+		creates = append(creates, &route53.Change{
+			Action:            aws.String(action),
+			ResourceRecordSet: v,
+		})
+	}
+
+	return append(deletes, creates...)
+}
+
+// changeResourceRecordSetsInBatches submits the specified changes in as few change batches as possible
+// and then waits for all of the changes to be propagated.
+func changeResourceRecordSetsInBatches(ctx context.Context, conn *route53.Route53, zoneID string, changes []*route53.Change) error {
+	var changeIDs []string
+
+	for _, batch := range chunkChanges(changes, recordsChangeBatchMaxResourceRecords) {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: batch,
+				Comment: aws.String("Managed by Terraform"),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		changeInfo, err := ChangeResourceRecordSets(ctx, conn, input)
+
+		if err != nil {
+			return err
+		}
+
+		changeIDs = append(changeIDs, CleanChangeID(aws.StringValue(changeInfo.Id)))
+	}
+
+	for _, v := range changeIDs {
+		if _, err := waitChangeInfoStatusInsync(ctx, conn, v); err != nil {
+			return fmt.Errorf("waiting for change (%s) to become INSYNC: %w", v, err)
+		}
+	}
+
+	return nil
+}
+
+// chunkChanges splits the specified changes into batches that each stay within the per-batch ResourceRecord element limit.
+func chunkChanges(changes []*route53.Change, maxResourceRecords int) [][]*route53.Change {
+	var batches [][]*route53.Change
+	var batch []*route53.Change
+	n := 0
+
+	for _, v := range changes {
+		weight := len(v.ResourceRecordSet.ResourceRecords)
+		if weight == 0 {
+			weight = 1
+		}
+		if aws.StringValue(v.Action) == route53.ChangeActionUpsert {
+			weight *= 2
+		}
+
+		if n+weight > maxResourceRecords && len(batch) > 0 {
+			batches = append(batches, batch)
+			batch, n = nil, 0
+		}
+
+		batch = append(batch, v)
+		n += weight
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+// findResourceRecordSetsByZoneID returns all record sets in the specified hosted zone keyed by name, type and set identifier.
+func findResourceRecordSetsByZoneID(ctx context.Context, conn *route53.Route53, zoneID string) (map[string]*route53.ResourceRecordSet, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	recordSets, err := findResourceRecordSets(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]*route53.ResourceRecordSet, len(recordSets))
+
+	for _, v := range recordSets {
+		output[recordsResourceRecordSetKey(v)] = v
+	}
+
+	return output, nil
+}
+
+// findResourceRecordSets returns all the record sets matching the specified input and filters.
+// A NoSuchHostedZone error is returned as a NotFoundError.
+func findResourceRecordSets(ctx context.Context, conn *route53.Route53, input *route53.ListResourceRecordSetsInput, filters ...tfslices.FilterFunc[*route53.ResourceRecordSet]) ([]*route53.ResourceRecordSet, error) {
+	return tfresource.FindAllPages(input,
+		func(fn func(*route53.ListResourceRecordSetsOutput, bool) bool) error {
+			return conn.ListResourceRecordSetsPagesWithContext(ctx, input, fn)
+		},
+		func(page *route53.ListResourceRecordSetsOutput) []*route53.ResourceRecordSet {
+			return page.ResourceRecordSets
+		},
+		[]string{route53.ErrCodeNoSuchHostedZone},
+		append([]tfslices.FilterFunc[*route53.ResourceRecordSet]{tfresource.NotNil[route53.ResourceRecordSet]}, filters...)...,
+	)
+}
+
+func recordsResourceRecordSetKey(apiObject *route53.ResourceRecordSet) string {
+	name := FQDN(strings.ToLower(CleanRecordName(aws.StringValue(apiObject.Name))))
+
+	return strings.Join([]string{name, strings.ToUpper(aws.StringValue(apiObject.Type)), aws.StringValue(apiObject.SetIdentifier)}, "_")
+}
+
+func expandRecordsResourceRecordSet(tfMap map[string]interface{}, zoneName string) *route53.ResourceRecordSet {
+	recordType := tfMap["type"].(string)
+	apiObject := &route53.ResourceRecordSet{
+		Name: aws.String(ExpandRecordName(tfMap["name"].(string), zoneName)),
+		Type: aws.String(recordType),
+	}
+
+	if v, ok := tfMap["alias"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		alias := v[0].(map[string]interface{})
+		apiObject.AliasTarget = &route53.AliasTarget{
+			DNSName:              aws.String(alias["name"].(string)),
+			EvaluateTargetHealth: aws.Bool(alias["evaluate_target_health"].(bool)),
+			HostedZoneId:         aws.String(alias["zone_id"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["records"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceRecords = expandResourceRecords(v.List(), recordType)
+	}
+
+	if v, ok := tfMap["ttl"].(int); ok && v != 0 {
+		apiObject.TTL = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenRecordsResourceRecordSet(apiObject *route53.ResourceRecordSet, name string) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"name":    name,
+		"records": flex.FlattenStringValueSet(FlattenResourceRecords(apiObject.ResourceRecords, aws.StringValue(apiObject.Type))),
+		"ttl":     int(aws.Int64Value(apiObject.TTL)),
+		"type":    aws.StringValue(apiObject.Type),
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap["alias"] = []interface{}{map[string]interface{}{
+			"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+			"name":                   NormalizeAliasName(aws.StringValue(v.DNSName)),
+			"zone_id":                aws.StringValue(v.HostedZoneId),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,186 @@
+package route53
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceRecords() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"resource_record_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias_target": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dns_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"hosted_zone_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"failover": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_check_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"multi_value_answer": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"traffic_policy_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	recordType := d.Get("type").(string)
+
+	recordSets, err := findResourceRecordSets(ctx, conn, input, func(v *route53.ResourceRecordSet) bool {
+		if recordType != "" && aws.StringValue(v.Type) != recordType {
+			return false
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(CleanRecordName(aws.StringValue(v.Name))) {
+			return false
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Route 53 Records (%s): %s", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+
+	var tfList []interface{}
+	for _, v := range recordSets {
+		tfList = append(tfList, flattenResourceRecordSet(v))
+	}
+
+	if err := d.Set("resource_record_sets", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resource_record_sets: %s", err)
+	}
+
+	return diags
+}
+
+func flattenResourceRecordSet(apiObject *route53.ResourceRecordSet) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"failover":                   aws.StringValue(apiObject.Failover),
+		"health_check_id":            aws.StringValue(apiObject.HealthCheckId),
+		"multi_value_answer":         aws.BoolValue(apiObject.MultiValueAnswer),
+		"name":                       CleanRecordName(aws.StringValue(apiObject.Name)),
+		"region":                     aws.StringValue(apiObject.Region),
+		"resource_records":           FlattenResourceRecords(apiObject.ResourceRecords, aws.StringValue(apiObject.Type)),
+		"set_identifier":             aws.StringValue(apiObject.SetIdentifier),
+		"traffic_policy_instance_id": aws.StringValue(apiObject.TrafficPolicyInstanceId),
+		"ttl":                        aws.Int64Value(apiObject.TTL),
+		"type":                       aws.StringValue(apiObject.Type),
+		"weight":                     aws.Int64Value(apiObject.Weight),
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap["alias_target"] = []interface{}{map[string]interface{}{
+			"dns_name":               NormalizeAliasName(aws.StringValue(v.DNSName)),
+			"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+			"hosted_zone_id":         aws.StringValue(v.HostedZoneId),
+		}}
+	}
+
+	return tfMap
+}
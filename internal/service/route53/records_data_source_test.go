@@ -0,0 +1,87 @@
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53RecordsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_route53_records.test"
+	zoneName := acctest.RandomDomainName()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_basic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					// NS, SOA and the two A records.
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "4"),
+				),
+			},
+			{
+				Config: testAccRecordsDataSourceConfig_filtered(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.name", fmt.Sprintf("www.%s.", zoneName)),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.resource_records.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.resource_records.0", "127.0.0.1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.ttl", "300"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.type", "A"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecordsDataSourceConfig_base(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "www" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www"
+  type    = "A"
+  ttl     = 300
+  records = ["127.0.0.1"]
+}
+
+resource "aws_route53_record" "api" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "api"
+  type    = "A"
+  ttl     = 300
+  records = ["127.0.0.2"]
+}
+`, zoneName)
+}
+
+func testAccRecordsDataSourceConfig_basic(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsDataSourceConfig_base(zoneName), `
+data "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  depends_on = [aws_route53_record.www, aws_route53_record.api]
+}
+`)
+}
+
+func testAccRecordsDataSourceConfig_filtered(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsDataSourceConfig_base(zoneName), `
+data "aws_route53_records" "test" {
+  zone_id    = aws_route53_zone.test.zone_id
+  name_regex = "^www\\."
+  type       = "A"
+
+  depends_on = [aws_route53_record.www, aws_route53_record.api]
+}
+`)
+}
@@ -0,0 +1,593 @@
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+)
+
+func TestChunkChanges(t *testing.T) {
+	t.Parallel()
+
+	change := func(action string, n int) *route53.Change {
+		apiObject := &route53.Change{
+			Action:            aws.String(action),
+			ResourceRecordSet: &route53.ResourceRecordSet{},
+		}
+
+		for i := 0; i < n; i++ {
+			apiObject.ResourceRecordSet.ResourceRecords = append(apiObject.ResourceRecordSet.ResourceRecords, &route53.ResourceRecord{})
+		}
+
+		return apiObject
+	}
+
+	testCases := []struct {
+		Name     string
+		Changes  []*route53.Change
+		Expected []int
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name:     "single batch",
+			Changes:  []*route53.Change{change(route53.ChangeActionCreate, 2), change(route53.ChangeActionDelete, 3)},
+			Expected: []int{2},
+		},
+		{
+			Name:     "upsert counts twice",
+			Changes:  []*route53.Change{change(route53.ChangeActionUpsert, 3), change(route53.ChangeActionUpsert, 3)},
+			Expected: []int{1, 1},
+		},
+		{
+			Name:     "alias counts once",
+			Changes:  []*route53.Change{change(route53.ChangeActionCreate, 0), change(route53.ChangeActionCreate, 0), change(route53.ChangeActionCreate, 9)},
+			Expected: []int{2, 1},
+		},
+		{
+			Name:     "oversized change",
+			Changes:  []*route53.Change{change(route53.ChangeActionCreate, 20), change(route53.ChangeActionCreate, 1)},
+			Expected: []int{1, 1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfroute53.ChunkChanges(testCase.Changes, 10)
+
+			if len(got) != len(testCase.Expected) {
+				t.Fatalf("expected %d batches, got %d", len(testCase.Expected), len(got))
+			}
+
+			for i, v := range testCase.Expected {
+				if len(got[i]) != v {
+					t.Errorf("batch %d: expected %d changes, got %d", i, v, len(got[i]))
+				}
+			}
+		})
+	}
+}
+
+func TestRecordsChanges(t *testing.T) {
+	t.Parallel()
+
+	recordSet := func(name, value string) *route53.ResourceRecordSet {
+		return &route53.ResourceRecordSet{
+			Name:            aws.String(name),
+			ResourceRecords: []*route53.ResourceRecord{{Value: aws.String(value)}},
+			TTL:             aws.Int64(300),
+			Type:            aws.String(route53.RRTypeA),
+		}
+	}
+
+	testCases := []struct {
+		Name           string
+		Old            map[string]*route53.ResourceRecordSet
+		New            map[string]*route53.ResourceRecordSet
+		AllowOverwrite bool
+		Expected       []string
+	}{
+		{
+			Name:     "unchanged",
+			Old:      map[string]*route53.ResourceRecordSet{"www": recordSet("www", "127.0.0.1")},
+			New:      map[string]*route53.ResourceRecordSet{"www": recordSet("www", "127.0.0.1")},
+			Expected: nil,
+		},
+		{
+			Name:     "changed",
+			Old:      map[string]*route53.ResourceRecordSet{"www": recordSet("www", "127.0.0.1")},
+			New:      map[string]*route53.ResourceRecordSet{"www": recordSet("www", "127.0.0.2")},
+			Expected: []string{route53.ChangeActionUpsert},
+		},
+		{
+			Name:     "added",
+			Old:      map[string]*route53.ResourceRecordSet{"www": recordSet("www", "127.0.0.1")},
+			New:      map[string]*route53.ResourceRecordSet{"www": recordSet("www", "127.0.0.1"), "api": recordSet("api", "127.0.0.1")},
+			Expected: []string{route53.ChangeActionCreate},
+		},
+		{
+			Name:           "added with allow_overwrite",
+			Old:            map[string]*route53.ResourceRecordSet{"www": recordSet("www", "127.0.0.1")},
+			New:            map[string]*route53.ResourceRecordSet{"www": recordSet("www", "127.0.0.1"), "api": recordSet("api", "127.0.0.1")},
+			AllowOverwrite: true,
+			Expected:       []string{route53.ChangeActionUpsert},
+		},
+		{
+			Name:     "replaced",
+			Old:      map[string]*route53.ResourceRecordSet{"www": recordSet("www", "127.0.0.1")},
+			New:      map[string]*route53.ResourceRecordSet{"api": recordSet("api", "127.0.0.1")},
+			Expected: []string{route53.ChangeActionDelete, route53.ChangeActionCreate},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfroute53.RecordsChanges(testCase.Old, testCase.New, testCase.AllowOverwrite)
+
+			if len(got) != len(testCase.Expected) {
+				t.Fatalf("expected %d changes, got %d", len(testCase.Expected), len(got))
+			}
+
+			for i, v := range testCase.Expected {
+				if got := aws.StringValue(got[i].Action); got != v {
+					t.Errorf("change %d: expected %s, got %s", i, v, got)
+				}
+			}
+		})
+	}
+}
+
+func TestFlattenRecordsResourceRecordSetsForImport(t *testing.T) {
+	t.Parallel()
+
+	recordSet := func(name, recordType, setIdentifier string) *route53.ResourceRecordSet {
+		apiObject := &route53.ResourceRecordSet{
+			Name:            aws.String(name),
+			ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("127.0.0.1")}},
+			TTL:             aws.Int64(300),
+			Type:            aws.String(recordType),
+		}
+
+		if setIdentifier != "" {
+			apiObject.SetIdentifier = aws.String(setIdentifier)
+			apiObject.Weight = aws.Int64(10)
+		}
+
+		return apiObject
+	}
+
+	recordSets := map[string]*route53.ResourceRecordSet{
+		"soa": recordSet("example.com.", route53.RRTypeSoa, ""),
+		"ns":  recordSet("example.com.", route53.RRTypeNs, ""),
+		"www": recordSet("www.example.com.", route53.RRTypeA, ""),
+		"txt": recordSet("txt.example.com.", route53.RRTypeTxt, ""),
+	}
+	weightedRecordSets := map[string]*route53.ResourceRecordSet{
+		"www":  recordSet("www.example.com.", route53.RRTypeA, ""),
+		"txt":  recordSet("txt.example.com.", route53.RRTypeTxt, ""),
+		"api1": recordSet("api.example.com.", route53.RRTypeA, "blue"),
+		"api2": recordSet("api.example.com.", route53.RRTypeA, "green"),
+	}
+
+	testCases := []struct {
+		Name        string
+		RecordSets  map[string]*route53.ResourceRecordSet
+		RecordType  string
+		Expected    int
+		ExpectError bool
+	}{
+		{
+			Name:       "all types",
+			RecordSets: recordSets,
+			Expected:   2,
+		},
+		{
+			Name:       "single type",
+			RecordSets: recordSets,
+			RecordType: route53.RRTypeTxt,
+			Expected:   1,
+		},
+		{
+			Name:        "weighted",
+			RecordSets:  weightedRecordSets,
+			ExpectError: true,
+		},
+		{
+			Name:        "weighted type",
+			RecordSets:  weightedRecordSets,
+			RecordType:  route53.RRTypeA,
+			ExpectError: true,
+		},
+		{
+			Name:       "other type than weighted",
+			RecordSets: weightedRecordSets,
+			RecordType: route53.RRTypeTxt,
+			Expected:   1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfroute53.FlattenRecordsResourceRecordSetsForImport(testCase.RecordSets, "example.com.", testCase.RecordType)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				for _, v := range []string{"api.example.com A (blue)", "api.example.com A (green)"} {
+					if !strings.Contains(err.Error(), v) {
+						t.Errorf("expected error to name %q, got %s", v, err)
+					}
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != testCase.Expected {
+				t.Errorf("expected %d records, got %d", testCase.Expected, len(got))
+			}
+		})
+	}
+}
+
+func TestAccRoute53Records_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), "127.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "www",
+						"records.#": "1",
+						"ttl":       "300",
+						"type":      "A",
+					}),
+					resource.TestCheckTypeSetElemAttr(resourceName, "record.*.records.*", "127.0.0.1"),
+				),
+			},
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), "127.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "record.*.records.*", "127.0.0.2"),
+				),
+			},
+			{
+				ResourceName:     resourceName,
+				ImportState:      true,
+				ImportStateId:    fmt.Sprintf("%s_A", zoneName.String()),
+				ImportStateCheck: testAccCheckRecordsImportedCount(2),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", resourceName)
+					}
+
+					return rs.Primary.ID, nil
+				},
+				ImportStateCheck: testAccCheckRecordsImportedCount(3),
+			},
+		},
+	})
+}
+
+func testAccCheckRecordsImportedCount(expected int) resource.ImportStateCheckFunc {
+	return func(s []*terraform.InstanceState) error {
+		if len(s) != 1 {
+			return fmt.Errorf("expected 1 imported resource, got %d", len(s))
+		}
+
+		if got, want := s[0].Attributes["record.#"], strconv.Itoa(expected); got != want {
+			return fmt.Errorf("expected %s imported records, got %s", want, got)
+		}
+
+		return nil
+	}
+}
+
+func TestAccRoute53Records_removeRecord(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String(), "127.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+				),
+			},
+			{
+				Config: testAccRecordsConfig_single(zoneName.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "1"),
+					testAccCheckRecordDoesNotExist(ctx, t, "aws_route53_zone.test", "api", "A"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_zoneIDWithPrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_zoneIDWithPrefix(zoneName.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+				),
+			},
+			{
+				Config:   testAccRecordsConfig_zoneIDWithPrefix(zoneName.String()),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_importWeighted(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_weighted(zoneName.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "1"),
+				),
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: zoneName.String(),
+				ExpectError:   regexp.MustCompile(`record sets with routing policies are not supported`),
+			},
+			{
+				ResourceName:     resourceName,
+				ImportState:      true,
+				ImportStateId:    fmt.Sprintf("%s_TXT", zoneName.String()),
+				ImportStateCheck: testAccCheckRecordsImportedCount(1),
+			},
+		},
+	})
+}
+
+func testAccCheckRecordsExists(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Records ID is set")
+		}
+
+		conn := acctest.ProviderMeta(t).Route53Conn()
+
+		recordSets, err := tfroute53.FindResourceRecordSetsByZoneID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "record.") || !strings.HasSuffix(k, ".type") {
+				continue
+			}
+
+			prefix := strings.TrimSuffix(k, "type")
+			name := rs.Primary.Attributes[prefix+"name"]
+			found := false
+
+			for _, recordSet := range recordSets {
+				if aws.StringValue(recordSet.Type) == v && strings.HasPrefix(strings.ToLower(tfroute53.CleanRecordName(aws.StringValue(recordSet.Name))), name) {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("Route 53 Record (%s %s) not found in Hosted Zone (%s)", name, v, rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(t).Route53Conn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53_records" {
+				continue
+			}
+
+			recordSets, err := tfroute53.FindResourceRecordSetsByZoneID(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				// The hosted zone has been deleted.
+				continue
+			}
+
+			for _, v := range recordSets {
+				if t := aws.StringValue(v.Type); t != route53.RRTypeNs && t != route53.RRTypeSoa {
+					return fmt.Errorf("Route 53 Record %s still exists in Hosted Zone (%s)", aws.StringValue(v.Name), rs.Primary.ID)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccRecordsConfig_basic(zoneName, address string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = [%[2]q]
+  }
+
+  record {
+    name    = "api"
+    type    = "A"
+    ttl     = 300
+    records = [%[2]q]
+  }
+
+  record {
+    name    = "txt"
+    type    = "TXT"
+    ttl     = 60
+    records = ["hello world"]
+  }
+}
+`, zoneName, address)
+}
+
+func testAccRecordsConfig_single(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = ["127.0.0.1"]
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsConfig_zoneIDWithPrefix(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = "/hostedzone/${aws_route53_zone.test.zone_id}"
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = ["127.0.0.1"]
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsConfig_weighted(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "test" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "www"
+  type           = "A"
+  ttl            = 300
+  records        = ["127.0.0.1"]
+  set_identifier = "blue"
+
+  weighted_routing_policy {
+    weight = 10
+  }
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "txt"
+    type    = "TXT"
+    ttl     = 60
+    records = ["hello world"]
+  }
+}
+`, zoneName)
+}
@@ -20,6 +20,11 @@ import (
 )
 
 func init() {
+	resource.AddTestSweepers("aws_route53_cidr_collection", &resource.Sweeper{
+		Name: "aws_route53_cidr_collection",
+		F:    sweepCIDRCollections,
+	})
+
 	resource.AddTestSweepers("aws_route53_health_check", &resource.Sweeper{
 		Name: "aws_route53_health_check",
 		F:    sweepHealthChecks,
@@ -62,6 +67,82 @@ func init() {
 	})
 }
 
+func sweepCIDRCollections(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+
+	conn := client.(*conns.AWSClient).Route53Conn()
+	locationSweepResources := make([]sweep.Sweepable, 0)
+	sweepResources := make([]sweep.Sweepable, 0)
+	var errs *multierror.Error
+
+	input := &route53.ListCidrCollectionsInput{}
+
+	err = conn.ListCidrCollectionsPagesWithContext(ctx, input, func(page *route53.ListCidrCollectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CidrCollections {
+			if v == nil {
+				continue
+			}
+
+			id := aws.StringValue(v.Id)
+
+			// Collections can only be deleted once they contain no locations.
+			input := &route53.ListCidrLocationsInput{
+				CollectionId: aws.String(id),
+			}
+
+			err := conn.ListCidrLocationsPagesWithContext(ctx, input, func(page *route53.ListCidrLocationsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
+
+				for _, v := range page.CidrLocations {
+					if v == nil {
+						continue
+					}
+
+					locationSweepResources = append(locationSweepResources, sweep.NewSweepFrameworkResource(newResourceCIDRLocation, cidrLocationCreateResourceID(id, aws.StringValue(v.LocationName)), client))
+				}
+
+				return !lastPage
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("listing Route53 CIDR Locations (%s) for %s: %w", id, region, err))
+			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepFrameworkResource(newResourceCIDRCollection, id, client))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("listing Route53 CIDR Collections for %s: %w", region, err))
+	}
+
+	if err = sweep.SweepOrchestratorWithContext(ctx, locationSweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Route53 CIDR Locations for %s: %w", region, err))
+	} else if err = sweep.SweepOrchestratorWithContext(ctx, sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("sweeping Route53 CIDR Collections for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Route53 CIDR Collections sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func sweepHealthChecks(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(region)
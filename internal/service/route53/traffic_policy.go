@@ -2,6 +2,7 @@ package route53
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
@@ -53,10 +54,36 @@ func ResourceTrafficPolicy() *schema.Resource {
 			},
 			"document": {
 				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"document", "document_config"},
 				ValidateFunc: validation.StringLenBetween(0, 102400),
 			},
+			"document_config": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"document", "document_config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": trafficPolicyDocumentEndpointSchema(),
+						"record_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+						},
+						"rule": trafficPolicyDocumentRuleSchema(),
+						"start_endpoint": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"start_rule": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -79,8 +106,14 @@ func resourceTrafficPolicyCreate(ctx context.Context, d *schema.ResourceData, me
 	conn := meta.(*conns.AWSClient).Route53Conn()
 
 	name := d.Get("name").(string)
+	document, err := trafficPolicyDocument(d)
+
+	if err != nil {
+		return diag.Errorf("error creating Route53 Traffic Policy (%s): %s", name, err)
+	}
+
 	input := &route53.CreateTrafficPolicyInput{
-		Document: aws.String(d.Get("document").(string)),
+		Document: aws.String(document),
 		Name:     aws.String(name),
 	}
 
@@ -129,20 +162,44 @@ func resourceTrafficPolicyRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceTrafficPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).Route53Conn()
 
-	input := &route53.UpdateTrafficPolicyCommentInput{
-		Id:      aws.String(d.Id()),
-		Version: aws.Int64(int64(d.Get("version").(int))),
-	}
+	// A changed document is published as a new version of the traffic policy.
+	if d.HasChanges("document", "document_config") {
+		document, err := trafficPolicyDocument(d)
 
-	if d.HasChange("comment") {
-		input.Comment = aws.String(d.Get("comment").(string))
-	}
+		if err != nil {
+			return diag.Errorf("error updating Route53 Traffic Policy (%s): %s", d.Id(), err)
+		}
+
+		input := &route53.CreateTrafficPolicyVersionInput{
+			Document: aws.String(document),
+			Id:       aws.String(d.Id()),
+		}
 
-	log.Printf("[INFO] Updating Route53 Traffic Policy comment: %s", input)
-	_, err := conn.UpdateTrafficPolicyCommentWithContext(ctx, input)
+		if v, ok := d.GetOk("comment"); ok {
+			input.Comment = aws.String(v.(string))
+		}
 
-	if err != nil {
-		return diag.Errorf("error updating Route53 Traffic Policy (%s) comment: %s", d.Id(), err)
+		log.Printf("[INFO] Creating Route53 Traffic Policy version: %s", input)
+		output, err := conn.CreateTrafficPolicyVersionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error creating Route53 Traffic Policy (%s) version: %s", d.Id(), err)
+		}
+
+		d.Set("version", output.TrafficPolicy.Version)
+	} else if d.HasChange("comment") {
+		input := &route53.UpdateTrafficPolicyCommentInput{
+			Comment: aws.String(d.Get("comment").(string)),
+			Id:      aws.String(d.Id()),
+			Version: aws.Int64(int64(d.Get("version").(int))),
+		}
+
+		log.Printf("[INFO] Updating Route53 Traffic Policy comment: %s", input)
+		_, err := conn.UpdateTrafficPolicyCommentWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Route53 Traffic Policy (%s) comment: %s", d.Id(), err)
+		}
 	}
 
 	return resourceTrafficPolicyRead(ctx, d, meta)
@@ -190,3 +247,41 @@ func resourceTrafficPolicyDelete(ctx context.Context, d *schema.ResourceData, me
 
 	return nil
 }
+
+// trafficPolicyDocument returns the traffic policy document JSON, either as configured
+// or compiled from the document_config block.
+func trafficPolicyDocument(d *schema.ResourceData) (string, error) {
+	v, ok := d.GetOk("document_config")
+
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return d.Get("document").(string), nil
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	doc := &Route53TrafficPolicyDoc{
+		AWSPolicyFormatVersion: "2015-10-01",
+		RecordType:             tfMap["record_type"].(string),
+		StartEndpoint:          tfMap["start_endpoint"].(string),
+		StartRule:              tfMap["start_rule"].(string),
+	}
+
+	if v, ok := tfMap["endpoint"].(*schema.Set); ok && v.Len() > 0 {
+		doc.Endpoints = expandDataTrafficPolicyEndpointsDoc(v.List())
+	}
+
+	if v, ok := tfMap["rule"].(*schema.Set); ok && v.Len() > 0 {
+		doc.Rules = expandDataTrafficPolicyRulesDoc(v.List())
+	}
+
+	if err := doc.Validate(); err != nil {
+		return "", fmt.Errorf("invalid document_config: %w", err)
+	}
+
+	output, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
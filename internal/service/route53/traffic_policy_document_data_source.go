@@ -16,31 +16,7 @@ func DataSourceTrafficPolicyDocument() *schema.Resource {
 		ReadWithoutTimeout: dataSourceTrafficPolicyDocumentRead,
 
 		Schema: map[string]*schema.Schema{
-			"endpoint": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"type": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringInSlice(TrafficPolicyDocEndpointType_values(), false),
-						},
-						"region": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-					},
-				},
-			},
+			"endpoint": trafficPolicyDocumentEndpointSchema(),
 			"json": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -49,196 +25,7 @@ func DataSourceTrafficPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"rule": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"type": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"primary": {
-							Type:     schema.TypeList,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"endpoint_reference": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"evaluate_target_health": {
-										Type:     schema.TypeBool,
-										Optional: true,
-									},
-									"health_check": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"rule_reference": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-								},
-							},
-						},
-						"secondary": {
-							Type:     schema.TypeList,
-							MaxItems: 1,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"endpoint_reference": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"evaluate_target_health": {
-										Type:     schema.TypeBool,
-										Optional: true,
-									},
-									"health_check": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"rule_reference": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-								},
-							},
-						},
-						"location": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"continent": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"country": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"endpoint_reference": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"evaluate_target_health": {
-										Type:     schema.TypeBool,
-										Optional: true,
-									},
-									"health_check": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"is_default": {
-										Type:     schema.TypeBool,
-										Optional: true,
-									},
-									"rule_reference": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"subdivision": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-								},
-							},
-						},
-						"geo_proximity_location": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"bias": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"endpoint_reference": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"evaluate_target_health": {
-										Type:     schema.TypeBool,
-										Optional: true,
-									},
-									"health_check": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"latitude": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"longitude": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"region": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"rule_reference": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-								},
-							},
-						},
-						"region": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"endpoint_reference": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"evaluate_target_health": {
-										Type:     schema.TypeBool,
-										Optional: true,
-									},
-									"health_check": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"region": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"rule_reference": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-								},
-							},
-						},
-						"items": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"endpoint_reference": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-									"health_check": {
-										Type:     schema.TypeString,
-										Optional: true,
-									},
-								},
-							},
-						},
-					},
-				},
-			},
+			"rule": trafficPolicyDocumentRuleSchema(),
 			"start_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -259,6 +46,235 @@ func DataSourceTrafficPolicyDocument() *schema.Resource {
 	}
 }
 
+func trafficPolicyDocumentEndpointSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(TrafficPolicyDocEndpointType_values(), false),
+				},
+				"region": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func trafficPolicyDocumentRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"primary": {
+					Type:     schema.TypeList,
+					MaxItems: 1,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"endpoint_reference": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"evaluate_target_health": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"health_check": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"rule_reference": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"secondary": {
+					Type:     schema.TypeList,
+					MaxItems: 1,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"endpoint_reference": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"evaluate_target_health": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"health_check": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"rule_reference": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"location": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"continent": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"country": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"endpoint_reference": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"evaluate_target_health": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"health_check": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"is_default": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"rule_reference": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"subdivision": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"geo_proximity_location": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"bias": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"endpoint_reference": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"evaluate_target_health": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"health_check": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"latitude": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"longitude": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"region": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"rule_reference": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"region": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"endpoint_reference": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"evaluate_target_health": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							"health_check": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"region": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"rule_reference": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"items": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"endpoint_reference": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"health_check": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"rule_reference": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"weight": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func dataSourceTrafficPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	trafficDoc := &Route53TrafficPolicyDoc{}
 
@@ -594,6 +610,12 @@ func expandDataTrafficPolicyItemDoc(tfMap map[string]interface{}) *TrafficPolicy
 	if v, ok := tfMap["health_check"]; ok && v.(string) != "" {
 		apiObject.HealthCheck = v.(string)
 	}
+	if v, ok := tfMap["rule_reference"]; ok && v.(string) != "" {
+		apiObject.RuleReference = v.(string)
+	}
+	if v, ok := tfMap["weight"]; ok && v.(string) != "" {
+		apiObject.Weight = v.(string)
+	}
 
 	return apiObject
 }
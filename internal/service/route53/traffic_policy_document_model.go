@@ -1,5 +1,9 @@
 package route53
 
+import (
+	"fmt"
+)
+
 const (
 	trafficPolicyDocEndpointValue      = "value"
 	trafficPolicyDocEndpointCloudFront = "cloudfront"
@@ -17,6 +21,27 @@ func TrafficPolicyDocEndpointType_values() []string {
 	}
 }
 
+const (
+	trafficPolicyDocRuleFailover     = "failover"
+	trafficPolicyDocRuleGeo          = "geo"
+	trafficPolicyDocRuleGeoproximity = "geoproximity"
+	trafficPolicyDocRuleLatency      = "latency"
+	trafficPolicyDocRuleMultiValue   = "multivalue"
+	trafficPolicyDocRuleWeighted     = "weighted"
+)
+
+// TrafficPolicyDocRuleType_values returns all elements of the rule types
+func TrafficPolicyDocRuleType_values() []string {
+	return []string{
+		trafficPolicyDocRuleFailover,
+		trafficPolicyDocRuleGeo,
+		trafficPolicyDocRuleGeoproximity,
+		trafficPolicyDocRuleLatency,
+		trafficPolicyDocRuleMultiValue,
+		trafficPolicyDocRuleWeighted,
+	}
+}
+
 type Route53TrafficPolicyDoc struct {
 	AWSPolicyFormatVersion string                            `json:",omitempty"`
 	RecordType             string                            `json:",omitempty"`
@@ -81,5 +106,127 @@ type TrafficPolicyLatencyRule struct {
 
 type TrafficPolicyMultiValueAnswerRule struct {
 	EndpointReference string `json:",omitempty"`
+	RuleReference     string `json:",omitempty"`
+	Weight            string `json:",omitempty"`
 	HealthCheck       string `json:",omitempty"`
 }
+
+// Validate checks that the document has a single start point and that all endpoint and rule references resolve.
+func (doc *Route53TrafficPolicyDoc) Validate() error {
+	if (doc.StartEndpoint == "") == (doc.StartRule == "") {
+		return fmt.Errorf("exactly one of start_endpoint or start_rule must be specified")
+	}
+
+	if v := doc.StartEndpoint; v != "" {
+		if _, ok := doc.Endpoints[v]; !ok {
+			return fmt.Errorf("start_endpoint (%s) is not a defined endpoint", v)
+		}
+	}
+
+	if v := doc.StartRule; v != "" {
+		if _, ok := doc.Rules[v]; !ok {
+			return fmt.Errorf("start_rule (%s) is not a defined rule", v)
+		}
+	}
+
+	checkReferences := func(ruleID, endpointReference, ruleReference string) error {
+		if (endpointReference == "") == (ruleReference == "") {
+			return fmt.Errorf("rule (%s): exactly one of endpoint_reference or rule_reference must be specified", ruleID)
+		}
+
+		if endpointReference != "" {
+			if _, ok := doc.Endpoints[endpointReference]; !ok {
+				return fmt.Errorf("rule (%s): endpoint_reference (%s) is not a defined endpoint", ruleID, endpointReference)
+			}
+		}
+
+		if ruleReference != "" {
+			if ruleReference == ruleID {
+				return fmt.Errorf("rule (%s): rule_reference must not refer to the rule itself", ruleID)
+			}
+
+			if _, ok := doc.Rules[ruleReference]; !ok {
+				return fmt.Errorf("rule (%s): rule_reference (%s) is not a defined rule", ruleID, ruleReference)
+			}
+		}
+
+		return nil
+	}
+
+	for id, rule := range doc.Rules {
+		switch rule.RuleType {
+		case trafficPolicyDocRuleFailover:
+			if rule.Primary == nil || rule.Secondary == nil {
+				return fmt.Errorf("rule (%s): failover rules require primary and secondary", id)
+			}
+
+			for _, v := range []*TrafficPolicyFailoverRule{rule.Primary, rule.Secondary} {
+				if err := checkReferences(id, v.EndpointReference, v.RuleReference); err != nil {
+					return err
+				}
+			}
+		case trafficPolicyDocRuleGeo:
+			if len(rule.Locations) == 0 {
+				return fmt.Errorf("rule (%s): geo rules require at least one location", id)
+			}
+
+			for _, v := range rule.Locations {
+				if err := checkReferences(id, v.EndpointReference, v.RuleReference); err != nil {
+					return err
+				}
+			}
+		case trafficPolicyDocRuleGeoproximity:
+			if len(rule.GeoProximityLocations) == 0 {
+				return fmt.Errorf("rule (%s): geoproximity rules require at least one geo_proximity_location", id)
+			}
+
+			for _, v := range rule.GeoProximityLocations {
+				if err := checkReferences(id, v.EndpointReference, v.RuleReference); err != nil {
+					return err
+				}
+			}
+		case trafficPolicyDocRuleLatency:
+			if len(rule.Regions) == 0 {
+				return fmt.Errorf("rule (%s): latency rules require at least one region", id)
+			}
+
+			for _, v := range rule.Regions {
+				if err := checkReferences(id, v.EndpointReference, v.RuleReference); err != nil {
+					return err
+				}
+			}
+		case trafficPolicyDocRuleMultiValue:
+			if len(rule.Items) == 0 {
+				return fmt.Errorf("rule (%s): multivalue rules require at least one item", id)
+			}
+
+			for _, v := range rule.Items {
+				if v.RuleReference != "" {
+					return fmt.Errorf("rule (%s): multivalue items only support endpoint_reference", id)
+				}
+
+				if err := checkReferences(id, v.EndpointReference, ""); err != nil {
+					return err
+				}
+			}
+		case trafficPolicyDocRuleWeighted:
+			if len(rule.Items) == 0 {
+				return fmt.Errorf("rule (%s): weighted rules require at least one item", id)
+			}
+
+			for _, v := range rule.Items {
+				if v.Weight == "" {
+					return fmt.Errorf("rule (%s): weighted items require a weight", id)
+				}
+
+				if err := checkReferences(id, v.EndpointReference, v.RuleReference); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("rule (%s): unsupported rule type (%s)", id, rule.RuleType)
+		}
+	}
+
+	return nil
+}
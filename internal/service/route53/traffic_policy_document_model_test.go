@@ -0,0 +1,150 @@
+package route53
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestTrafficPolicyDocValidate(t *testing.T) {
+	t.Parallel()
+
+	endpoints := map[string]*TrafficPolicyEndpoint{
+		"one": {Type: trafficPolicyDocEndpointValue, Value: "10.0.0.1"},
+		"two": {Type: trafficPolicyDocEndpointValue, Value: "10.0.0.2"},
+	}
+
+	testCases := []struct {
+		Name        string
+		Doc         *Route53TrafficPolicyDoc
+		ExpectError *regexp.Regexp
+	}{
+		{
+			Name: "start endpoint",
+			Doc: &Route53TrafficPolicyDoc{
+				Endpoints:     endpoints,
+				StartEndpoint: "one",
+			},
+		},
+		{
+			Name: "no start",
+			Doc: &Route53TrafficPolicyDoc{
+				Endpoints: endpoints,
+			},
+			ExpectError: regexp.MustCompile(`exactly one of start_endpoint or start_rule`),
+		},
+		{
+			Name: "undefined start rule",
+			Doc: &Route53TrafficPolicyDoc{
+				Endpoints: endpoints,
+				StartRule: "missing",
+			},
+			ExpectError: regexp.MustCompile(`start_rule \(missing\) is not a defined rule`),
+		},
+		{
+			Name: "nested rules",
+			Doc: &Route53TrafficPolicyDoc{
+				Endpoints: endpoints,
+				Rules: map[string]*TrafficPolicyRule{
+					"failover": {
+						RuleType:  trafficPolicyDocRuleFailover,
+						Primary:   &TrafficPolicyFailoverRule{RuleReference: "weighted"},
+						Secondary: &TrafficPolicyFailoverRule{EndpointReference: "two"},
+					},
+					"weighted": {
+						RuleType: trafficPolicyDocRuleWeighted,
+						Items: []*TrafficPolicyMultiValueAnswerRule{
+							{EndpointReference: "one", Weight: "1"},
+							{EndpointReference: "two", Weight: "2"},
+						},
+					},
+				},
+				StartRule: "failover",
+			},
+		},
+		{
+			Name: "failover missing secondary",
+			Doc: &Route53TrafficPolicyDoc{
+				Endpoints: endpoints,
+				Rules: map[string]*TrafficPolicyRule{
+					"failover": {
+						RuleType: trafficPolicyDocRuleFailover,
+						Primary:  &TrafficPolicyFailoverRule{EndpointReference: "one"},
+					},
+				},
+				StartRule: "failover",
+			},
+			ExpectError: regexp.MustCompile(`failover rules require primary and secondary`),
+		},
+		{
+			Name: "self reference",
+			Doc: &Route53TrafficPolicyDoc{
+				Endpoints: endpoints,
+				Rules: map[string]*TrafficPolicyRule{
+					"failover": {
+						RuleType:  trafficPolicyDocRuleFailover,
+						Primary:   &TrafficPolicyFailoverRule{RuleReference: "failover"},
+						Secondary: &TrafficPolicyFailoverRule{EndpointReference: "two"},
+					},
+				},
+				StartRule: "failover",
+			},
+			ExpectError: regexp.MustCompile(`must not refer to the rule itself`),
+		},
+		{
+			Name: "undefined endpoint reference",
+			Doc: &Route53TrafficPolicyDoc{
+				Endpoints: endpoints,
+				Rules: map[string]*TrafficPolicyRule{
+					"multivalue": {
+						RuleType: trafficPolicyDocRuleMultiValue,
+						Items: []*TrafficPolicyMultiValueAnswerRule{
+							{EndpointReference: "three"},
+						},
+					},
+				},
+				StartRule: "multivalue",
+			},
+			ExpectError: regexp.MustCompile(`endpoint_reference \(three\) is not a defined endpoint`),
+		},
+		{
+			Name: "weighted item without weight",
+			Doc: &Route53TrafficPolicyDoc{
+				Endpoints: endpoints,
+				Rules: map[string]*TrafficPolicyRule{
+					"weighted": {
+						RuleType: trafficPolicyDocRuleWeighted,
+						Items: []*TrafficPolicyMultiValueAnswerRule{
+							{EndpointReference: "one"},
+						},
+					},
+				},
+				StartRule: "weighted",
+			},
+			ExpectError: regexp.MustCompile(`weight`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.Doc.Validate()
+
+			if testCase.ExpectError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error matching %q, got none", testCase.ExpectError)
+			}
+
+			if !testCase.ExpectError.MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got %q", testCase.ExpectError, err)
+			}
+		})
+	}
+}
@@ -7,11 +7,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/route53"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -28,19 +26,19 @@ func TestAccRoute53TrafficPolicyInstance_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v route53.TrafficPolicyInstance
 	resourceName := "aws_route53_traffic_policy_instance.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	zoneName := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTrafficPolicy(t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTrafficPolicyInstanceDestroy(ctx),
+		CheckDestroy:             testAccCheckTrafficPolicyInstanceDestroy(ctx, t),
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTrafficPolicyInstanceConfig_basic(rName, zoneName, 3600),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTrafficPolicyInstanceExists(ctx, resourceName, &v),
+					testAccCheckTrafficPolicyInstanceExists(ctx, t, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s.%s", rName, zoneName)),
 					resource.TestCheckResourceAttr(resourceName, "ttl", "3600"),
 				),
@@ -58,19 +56,19 @@ func TestAccRoute53TrafficPolicyInstance_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v route53.TrafficPolicyInstance
 	resourceName := "aws_route53_traffic_policy_instance.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	zoneName := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTrafficPolicy(t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTrafficPolicyInstanceDestroy(ctx),
+		CheckDestroy:             testAccCheckTrafficPolicyInstanceDestroy(ctx, t),
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTrafficPolicyInstanceConfig_basic(rName, zoneName, 360),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTrafficPolicyInstanceExists(ctx, resourceName, &v),
+					testAccCheckTrafficPolicyInstanceExists(ctx, t, resourceName, &v),
 					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfroute53.ResourceTrafficPolicyInstance(), resourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -83,26 +81,26 @@ func TestAccRoute53TrafficPolicyInstance_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v route53.TrafficPolicyInstance
 	resourceName := "aws_route53_traffic_policy_instance.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	zoneName := acctest.RandomDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTrafficPolicy(t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTrafficPolicyInstanceDestroy(ctx),
+		CheckDestroy:             testAccCheckTrafficPolicyInstanceDestroy(ctx, t),
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTrafficPolicyInstanceConfig_basic(rName, zoneName, 3600),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTrafficPolicyInstanceExists(ctx, resourceName, &v),
+					testAccCheckTrafficPolicyInstanceExists(ctx, t, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "ttl", "3600"),
 				),
 			},
 			{
 				Config: testAccTrafficPolicyInstanceConfig_basic(rName, zoneName, 7200),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTrafficPolicyInstanceExists(ctx, resourceName, &v),
+					testAccCheckTrafficPolicyInstanceExists(ctx, t, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "ttl", "7200"),
 				),
 			},
@@ -115,7 +113,7 @@ func TestAccRoute53TrafficPolicyInstance_update(t *testing.T) {
 	})
 }
 
-func testAccCheckTrafficPolicyInstanceExists(ctx context.Context, n string, v *route53.TrafficPolicyInstance) resource.TestCheckFunc {
+func testAccCheckTrafficPolicyInstanceExists(ctx context.Context, t *testing.T, n string, v *route53.TrafficPolicyInstance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -126,7 +124,7 @@ func testAccCheckTrafficPolicyInstanceExists(ctx context.Context, n string, v *r
 			return fmt.Errorf("No Route53 Traffic Policy Instance ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 
 		output, err := tfroute53.FindTrafficPolicyInstanceByID(ctx, conn, rs.Primary.ID)
 
@@ -140,9 +138,9 @@ func testAccCheckTrafficPolicyInstanceExists(ctx context.Context, n string, v *r
 	}
 }
 
-func testAccCheckTrafficPolicyInstanceDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckTrafficPolicyInstanceDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_route53_traffic_policy_instance" {
@@ -3,14 +3,13 @@ package route53_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/route53"
-	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -19,18 +18,18 @@ func TestAccRoute53TrafficPolicy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v route53.TrafficPolicy
 	resourceName := "aws_route53_traffic_policy.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTrafficPolicy(t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTrafficPolicyDestroy(ctx),
+		CheckDestroy:             testAccCheckTrafficPolicyDestroy(ctx, t),
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTrafficPolicyConfig_basic(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckTrafficPolicyExists(ctx, resourceName, &v),
+					testAccCheckTrafficPolicyExists(ctx, t, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "comment", ""),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "type", "A"),
@@ -51,18 +50,18 @@ func TestAccRoute53TrafficPolicy_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v route53.TrafficPolicy
 	resourceName := "aws_route53_traffic_policy.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTrafficPolicy(t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTrafficPolicyDestroy(ctx),
+		CheckDestroy:             testAccCheckTrafficPolicyDestroy(ctx, t),
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTrafficPolicyConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTrafficPolicyExists(ctx, resourceName, &v),
+					testAccCheckTrafficPolicyExists(ctx, t, resourceName, &v),
 					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfroute53.ResourceTrafficPolicy(), resourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -75,27 +74,27 @@ func TestAccRoute53TrafficPolicy_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v route53.TrafficPolicy
 	resourceName := "aws_route53_traffic_policy.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
 	comment := `comment`
 	commentUpdated := `comment updated`
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTrafficPolicy(t) },
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckTrafficPolicyDestroy(ctx),
+		CheckDestroy:             testAccCheckTrafficPolicyDestroy(ctx, t),
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTrafficPolicyConfig_complete(rName, comment),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTrafficPolicyExists(ctx, resourceName, &v),
+					testAccCheckTrafficPolicyExists(ctx, t, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "comment", comment),
 				),
 			},
 			{
 				Config: testAccTrafficPolicyConfig_complete(rName, commentUpdated),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTrafficPolicyExists(ctx, resourceName, &v),
+					testAccCheckTrafficPolicyExists(ctx, t, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "comment", commentUpdated),
 				),
 			},
@@ -109,7 +108,56 @@ func TestAccRoute53TrafficPolicy_update(t *testing.T) {
 	})
 }
 
-func testAccCheckTrafficPolicyExists(ctx context.Context, n string, v *route53.TrafficPolicy) resource.TestCheckFunc {
+func TestAccRoute53TrafficPolicy_documentConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v route53.TrafficPolicy
+	resourceName := "aws_route53_traffic_policy.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTrafficPolicy(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrafficPolicyDestroy(ctx, t),
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficPolicyConfig_documentConfig(rName, "10.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficPolicyExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "document"),
+				),
+			},
+			{
+				Config: testAccTrafficPolicyConfig_documentConfig(rName, "10.0.0.3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficPolicyExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53TrafficPolicy_documentConfigInvalid(t *testing.T) {
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTrafficPolicy(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTrafficPolicyConfig_documentConfigInvalid(rName),
+				ExpectError: regexp.MustCompile(`endpoint_reference \(missing\) is not a defined endpoint`),
+			},
+		},
+	})
+}
+
+func testAccCheckTrafficPolicyExists(ctx context.Context, t *testing.T, n string, v *route53.TrafficPolicy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -120,7 +168,7 @@ func testAccCheckTrafficPolicyExists(ctx context.Context, n string, v *route53.T
 			return fmt.Errorf("No Route53 Traffic Policy ID is set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 
 		output, err := tfroute53.FindTrafficPolicyByID(ctx, conn, rs.Primary.ID)
 
@@ -134,9 +182,9 @@ func testAccCheckTrafficPolicyExists(ctx context.Context, n string, v *route53.T
 	}
 }
 
-func testAccCheckTrafficPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckTrafficPolicyDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_route53_traffic_policy" {
@@ -212,3 +260,75 @@ EOT
 }
 `, rName, comment)
 }
+
+func testAccTrafficPolicyConfig_documentConfig(rName, secondary string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_traffic_policy" "test" {
+  name = %[1]q
+
+  document_config {
+    record_type = "A"
+    start_rule  = "site_switch"
+
+    endpoint {
+      id    = "primary"
+      type  = "value"
+      value = "10.0.0.1"
+    }
+
+    endpoint {
+      id    = "secondary"
+      type  = "value"
+      value = %[2]q
+    }
+
+    rule {
+      id   = "site_switch"
+      type = "failover"
+
+      primary {
+        endpoint_reference = "primary"
+      }
+
+      secondary {
+        endpoint_reference = "secondary"
+      }
+    }
+  }
+}
+`, rName, secondary)
+}
+
+func testAccTrafficPolicyConfig_documentConfigInvalid(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_traffic_policy" "test" {
+  name = %[1]q
+
+  document_config {
+    record_type = "A"
+    start_rule  = "weighted"
+
+    endpoint {
+      id    = "one"
+      type  = "value"
+      value = "10.0.0.1"
+    }
+
+    rule {
+      id   = "weighted"
+      type = "weighted"
+
+      items {
+        endpoint_reference = "one"
+        weight             = "1"
+      }
+
+      items {
+        endpoint_reference = "missing"
+        weight             = "1"
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,15 @@
+package route53
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var validCIDRCollectionID = validation.StringMatch(regexp.MustCompile(`^[0-9a-f]{8}-(?:[0-9a-f]{4}-){3}[0-9a-f]{12}$`), "must be a CIDR collection ID")
+
+// The location name "*" is the default location, matching any CIDR block not in the collection's locations.
+var validCIDRLocationName = validation.All(
+	validation.StringLenBetween(1, 16),
+	validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_\-\*]+$`), "can include letters, digits, underscore (_), the dash (-) and the asterisk (*) characters"),
+)
@@ -0,0 +1,68 @@
+package route53
+
+import (
+	"testing"
+)
+
+func TestValidCIDRCollectionID(t *testing.T) {
+	t.Parallel()
+
+	validIDs := []string{
+		"0e4a7b2c-1d3f-4a5b-8c6d-9e0f1a2b3c4d",
+		"00000000-0000-0000-0000-000000000000",
+	}
+	for _, v := range validIDs {
+		_, errors := validCIDRCollectionID(v, "collection_id")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CIDR collection ID: %q", v, errors)
+		}
+	}
+
+	invalidIDs := []string{
+		"",
+		"test-collection",
+		"0E4A7B2C-1D3F-4A5B-8C6D-9E0F1A2B3C4D",
+		"0e4a7b2c1d3f4a5b8c6d9e0f1a2b3c4d",
+		"0e4a7b2c-1d3f-4a5b-8c6d-9e0f1a2b3c4",
+		"0e4a7b2c-1d3f-4a5b-8c6d-9e0f1a2b3c4d5",
+		" 0e4a7b2c-1d3f-4a5b-8c6d-9e0f1a2b3c4d",
+	}
+	for _, v := range invalidIDs {
+		_, errors := validCIDRCollectionID(v, "collection_id")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CIDR collection ID", v)
+		}
+	}
+}
+
+func TestValidCIDRLocationName(t *testing.T) {
+	t.Parallel()
+
+	validNames := []string{
+		"*",
+		"a",
+		"location-1",
+		"location_1",
+		"ABCDEFGHIJKLMNOP",
+	}
+	for _, v := range validNames {
+		_, errors := validCIDRLocationName(v, "location_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CIDR location name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"ABCDEFGHIJKLMNOPQ",
+		"location 1",
+		"location.1",
+		"location/1",
+	}
+	for _, v := range invalidNames {
+		_, errors := validCIDRLocationName(v, "location_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CIDR location name", v)
+		}
+	}
+}
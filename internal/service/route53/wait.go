@@ -22,6 +22,7 @@ const (
 	hostedZoneDNSSECStatusTimeout = 5 * time.Minute
 
 	keySigningKeyStatusTimeout = 5 * time.Minute
+	keySigningKeyInUseTimeout  = 5 * time.Minute
 
 	trafficPolicyInstanceOperationTimeout = 4 * time.Minute
 )
@@ -71,6 +72,30 @@ func waitHostedZoneDNSSECStatusUpdated(ctx context.Context, conn *route53.Route5
 	return nil, err
 }
 
+// waitHostedZoneDNSSECStatusSettled waits for a hosted zone's DNSSEC signing status to leave ACTION_NEEDED
+// after a key-signing key has been activated or deactivated.
+func waitHostedZoneDNSSECStatusSettled(ctx context.Context, conn *route53.Route53, hostedZoneID string) (*route53.DNSSECStatus, error) { //nolint:unparam
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ServeSignatureActionNeeded},
+		Target:     []string{ServeSignatureSigning, ServeSignatureNotSigning},
+		Refresh:    statusHostedZoneDNSSEC(ctx, conn, hostedZoneID),
+		MinTimeout: 5 * time.Second,
+		Timeout:    hostedZoneDNSSECStatusTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*route53.DNSSECStatus); ok {
+		if serveSignature := aws.StringValue(output.ServeSignature); serveSignature == ServeSignatureActionNeeded || serveSignature == ServeSignatureInternalFailure {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitKeySigningKeyStatusUpdated(ctx context.Context, conn *route53.Route53, hostedZoneID string, name string, status string) (*route53.KeySigningKey, error) { //nolint:unparam
 	stateConf := &resource.StateChangeConf{
 		Target:     []string{status},
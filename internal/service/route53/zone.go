@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -115,10 +116,48 @@ func ResourceZone() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceZoneCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceZoneCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// A reusable delegation set can only be used by one hosted zone with a given name,
+	// otherwise the zones would share the same authoritative name servers.
+	if diff.Id() != "" || !diff.NewValueKnown("delegation_set_id") || !diff.NewValueKnown("name") {
+		return nil
+	}
+
+	delegationSetID := diff.Get("delegation_set_id").(string)
+
+	if delegationSetID == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).Route53Conn()
+	name := TrimTrailingPeriod(diff.Get("name").(string))
+
+	hostedZones, err := FindHostedZonesByDelegationSetID(ctx, conn, delegationSetID)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("Route 53 Reusable Delegation Set (%s) not found", delegationSetID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing Route 53 Hosted Zones using Reusable Delegation Set (%s): %w", delegationSetID, err)
+	}
+
+	for _, v := range hostedZones {
+		if strings.EqualFold(TrimTrailingPeriod(aws.StringValue(v.Name)), name) {
+			return fmt.Errorf("Route 53 Reusable Delegation Set (%s) is already used by Hosted Zone (%s) with name %s", delegationSetID, CleanZoneID(aws.StringValue(v.Id)), name)
+		}
+	}
+
+	return nil
+}
+
 func resourceZoneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53Conn()
@@ -403,7 +442,7 @@ func deleteAllRecordsInHostedZoneId(ctx context.Context, hostedZoneId, hostedZon
 		if out, ok := resp.(*route53.ChangeResourceRecordSetsOutput); ok {
 			log.Printf("[DEBUG] Waiting for change batch to become INSYNC: %#v", out)
 			if out.ChangeInfo != nil && out.ChangeInfo.Id != nil {
-				lastErrorFromWaiter = WaitForRecordSetToSync(ctx, conn, CleanChangeID(aws.StringValue(out.ChangeInfo.Id)))
+				lastErrorFromWaiter = WaitForRecordSetToSync(ctx, conn, CleanChangeID(aws.StringValue(out.ChangeInfo.Id)), recordSetSyncTimeout)
 			} else {
 				log.Printf("[DEBUG] Change info was empty")
 			}
@@ -646,7 +685,7 @@ func hostedZoneVPCAssociate(ctx context.Context, conn *route53.Route53, zoneID s
 	output, err := conn.AssociateVPCWithHostedZoneWithContext(ctx, input)
 
 	if err != nil {
-		return fmt.Errorf("associating Route53 Hosted Zone (%s) to VPC (%s): %s", zoneID, aws.StringValue(vpc.VPCId), err)
+		return fmt.Errorf("associating Route53 Hosted Zone (%s) to VPC (%s): %w", zoneID, aws.StringValue(vpc.VPCId), err)
 	}
 
 	if err := waitForChangeSynchronization(ctx, conn, CleanChangeID(aws.StringValue(output.ChangeInfo.Id))); err != nil {
@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
 )
@@ -21,16 +20,16 @@ func TestAccRoute53ZoneAssociation_basic(t *testing.T) {
 
 	domainName := acctest.RandomFQDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckZoneAssociationDestroy(ctx),
+		CheckDestroy:             testAccCheckZoneAssociationDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccZoneAssociationConfig_basic(domainName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckZoneAssociationExists(ctx, resourceName),
+					testAccCheckZoneAssociationExists(ctx, t, resourceName),
 				),
 			},
 			{
@@ -48,16 +47,16 @@ func TestAccRoute53ZoneAssociation_disappears(t *testing.T) {
 
 	domainName := acctest.RandomFQDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckZoneAssociationDestroy(ctx),
+		CheckDestroy:             testAccCheckZoneAssociationDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccZoneAssociationConfig_basic(domainName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckZoneAssociationExists(ctx, resourceName),
+					testAccCheckZoneAssociationExists(ctx, t, resourceName),
 					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfroute53.ResourceZoneAssociation(), resourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -73,16 +72,16 @@ func TestAccRoute53ZoneAssociation_Disappears_vpc(t *testing.T) {
 
 	domainName := acctest.RandomFQDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckZoneAssociationDestroy(ctx),
+		CheckDestroy:             testAccCheckZoneAssociationDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccZoneAssociationConfig_basic(domainName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckZoneAssociationExists(ctx, resourceName),
+					testAccCheckZoneAssociationExists(ctx, t, resourceName),
 					acctest.CheckResourceDisappears(ctx, acctest.Provider, ec2.ResourceVPC(), vpcResourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -98,16 +97,16 @@ func TestAccRoute53ZoneAssociation_Disappears_zone(t *testing.T) {
 
 	domainName := acctest.RandomFQDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckZoneAssociationDestroy(ctx),
+		CheckDestroy:             testAccCheckZoneAssociationDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccZoneAssociationConfig_basic(domainName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckZoneAssociationExists(ctx, resourceName),
+					testAccCheckZoneAssociationExists(ctx, t, resourceName),
 					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfroute53.ResourceZone(), route53ZoneResourceName),
 				),
 				ExpectNonEmptyPlan: true,
@@ -121,19 +120,19 @@ func TestAccRoute53ZoneAssociation_crossAccount(t *testing.T) {
 	resourceName := "aws_route53_zone_association.test"
 	domainName := acctest.RandomFQDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckAlternateAccount(t)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
-		CheckDestroy:             testAccCheckZoneAssociationDestroy(ctx),
+		CheckDestroy:             testAccCheckZoneAssociationDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccZoneAssociationConfig_crossAccount(domainName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckZoneAssociationExists(ctx, resourceName),
+					testAccCheckZoneAssociationExists(ctx, t, resourceName),
 				),
 			},
 			{
@@ -151,19 +150,19 @@ func TestAccRoute53ZoneAssociation_crossRegion(t *testing.T) {
 	resourceName := "aws_route53_zone_association.test"
 	domainName := acctest.RandomFQDomainName()
 
-	resource.ParallelTest(t, resource.TestCase{
+	acctest.ParallelTest(t, resource.TestCase{
 		PreCheck: func() {
 			acctest.PreCheck(t)
 			acctest.PreCheckMultipleRegion(t, 2)
 		},
 		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
 		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
-		CheckDestroy:             testAccCheckZoneAssociationDestroy(ctx),
+		CheckDestroy:             testAccCheckZoneAssociationDestroy(ctx, t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccZoneAssociationConfig_region(domainName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckZoneAssociationExists(ctx, resourceName),
+					testAccCheckZoneAssociationExists(ctx, t, resourceName),
 				),
 			},
 			{
@@ -176,9 +175,9 @@ func TestAccRoute53ZoneAssociation_crossRegion(t *testing.T) {
 	})
 }
 
-func testAccCheckZoneAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+func testAccCheckZoneAssociationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_route53_zone_association" {
 				continue
@@ -208,7 +207,7 @@ func testAccCheckZoneAssociationDestroy(ctx context.Context) resource.TestCheckF
 	}
 }
 
-func testAccCheckZoneAssociationExists(ctx context.Context, resourceName string) resource.TestCheckFunc {
+func testAccCheckZoneAssociationExists(ctx context.Context, t *testing.T, resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
 		if !ok {
@@ -225,7 +224,7 @@ func testAccCheckZoneAssociationExists(ctx context.Context, resourceName string)
 			return err
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn()
+		conn := acctest.ProviderMeta(t).Route53Conn()
 
 		hostedZoneSummary, err := tfroute53.GetZoneAssociation(ctx, conn, zoneID, vpcID, vpcRegion)
 
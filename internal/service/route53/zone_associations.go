@@ -0,0 +1,300 @@
+package route53
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceZoneAssociations() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceZoneAssociationsCreate,
+		ReadWithoutTimeout:   resourceZoneAssociationsRead,
+		UpdateWithoutTimeout: resourceZoneAssociationsUpdate,
+		DeleteWithoutTimeout: resourceZoneAssociationsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"authorize_cross_account": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"pending_authorizations": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vpc": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"vpc_region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+				Set: hostedZoneVPCHash,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceZoneAssociationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	output, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+
+	// Reconcile the complete set of associations, including any that already exist.
+	vpcs := expandVPCs(d.Get("vpc").(*schema.Set).List(), meta.(*conns.AWSClient).Region)
+	if err := reconcileZoneAssociations(ctx, conn, zoneID, output.VPCs, vpcs, d.Get("authorize_cross_account").(bool)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Route 53 Zone Associations (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceZoneAssociationsRead(ctx, d, meta)...)
+}
+
+func resourceZoneAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	output, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Hosted Zone (%s) not found, removing Route 53 Zone Associations from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Zone Associations (%s): %s", d.Id(), err)
+	}
+
+	vpcs := output.VPCs
+	var pending []*route53.VPC
+
+	if d.Get("authorize_cross_account").(bool) {
+		authorized, err := FindVPCAssociationAuthorizationsByZoneID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route 53 Zone Associations (%s): %s", d.Id(), err)
+		}
+
+		// Authorized VPCs that the VPC owner has not yet associated are reported as part of the configured set.
+		for _, v := range authorized {
+			if !hostedZoneVPCsContain(output.VPCs, v) {
+				pending = append(pending, v)
+				vpcs = append(vpcs, v)
+			}
+		}
+	}
+
+	if err := d.Set("pending_authorizations", flattenVPCs(pending)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting pending_authorizations: %s", err)
+	}
+	if err := d.Set("vpc", flattenVPCs(vpcs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpc: %s", err)
+	}
+	d.Set("zone_id", d.Id())
+
+	return diags
+}
+
+func resourceZoneAssociationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	if d.HasChange("vpc") {
+		output, err := FindHostedZoneByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", d.Id(), err)
+		}
+
+		vpcs := expandVPCs(d.Get("vpc").(*schema.Set).List(), meta.(*conns.AWSClient).Region)
+		if err := reconcileZoneAssociations(ctx, conn, d.Id(), output.VPCs, vpcs, d.Get("authorize_cross_account").(bool)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Route 53 Zone Associations (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceZoneAssociationsRead(ctx, d, meta)...)
+}
+
+func resourceZoneAssociationsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	for _, vpc := range expandVPCs(d.Get("vpc").(*schema.Set).List(), meta.(*conns.AWSClient).Region) {
+		err := disassociateZoneVPC(ctx, conn, d.Id(), vpc)
+
+		// A private hosted zone must always be associated with at least one VPC.
+		if tfawserr.ErrCodeEquals(err, route53.ErrCodeLastVPCAssociation) {
+			log.Printf("[WARN] Route 53 Hosted Zone (%s) VPC (%s) is the last associated VPC, leaving association in place", d.Id(), aws.StringValue(vpc.VPCId))
+			continue
+		}
+
+		if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting Route 53 Zone Associations (%s): %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+// reconcileZoneAssociations associates the hosted zone with all wanted VPCs and then
+// disassociates any other VPCs, so that the zone is never left without an association.
+func reconcileZoneAssociations(ctx context.Context, conn *route53.Route53, zoneID string, existing, wanted []*route53.VPC, authorizeCrossAccount bool) error {
+	for _, vpc := range wanted {
+		if hostedZoneVPCsContain(existing, vpc) {
+			continue
+		}
+
+		err := hostedZoneVPCAssociate(ctx, conn, zoneID, vpc)
+
+		// The hosted zone owner cannot associate a VPC in another account.
+		// Authorize the association instead so that the VPC owner can complete it, e.g. with aws_route53_zone_association.
+		if authorizeCrossAccount && tfawserr.ErrCodeEquals(err, route53.ErrCodeNotAuthorizedException) {
+			log.Printf("[DEBUG] Authorizing Route 53 Hosted Zone (%s) association with VPC (%s)", zoneID, aws.StringValue(vpc.VPCId))
+			_, err = conn.CreateVPCAssociationAuthorizationWithContext(ctx, &route53.CreateVPCAssociationAuthorizationInput{
+				HostedZoneId: aws.String(zoneID),
+				VPC:          vpc,
+			})
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, vpc := range existing {
+		if hostedZoneVPCsContain(wanted, vpc) {
+			continue
+		}
+
+		if err := disassociateZoneVPC(ctx, conn, zoneID, vpc); err != nil {
+			return err
+		}
+	}
+
+	if authorizeCrossAccount {
+		authorized, err := FindVPCAssociationAuthorizationsByZoneID(ctx, conn, zoneID)
+
+		if err != nil {
+			return err
+		}
+
+		for _, vpc := range authorized {
+			if hostedZoneVPCsContain(wanted, vpc) {
+				continue
+			}
+
+			if err := deleteVPCAssociationAuthorization(ctx, conn, zoneID, vpc); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func disassociateZoneVPC(ctx context.Context, conn *route53.Route53, zoneID string, vpc *route53.VPC) error {
+	input := &route53.DisassociateVPCFromHostedZoneInput{
+		HostedZoneId: aws.String(zoneID),
+		VPC:          vpc,
+	}
+
+	log.Printf("[DEBUG] Disassociating Route53 Hosted Zone with VPC: %s", input)
+	output, err := conn.DisassociateVPCFromHostedZoneWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeVPCAssociationNotFound) {
+		// The VPC may only have been authorized.
+		return deleteVPCAssociationAuthorization(ctx, conn, zoneID, vpc)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err := waitForChangeSynchronization(ctx, conn, CleanChangeID(aws.StringValue(output.ChangeInfo.Id))); err != nil {
+		return fmt.Errorf("waiting for Route53 Hosted Zone (%s) disassociation from VPC (%s): %w", zoneID, aws.StringValue(vpc.VPCId), err)
+	}
+
+	return deleteVPCAssociationAuthorization(ctx, conn, zoneID, vpc)
+}
+
+func deleteVPCAssociationAuthorization(ctx context.Context, conn *route53.Route53, zoneID string, vpc *route53.VPC) error {
+	_, err := conn.DeleteVPCAssociationAuthorizationWithContext(ctx, &route53.DeleteVPCAssociationAuthorizationInput{
+		HostedZoneId: aws.String(zoneID),
+		VPC:          vpc,
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeVPCAssociationAuthorizationNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting Route53 VPC Association Authorization (%s:%s): %w", zoneID, aws.StringValue(vpc.VPCId), err)
+	}
+
+	return nil
+}
+
+func hostedZoneVPCsContain(vpcs []*route53.VPC, vpc *route53.VPC) bool {
+	for _, v := range vpcs {
+		if aws.StringValue(v.VPCId) == aws.StringValue(vpc.VPCId) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,106 @@
+package route53_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53ZoneAssociations_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_zone_associations.test"
+	dataSourceName := "data.aws_route53_zone.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	domainName := acctest.RandomFQDomainName()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneAssociationsConfig_basic(rName, domainName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttr(resourceName, "vpc.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "pending_authorizations.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"authorize_cross_account"},
+			},
+			{
+				Config: testAccZoneAssociationsConfig_basic(rName, domainName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "vpc.#", "3"),
+				),
+			},
+			{
+				Config: testAccZoneAssociationsConfig_dataSource(rName, domainName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "vpc.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpcs.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpcs.0.vpc_id", "aws_vpc.test.0", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccZoneAssociationsConfig_basic(rName, domainName string, n int) string {
+	var vpcs []string
+	for i := 0; i < n; i++ {
+		vpcs = append(vpcs, fmt.Sprintf(`
+  vpc {
+    vpc_id = aws_vpc.test[%[1]d].id
+  }
+`, i))
+	}
+
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = 3
+
+  cidr_block           = "10.${count.index}.0.0/16"
+  enable_dns_hostnames = true
+  enable_dns_support   = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_zone" "test" {
+  name = %[2]q
+
+  vpc {
+    vpc_id = aws_vpc.test[0].id
+  }
+
+  lifecycle {
+    ignore_changes = [vpc]
+  }
+}
+
+resource "aws_route53_zone_associations" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+%[3]s
+}
+`, rName, domainName, strings.Join(vpcs, ""))
+}
+
+func testAccZoneAssociationsConfig_dataSource(rName, domainName string, n int) string {
+	return acctest.ConfigCompose(testAccZoneAssociationsConfig_basic(rName, domainName, n), `
+data "aws_route53_zone" "test" {
+  zone_id = aws_route53_zone_associations.test.zone_id
+}
+`)
+}
@@ -70,6 +70,22 @@ func DataSourceZone() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"vpcs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"zone_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -198,6 +214,22 @@ func dataSourceZoneRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "setting name_servers: %s", err)
 	}
 
+	// All VPC associations, including those of VPCs in other accounts.
+	var vpcs []*route53.VPC
+	if aws.BoolValue(hostedZoneFound.Config.PrivateZone) {
+		output, err := FindHostedZoneByID(ctx, conn, idHostedZone)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", idHostedZone, err)
+		}
+
+		vpcs = output.VPCs
+	}
+
+	if err := d.Set("vpcs", flattenVPCs(vpcs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpcs: %s", err)
+	}
+
 	tags, err = ListTags(ctx, conn, idHostedZone, route53.TagResourceTypeHostedzone)
 
 	if err != nil {
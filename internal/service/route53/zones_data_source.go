@@ -0,0 +1,208 @@
+package route53
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceZones() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"name_suffix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"private_zone": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"tags": tftags.TagsSchema(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_zone": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"resource_record_set_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn()
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	nameSuffix := strings.ToLower(TrimTrailingPeriod(d.Get("name_suffix").(string)))
+	privateZone := d.GetRawConfig().GetAttr("private_zone")
+	vpcID := d.Get("vpc_id").(string)
+	tags := tftags.New(ctx, d.Get("tags").(map[string]interface{})).IgnoreAWS()
+
+	var hostedZones []*route53.HostedZone
+
+	err := conn.ListHostedZonesPagesWithContext(ctx, &route53.ListHostedZonesInput{}, func(page *route53.ListHostedZonesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.HostedZones {
+			if v == nil {
+				continue
+			}
+
+			name := TrimTrailingPeriod(aws.StringValue(v.Name))
+
+			if nameRegex != nil && !nameRegex.MatchString(name) {
+				continue
+			}
+
+			if nameSuffix != "" {
+				if lowerName := strings.ToLower(name); lowerName != nameSuffix && !strings.HasSuffix(lowerName, "."+nameSuffix) {
+					continue
+				}
+			}
+
+			isPrivate := v.Config != nil && aws.BoolValue(v.Config.PrivateZone)
+
+			if !privateZone.IsNull() && privateZone.True() != isPrivate {
+				continue
+			}
+
+			if vpcID != "" && !isPrivate {
+				continue
+			}
+
+			hostedZones = append(hostedZones, v)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Route 53 Hosted Zones: %s", err)
+	}
+
+	var ids, names []string
+	var tfList []interface{}
+
+	for _, v := range hostedZones {
+		zoneID := CleanZoneID(aws.StringValue(v.Id))
+
+		if vpcID != "" {
+			output, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", zoneID, err)
+			}
+
+			if !hostedZoneVPCsContain(output.VPCs, &route53.VPC{VPCId: aws.String(vpcID)}) {
+				continue
+			}
+		}
+
+		if len(tags) > 0 {
+			zoneTags, err := ListTags(ctx, conn, zoneID, route53.TagResourceTypeHostedzone)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "listing Route 53 Hosted Zone (%s) tags: %s", zoneID, err)
+			}
+
+			if !zoneTags.ContainsAll(tags) {
+				continue
+			}
+		}
+
+		name := TrimTrailingPeriod(aws.StringValue(v.Name))
+		tfMap := map[string]interface{}{
+			"arn": arn.ARN{
+				Partition: meta.(*conns.AWSClient).Partition,
+				Service:   "route53",
+				Resource:  fmt.Sprintf("hostedzone/%s", zoneID),
+			}.String(),
+			"name":                      name,
+			"resource_record_set_count": aws.Int64Value(v.ResourceRecordSetCount),
+			"zone_id":                   zoneID,
+		}
+
+		if v.Config != nil {
+			tfMap["comment"] = aws.StringValue(v.Config.Comment)
+			tfMap["private_zone"] = aws.BoolValue(v.Config.PrivateZone)
+		}
+
+		ids = append(ids, zoneID)
+		names = append(names, name)
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Partition)
+	d.Set("ids", ids)
+	d.Set("names", names)
+
+	if err := d.Set("zones", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting zones: %s", err)
+	}
+
+	return diags
+}
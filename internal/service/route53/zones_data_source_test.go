@@ -0,0 +1,80 @@
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53ZonesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_zones.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+
+	acctest.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZonesDataSourceConfig_basic(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "zones.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", "aws_route53_zone.test.0", "zone_id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", "aws_route53_zone.test.1", "zone_id"),
+					resource.TestCheckResourceAttr("data.aws_route53_zones.private", "ids.#", "0"),
+					resource.TestCheckResourceAttr("data.aws_route53_zones.other_tag", "ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccZonesDataSourceConfig_basic(rName, domain string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  count = 2
+
+  name = "sub${count.index}.%[2]s"
+
+  tags = {
+    Name  = %[1]q
+    Index = count.index
+  }
+}
+
+data "aws_route53_zones" "test" {
+  name_suffix = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_route53_zone.test]
+}
+
+data "aws_route53_zones" "private" {
+  name_suffix  = %[2]q
+  private_zone = true
+
+  depends_on = [aws_route53_zone.test]
+}
+
+data "aws_route53_zones" "other_tag" {
+  name_suffix = %[2]q
+
+  tags = {
+    Index = "1"
+  }
+
+  depends_on = [aws_route53_zone.test]
+}
+`, rName, domain)
+}
@@ -3,6 +3,7 @@ package route53resolver
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -35,10 +37,21 @@ func ResourceFirewallDomainList() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"domain_file_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 1024),
+				ConflictsWith: []string{"domains"},
+			},
 			"domains": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"domain_file_url"},
+				// Domains imported from a file are read back but not managed individually.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Get("domain_file_url").(string) != ""
+				},
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -77,19 +90,13 @@ func resourceFirewallDomainListCreate(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(aws.StringValue(output.FirewallDomainList.Id))
 
-	if v, ok := d.GetOk("domains"); ok && v.(*schema.Set).Len() > 0 {
-		_, err := conn.UpdateFirewallDomainsWithContext(ctx, &route53resolver.UpdateFirewallDomainsInput{
-			FirewallDomainListId: aws.String(d.Id()),
-			Domains:              flex.ExpandStringSet(v.(*schema.Set)),
-			Operation:            aws.String(route53resolver.FirewallDomainUpdateOperationAdd),
-		})
-
-		if err != nil {
-			return diag.Errorf("updating Route53 Resolver Firewall Domain List (%s) domains: %s", d.Id(), err)
+	if v, ok := d.GetOk("domain_file_url"); ok {
+		if err := importFirewallDomains(ctx, conn, d.Id(), v.(string)); err != nil {
+			return diag.Errorf("importing Route53 Resolver Firewall Domain List (%s) domains: %s", d.Id(), err)
 		}
-
-		if _, err = waitFirewallDomainListUpdated(ctx, conn, d.Id()); err != nil {
-			return diag.Errorf("waiting for Route53 Resolver Firewall Domain List (%s) update: %s", d.Id(), err)
+	} else if v, ok := d.GetOk("domains"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateFirewallDomains(ctx, conn, d.Id(), route53resolver.FirewallDomainUpdateOperationAdd, flex.ExpandStringValueSet(v.(*schema.Set))); err != nil {
+			return diag.Errorf("updating Route53 Resolver Firewall Domain List (%s) domains: %s", d.Id(), err)
 		}
 	}
 
@@ -161,7 +168,13 @@ func resourceFirewallDomainListRead(ctx context.Context, d *schema.ResourceData,
 func resourceFirewallDomainListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).Route53ResolverConn()
 
-	if d.HasChange("domains") {
+	if v := d.Get("domain_file_url").(string); v != "" {
+		if d.HasChange("domain_file_url") {
+			if err := importFirewallDomains(ctx, conn, d.Id(), v); err != nil {
+				return diag.Errorf("importing Route53 Resolver Firewall Domain List (%s) domains: %s", d.Id(), err)
+			}
+		}
+	} else if d.HasChanges("domain_file_url", "domains") {
 		o, n := d.GetChange("domains")
 		if o == nil {
 			o = new(schema.Set)
@@ -172,26 +185,16 @@ func resourceFirewallDomainListUpdate(ctx context.Context, d *schema.ResourceDat
 		os := o.(*schema.Set)
 		ns := n.(*schema.Set)
 
-		domains := ns
-		operation := route53resolver.FirewallDomainUpdateOperationReplace
-
-		if domains.Len() == 0 {
-			domains = os
-			operation = route53resolver.FirewallDomainUpdateOperationRemove
+		if del := flex.ExpandStringValueSet(os.Difference(ns)); len(del) > 0 {
+			if err := updateFirewallDomains(ctx, conn, d.Id(), route53resolver.FirewallDomainUpdateOperationRemove, del); err != nil {
+				return diag.Errorf("updating Route53 Resolver Firewall Domain List (%s) domains: %s", d.Id(), err)
+			}
 		}
 
-		_, err := conn.UpdateFirewallDomainsWithContext(ctx, &route53resolver.UpdateFirewallDomainsInput{
-			FirewallDomainListId: aws.String(d.Id()),
-			Domains:              flex.ExpandStringSet(domains),
-			Operation:            aws.String(operation),
-		})
-
-		if err != nil {
-			return diag.Errorf("updating Route53 Resolver Firewall Domain List (%s) domains: %s", d.Id(), err)
-		}
-
-		if _, err = waitFirewallDomainListUpdated(ctx, conn, d.Id()); err != nil {
-			return diag.Errorf("waiting for Route53 Resolver Firewall Domain List (%s) update: %s", d.Id(), err)
+		if add := flex.ExpandStringValueSet(ns.Difference(os)); len(add) > 0 {
+			if err := updateFirewallDomains(ctx, conn, d.Id(), route53resolver.FirewallDomainUpdateOperationAdd, add); err != nil {
+				return diag.Errorf("updating Route53 Resolver Firewall Domain List (%s) domains: %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -229,6 +232,68 @@ func resourceFirewallDomainListDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+// firewallDomainsUpdateMaxDomains is the maximum number of domains accepted by a single UpdateFirewallDomains call.
+const firewallDomainsUpdateMaxDomains = 1000
+
+// updateFirewallDomains adds or removes domains in batches that fit within the UpdateFirewallDomains API limit.
+func updateFirewallDomains(ctx context.Context, conn *route53resolver.Route53Resolver, id, operation string, domains []string) error {
+	for _, chunk := range chunkFirewallDomains(domains, firewallDomainsUpdateMaxDomains) {
+		_, err := conn.UpdateFirewallDomainsWithContext(ctx, &route53resolver.UpdateFirewallDomainsInput{
+			FirewallDomainListId: aws.String(id),
+			Domains:              aws.StringSlice(chunk),
+			Operation:            aws.String(operation),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if _, err := waitFirewallDomainListUpdated(ctx, conn, id); err != nil {
+			return fmt.Errorf("waiting for update: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func importFirewallDomains(ctx context.Context, conn *route53resolver.Route53Resolver, id, domainFileURL string) error {
+	_, err := conn.ImportFirewallDomainsWithContext(ctx, &route53resolver.ImportFirewallDomainsInput{
+		DomainFileUrl:        aws.String(domainFileURL),
+		FirewallDomainListId: aws.String(id),
+		Operation:            aws.String(route53resolver.FirewallDomainImportOperationReplace),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	output, err := waitFirewallDomainListUpdated(ctx, conn, id)
+
+	if err != nil {
+		return fmt.Errorf("waiting for import: %w", err)
+	}
+
+	if status := aws.StringValue(output.Status); status == route53resolver.FirewallDomainListStatusCompleteImportFailed {
+		return fmt.Errorf("import failed: %s", aws.StringValue(output.StatusMessage))
+	}
+
+	return nil
+}
+
+func chunkFirewallDomains(domains []string, size int) [][]string {
+	var chunks [][]string
+
+	for size < len(domains) {
+		domains, chunks = domains[size:], append(chunks, domains[0:size:size])
+	}
+
+	if len(domains) > 0 {
+		chunks = append(chunks, domains)
+	}
+
+	return chunks
+}
+
 func FindFirewallDomainListByID(ctx context.Context, conn *route53resolver.Route53Resolver, id string) (*route53resolver.FirewallDomainList, error) {
 	input := &route53resolver.GetFirewallDomainListInput{
 		FirewallDomainListId: aws.String(id),
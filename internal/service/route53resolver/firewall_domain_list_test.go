@@ -95,6 +95,70 @@ func TestAccRoute53ResolverFirewallDomainList_domains(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverFirewallDomainList_manyDomains(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v route53resolver.FirewallDomainList
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_firewall_domain_list.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallDomainListDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallDomainListConfig_many(rName, 1501),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallDomainListExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "1501"),
+				),
+			},
+			{
+				Config: testAccFirewallDomainListConfig_many(rName, 1001),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallDomainListExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "1001"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ResolverFirewallDomainList_domainFileURL(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v route53resolver.FirewallDomainList
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_firewall_domain_list.test"
+	domainName1 := acctest.RandomFQDomainName()
+	domainName2 := acctest.RandomFQDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallDomainListDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallDomainListConfig_domainFileURL(rName, "v1", domainName1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallDomainListExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "domains.*", domainName1),
+				),
+			},
+			{
+				Config: testAccFirewallDomainListConfig_domainFileURL(rName, "v2", domainName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallDomainListExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "domains.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "domains.*", domainName2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoute53ResolverFirewallDomainList_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v route53resolver.FirewallDomainList
@@ -236,6 +300,35 @@ resource "aws_route53_resolver_firewall_domain_list" "test" {
 `, rName, domain)
 }
 
+func testAccFirewallDomainListConfig_many(rName string, n int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_resolver_firewall_domain_list" "test" {
+  name    = %[1]q
+  domains = [for i in range(%[2]d) : "d${i}.%[1]s.example.com"]
+}
+`, rName, n)
+}
+
+func testAccFirewallDomainListConfig_domainFileURL(rName, key, domain string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "%[2]s/domains.txt"
+  content = "%[3]s\n"
+}
+
+resource "aws_route53_resolver_firewall_domain_list" "test" {
+  name            = %[1]q
+  domain_file_url = "s3://${aws_s3_object.test.bucket}/${aws_s3_object.test.key}"
+}
+`, rName, key, domain)
+}
+
 func testAccFirewallDomainListConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_route53_resolver_firewall_domain_list" "test" {
@@ -28,6 +28,11 @@ func ResourceQueryLogConfig() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(queryLogConfigCreatedTimeout),
+			Delete: schema.DefaultTimeout(queryLogConfigDeletedTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -37,7 +42,7 @@ func ResourceQueryLogConfig() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: verify.ValidARN,
+				ValidateFunc: validQueryLogConfigDestinationARN,
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -85,7 +90,7 @@ func resourceQueryLogConfigCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(aws.StringValue(output.ResolverQueryLogConfig.Id))
 
-	if _, err := waitQueryLogConfigCreated(ctx, conn, d.Id()); err != nil {
+	if _, err := waitQueryLogConfigCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("waiting for Route53 Resolver Query Log Config (%s) create: %s", d.Id(), err)
 	}
 
@@ -166,7 +171,7 @@ func resourceQueryLogConfigDelete(ctx context.Context, d *schema.ResourceData, m
 		return diag.Errorf("deleting Route53 Resolver Query Log Config (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitQueryLogConfigDeleted(ctx, conn, d.Id()); err != nil {
+	if _, err := waitQueryLogConfigDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return diag.Errorf("waiting for Route53 Resolver Query Log Config (%s) delete: %s", d.Id(), err)
 	}
 
@@ -219,12 +224,12 @@ const (
 	queryLogConfigDeletedTimeout = 5 * time.Minute
 )
 
-func waitQueryLogConfigCreated(ctx context.Context, conn *route53resolver.Route53Resolver, id string) (*route53resolver.ResolverQueryLogConfig, error) {
+func waitQueryLogConfigCreated(ctx context.Context, conn *route53resolver.Route53Resolver, id string, timeout time.Duration) (*route53resolver.ResolverQueryLogConfig, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{route53resolver.ResolverQueryLogConfigStatusCreating},
 		Target:  []string{route53resolver.ResolverQueryLogConfigStatusCreated},
 		Refresh: statusQueryLogConfig(ctx, conn, id),
-		Timeout: queryLogConfigCreatedTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -236,12 +241,12 @@ func waitQueryLogConfigCreated(ctx context.Context, conn *route53resolver.Route5
 	return nil, err
 }
 
-func waitQueryLogConfigDeleted(ctx context.Context, conn *route53resolver.Route53Resolver, id string) (*route53resolver.ResolverQueryLogConfig, error) {
+func waitQueryLogConfigDeleted(ctx context.Context, conn *route53resolver.Route53Resolver, id string, timeout time.Duration) (*route53resolver.ResolverQueryLogConfig, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{route53resolver.ResolverQueryLogConfigStatusDeleting},
 		Target:  []string{},
 		Refresh: statusQueryLogConfig(ctx, conn, id),
-		Timeout: queryLogConfigDeletedTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
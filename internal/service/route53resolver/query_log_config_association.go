@@ -26,6 +26,11 @@ func ResourceQueryLogConfigAssociation() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(queryLogConfigAssociationCreatedTimeout),
+			Delete: schema.DefaultTimeout(queryLogConfigAssociationDeletedTimeout),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"resolver_query_log_config_id": {
 				Type:     schema.TypeString,
@@ -57,7 +62,7 @@ func resourceQueryLogConfigAssociationCreate(ctx context.Context, d *schema.Reso
 
 	d.SetId(aws.StringValue(output.ResolverQueryLogConfigAssociation.Id))
 
-	if _, err := waitQueryLogConfigAssociationCreated(ctx, conn, d.Id()); err != nil {
+	if _, err := waitQueryLogConfigAssociationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return diag.Errorf("waiting for Route53 Resolver Query Log Config Association (%s) create: %s", d.Id(), err)
 	}
 
@@ -102,7 +107,7 @@ func resourceQueryLogConfigAssociationDelete(ctx context.Context, d *schema.Reso
 		return diag.Errorf("deleting Route53 Resolver Query Log Config Association (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitQueryLogConfigAssociationDeleted(ctx, conn, d.Id()); err != nil {
+	if _, err := waitQueryLogConfigAssociationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return diag.Errorf("waiting for Route53 Resolver Query Log Config Association (%s) delete: %s", d.Id(), err)
 	}
 
@@ -155,12 +160,12 @@ const (
 	queryLogConfigAssociationDeletedTimeout = 5 * time.Minute
 )
 
-func waitQueryLogConfigAssociationCreated(ctx context.Context, conn *route53resolver.Route53Resolver, id string) (*route53resolver.ResolverQueryLogConfigAssociation, error) {
+func waitQueryLogConfigAssociationCreated(ctx context.Context, conn *route53resolver.Route53Resolver, id string, timeout time.Duration) (*route53resolver.ResolverQueryLogConfigAssociation, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{route53resolver.ResolverQueryLogConfigAssociationStatusCreating},
 		Target:  []string{route53resolver.ResolverQueryLogConfigAssociationStatusActive},
 		Refresh: statusQueryLogConfigAssociation(ctx, conn, id),
-		Timeout: queryLogConfigAssociationCreatedTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -176,12 +181,12 @@ func waitQueryLogConfigAssociationCreated(ctx context.Context, conn *route53reso
 	return nil, err
 }
 
-func waitQueryLogConfigAssociationDeleted(ctx context.Context, conn *route53resolver.Route53Resolver, id string) (*route53resolver.ResolverQueryLogConfigAssociation, error) {
+func waitQueryLogConfigAssociationDeleted(ctx context.Context, conn *route53resolver.Route53Resolver, id string, timeout time.Duration) (*route53resolver.ResolverQueryLogConfigAssociation, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{route53resolver.ResolverQueryLogConfigAssociationStatusDeleting},
 		Target:  []string{},
 		Refresh: statusQueryLogConfigAssociation(ctx, conn, id),
-		Timeout: queryLogConfigAssociationDeletedTimeout,
+		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -48,6 +48,52 @@ func TestAccRoute53ResolverQueryLogConfig_basic(t *testing.T) {
 	})
 }
 
+func TestAccRoute53ResolverQueryLogConfig_cloudWatchLogs(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v route53resolver.ResolverQueryLogConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_query_log_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueryLogConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryLogConfigConfig_cloudWatchLogs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueryLogConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_arn", "aws_cloudwatch_log_group.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ResolverQueryLogConfig_firehose(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v route53resolver.ResolverQueryLogConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53_resolver_query_log_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53resolver.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueryLogConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryLogConfigConfig_firehose(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueryLogConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_arn", "aws_kinesis_firehose_delivery_stream.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoute53ResolverQueryLogConfig_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v route53resolver.ResolverQueryLogConfig
@@ -196,6 +242,81 @@ resource "aws_route53_resolver_query_log_config" "test" {
 `, rName)
 }
 
+func testAccQueryLogConfigConfig_cloudWatchLogs(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_resolver_query_log_config" "test" {
+  name            = %[1]q
+  destination_arn = aws_cloudwatch_log_group.test.arn
+}
+`, rName)
+}
+
+func testAccQueryLogConfigConfig_firehose(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "firehose.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:AbortMultipartUpload",
+        "s3:GetBucketLocation",
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:ListBucketMultipartUploads",
+        "s3:PutObject",
+      ]
+      Effect   = "Allow"
+      Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  depends_on = [aws_iam_role_policy.test]
+
+  name        = %[1]q
+  destination = "extended_s3"
+
+  extended_s3_configuration {
+    role_arn   = aws_iam_role.test.arn
+    bucket_arn = aws_s3_bucket.test.arn
+  }
+}
+
+resource "aws_route53_resolver_query_log_config" "test" {
+  name            = %[1]q
+  destination_arn = aws_kinesis_firehose_delivery_stream.test.arn
+}
+`, rName)
+}
+
 func testAccQueryLogConfigConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_log_group" "test" {
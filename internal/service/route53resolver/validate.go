@@ -3,6 +3,9 @@ package route53resolver
 import (
 	"fmt"
 	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
 )
 
 func validResolverName(v interface{}, k string) (ws []string, errors []error) {
@@ -27,3 +30,36 @@ func validResolverName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// validQueryLogConfigDestinationARN checks that a query logging destination is a CloudWatch Logs log group,
+// an S3 bucket or a Kinesis Data Firehose delivery stream.
+func validQueryLogConfigDestinationARN(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		return
+	}
+
+	switch parsedARN.Service {
+	case "firehose":
+		if !strings.HasPrefix(parsedARN.Resource, "deliverystream/") {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a Kinesis Data Firehose delivery stream ARN", k, value))
+		}
+	case "logs":
+		if !strings.HasPrefix(parsedARN.Resource, "log-group:") {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a CloudWatch Logs log group ARN", k, value))
+		}
+	case "s3":
+	default:
+		errors = append(errors, fmt.Errorf("%q (%s) must be the ARN of a CloudWatch Logs log group, an S3 bucket or a Kinesis Data Firehose delivery stream", k, value))
+	}
+
+	return
+}
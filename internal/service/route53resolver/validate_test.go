@@ -45,3 +45,47 @@ func TestValidResolverName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidQueryLogConfigDestinationARN(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "arn:aws:s3:::example-bucket",
+			ErrCount: 0,
+		},
+		{
+			Value:    "arn:aws:s3:::example-bucket/prefix",
+			ErrCount: 0,
+		},
+		{
+			Value:    "arn:aws:logs:us-west-2:123456789012:log-group:example",
+			ErrCount: 0,
+		},
+		{
+			Value:    "arn:aws:firehose:us-west-2:123456789012:deliverystream/example",
+			ErrCount: 0,
+		},
+		{
+			Value:    "arn:aws:logs:us-west-2:123456789012:destination:example",
+			ErrCount: 1,
+		},
+		{
+			Value:    "arn:aws:kinesis:us-west-2:123456789012:stream/example",
+			ErrCount: 1,
+		},
+		{
+			Value:    "example-bucket",
+			ErrCount: 1,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := validQueryLogConfigDestinationARN(tc.Value, "destination_arn")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
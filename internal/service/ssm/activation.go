@@ -64,6 +64,10 @@ func ResourceActivation() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"remaining_registrations": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"activation_code": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -186,6 +190,11 @@ func resourceActivationRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("iam_role", activation.IamRole)
 	d.Set("registration_limit", activation.RegistrationLimit)
 	d.Set("registration_count", activation.RegistrationsCount)
+	if remaining := aws.Int64Value(activation.RegistrationLimit) - aws.Int64Value(activation.RegistrationsCount); remaining > 0 {
+		d.Set("remaining_registrations", remaining)
+	} else {
+		d.Set("remaining_registrations", 0)
+	}
 	tags := KeyValueTags(ctx, activation.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -34,6 +34,8 @@ func TestAccSSMActivation_basic(t *testing.T) {
 					testAccCheckActivationExists(ctx, resourceName, &ssmActivation),
 					resource.TestCheckResourceAttrSet(resourceName, "activation_code"),
 					acctest.CheckResourceAttrRFC3339(resourceName, "expiration_date"),
+					resource.TestCheckResourceAttr(resourceName, "registration_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "remaining_registrations", "5"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Name", tag)),
 			},
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -55,6 +56,11 @@ func ResourceAssociation() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"calendar_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"instance_id": {
 				Type:       schema.TypeString,
 				ForceNew:   true,
@@ -174,6 +180,10 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.AssociationName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("calendar_names"); ok && v.(*schema.Set).Len() > 0 {
+		associationInput.CalendarNames = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("instance_id"); ok {
 		associationInput.InstanceId = aws.String(v.(string))
 	}
@@ -226,9 +236,7 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(aws.StringValue(resp.AssociationDescription.AssociationId))
 
 	if v, ok := d.GetOk("wait_for_success_timeout_seconds"); ok {
-		dur, _ := time.ParseDuration(fmt.Sprintf("%ds", v.(int)))
-		_, err = waitAssociationSuccess(ctx, conn, d.Id(), dur)
-		if err != nil {
+		if _, err := waitAssociationSuccess(ctx, conn, d.Id(), time.Duration(v.(int))*time.Second); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for SSM Association (%s) to be Success: %s", d.Id(), err)
 		}
 	}
@@ -262,6 +270,7 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("arn", arn)
 	d.Set("apply_only_at_cron_interval", association.ApplyOnlyAtCronInterval)
 	d.Set("association_name", association.AssociationName)
+	d.Set("calendar_names", aws.StringValueSlice(association.CalendarNames))
 	d.Set("instance_id", association.InstanceId)
 	d.Set("name", association.Name)
 	d.Set("association_id", association.AssociationId)
@@ -306,6 +315,14 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.AssociationName = aws.String(v.(string))
 	}
 
+	// An empty list removes all change calendars from the association.
+	if v, ok := d.GetOk("calendar_names"); ok || d.HasChange("calendar_names") {
+		associationInput.CalendarNames = aws.StringSlice([]string{})
+		if ok {
+			associationInput.CalendarNames = flex.ExpandStringSet(v.(*schema.Set))
+		}
+	}
+
 	if v, ok := d.GetOk("document_version"); ok {
 		associationInput.DocumentVersion = aws.String(v.(string))
 	}
@@ -347,6 +364,12 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "updating SSM association: %s", err)
 	}
 
+	if v, ok := d.GetOk("wait_for_success_timeout_seconds"); ok {
+		if _, err := waitAssociationSuccess(ctx, conn, d.Id(), time.Duration(v.(int))*time.Second); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SSM Association (%s) to be Success: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceAssociationRead(ctx, d, meta)...)
 }
 
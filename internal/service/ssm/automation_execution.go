@@ -0,0 +1,306 @@
+package ssm
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceAutomationExecution() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAutomationExecutionCreate,
+		ReadWithoutTimeout:   resourceAutomationExecutionRead,
+		DeleteWithoutTimeout: resourceAutomationExecutionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"document_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"document_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)$`), "must be $DEFAULT, $LATEST, or a version number"),
+			},
+			"failure_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_concurrency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"target_parameter_name"},
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+			},
+			"max_errors": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"target_parameter_name"},
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+			},
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"step_execution": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failure_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"outputs": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"target_maps": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      300,
+				ConflictsWith: []string{"targets"},
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"target_parameter_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"targets": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      5,
+				ConflictsWith: []string{"target_maps"},
+				RequiredWith:  []string{"target_parameter_name"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 163),
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 50,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAutomationExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	documentName := d.Get("document_name").(string)
+	input := &ssm.StartAutomationExecutionInput{
+		DocumentName: aws.String(documentName),
+	}
+
+	if v, ok := d.GetOk("document_version"); ok {
+		input.DocumentVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_concurrency"); ok {
+		input.MaxConcurrency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_errors"); ok {
+		input.MaxErrors = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Parameters = expandDocumentParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("target_maps"); ok && len(v.([]interface{})) > 0 {
+		input.TargetMaps = expandTargetMaps(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("target_parameter_name"); ok {
+		input.TargetParameterName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("targets"); ok {
+		input.Targets = expandTargets(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Starting SSM Automation Execution: %s", input)
+	output, err := conn.StartAutomationExecutionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting SSM Automation Execution (%s): %s", documentName, err)
+	}
+
+	d.SetId(aws.StringValue(output.AutomationExecutionId))
+
+	if _, err := waitAutomationExecutionCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SSM Automation Execution (%s) to complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAutomationExecutionRead(ctx, d, meta)...)
+}
+
+func resourceAutomationExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	execution, err := FindAutomationExecutionByID(ctx, conn, d.Id())
+
+	// Automation execution history is only retained for 30 days. Keep the last known state rather than running the automation again.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Automation Execution (%s) not found, keeping last known state", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Automation Execution (%s): %s", d.Id(), err)
+	}
+
+	// The execution's arguments are not read back as they cannot change after it has been started.
+	d.Set("failure_message", execution.FailureMessage)
+	d.Set("max_concurrency", execution.MaxConcurrency)
+	d.Set("max_errors", execution.MaxErrors)
+	if err := d.Set("outputs", flattenParameters(execution.Outputs)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting outputs: %s", err)
+	}
+	d.Set("status", execution.AutomationExecutionStatus)
+	if err := d.Set("step_execution", flattenStepExecutions(execution.StepExecutions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting step_execution: %s", err)
+	}
+
+	return diags
+}
+
+func resourceAutomationExecutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	// Completed executions cannot be deleted. Only stop an execution that is still running, e.g. after a create timeout.
+	switch status := d.Get("status").(string); status {
+	case ssm.AutomationExecutionStatusSuccess, ssm.AutomationExecutionStatusCompletedWithSuccess:
+		return diags
+	}
+
+	log.Printf("[DEBUG] Stopping SSM Automation Execution: %s", d.Id())
+	_, err := conn.StopAutomationExecutionWithContext(ctx, &ssm.StopAutomationExecutionInput{
+		AutomationExecutionId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeAutomationExecutionNotFoundException, ssm.ErrCodeInvalidAutomationStatusUpdateException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "stopping SSM Automation Execution (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandTargetMaps(tfList []interface{}) []map[string][]*string {
+	apiObjects := make([]map[string][]*string, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandDocumentParameters(tfMap))
+	}
+
+	return apiObjects
+}
+
+func flattenStepExecutions(apiObjects []*ssm.StepExecution) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"action":          aws.StringValue(apiObject.Action),
+			"failure_message": aws.StringValue(apiObject.FailureMessage),
+			"name":            aws.StringValue(apiObject.StepName),
+			"outputs":         flattenParameters(apiObject.Outputs),
+			"status":          aws.StringValue(apiObject.StepStatus),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,112 @@
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+)
+
+func TestAccSSMAutomationExecution_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_automation_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAutomationExecutionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationExecutionConfig_basic(rName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationExecutionExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "document_name", "aws_ssm_document.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "status", ssm.AutomationExecutionStatusSuccess),
+					resource.TestCheckResourceAttr(resourceName, "step_execution.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "step_execution.0.name", "getCallerIdentity"),
+					resource.TestCheckResourceAttr(resourceName, "step_execution.0.action", "aws:executeAwsApi"),
+					resource.TestCheckResourceAttr(resourceName, "step_execution.0.status", ssm.AutomationExecutionStatusSuccess),
+					resource.TestCheckResourceAttrPair(resourceName, "outputs.getCallerIdentity.Account", "data.aws_caller_identity.current", "account_id"),
+				),
+			},
+			{
+				Config: testAccAutomationExecutionConfig_basic(rName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAutomationExecutionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "second"),
+					resource.TestCheckResourceAttr(resourceName, "status", ssm.AutomationExecutionStatusSuccess),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAutomationExecutionExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Automation Execution ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+
+		_, err := tfssm.FindAutomationExecutionByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAutomationExecutionDestroy(s *terraform.State) error {
+	// Completed automation executions cannot be deleted
+	return nil
+}
+
+func testAccAutomationExecutionConfig_basic(rName, run string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_ssm_document" "test" {
+  name            = %[1]q
+  document_type   = "Automation"
+  document_format = "YAML"
+
+  content = <<DOC
+schemaVersion: '0.3'
+description: Returns the caller identity
+outputs:
+  - getCallerIdentity.Account
+mainSteps:
+  - name: getCallerIdentity
+    action: aws:executeAwsApi
+    inputs:
+      Service: sts
+      Api: GetCallerIdentity
+    outputs:
+      - Name: Account
+        Selector: $.Account
+        Type: String
+DOC
+}
+
+resource "aws_ssm_automation_execution" "test" {
+  document_name = aws_ssm_document.test.name
+
+  triggers = {
+    run = %[2]q
+  }
+}
+`, rName, run)
+}
@@ -0,0 +1,364 @@
+package ssm
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceCommand() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCommandCreate,
+		ReadWithoutTimeout:   resourceCommandRead,
+		DeleteWithoutTimeout: resourceCommandDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloudwatch_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_log_group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"cloudwatch_output_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"document_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"document_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)$`), "must be $DEFAULT, $LATEST, or a version number"),
+			},
+			"instance_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     50,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"instance_ids", "targets"},
+			},
+			"invocation": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"output": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"max_concurrency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+			},
+			"max_errors": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+			},
+			"output_location": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						"s3_key_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 500),
+						},
+						"s3_region": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 20),
+						},
+					},
+				},
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"targets": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 163),
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 50,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+				ExactlyOneOf: []string{"instance_ids", "targets"},
+			},
+			"timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(30, 2592000),
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCommandCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	documentName := d.Get("document_name").(string)
+	input := &ssm.SendCommandInput{
+		DocumentName: aws.String(documentName),
+	}
+
+	if v, ok := d.GetOk("cloudwatch_config"); ok {
+		input.CloudWatchOutputConfig = expandTaskInvocationRunCommandParametersCloudWatchConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		input.Comment = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("document_version"); ok {
+		input.DocumentVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.InstanceIds = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("max_concurrency"); ok {
+		input.MaxConcurrency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_errors"); ok {
+		input.MaxErrors = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("output_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		input.OutputS3BucketName = aws.String(tfMap["s3_bucket_name"].(string))
+
+		if v, ok := tfMap["s3_key_prefix"].(string); ok && v != "" {
+			input.OutputS3KeyPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["s3_region"].(string); ok && v != "" {
+			input.OutputS3Region = aws.String(v)
+		}
+	}
+
+	if v, ok := d.GetOk("parameters"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Parameters = expandDocumentParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("targets"); ok {
+		input.Targets = expandTargets(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("timeout_seconds"); ok {
+		input.TimeoutSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Sending SSM Command: %s", input)
+	output, err := conn.SendCommandWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "sending SSM Command (%s): %s", documentName, err)
+	}
+
+	d.SetId(aws.StringValue(output.Command.CommandId))
+
+	if _, err := waitCommandCompleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SSM Command (%s) to complete: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceCommandRead(ctx, d, meta)...)
+}
+
+func resourceCommandRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	command, err := FindCommandByID(ctx, conn, d.Id())
+
+	// Command history is only retained for 30 days. Keep the last known state rather than running the command again.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Command (%s) not found, keeping last known state", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Command (%s): %s", d.Id(), err)
+	}
+
+	invocations, err := findCommandInvocationsByCommandID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Command (%s) invocations: %s", d.Id(), err)
+	}
+
+	// The command's arguments are not read back as they cannot change after it has been sent.
+	if err := d.Set("invocation", flattenCommandInvocations(invocations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting invocation: %s", err)
+	}
+	d.Set("max_concurrency", command.MaxConcurrency)
+	d.Set("max_errors", command.MaxErrors)
+	d.Set("status", command.Status)
+	d.Set("status_details", command.StatusDetails)
+
+	return diags
+}
+
+func resourceCommandDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	// Completed commands cannot be deleted. Only cancel a command that is still running, e.g. after a create timeout.
+	switch status := d.Get("status").(string); status {
+	case ssm.CommandStatusPending, ssm.CommandStatusInProgress, "":
+		log.Printf("[DEBUG] Cancelling SSM Command: %s", d.Id())
+		_, err := conn.CancelCommandWithContext(ctx, &ssm.CancelCommandInput{
+			CommandId: aws.String(d.Id()),
+		})
+
+		if tfawserr.ErrCodeEquals(err, ssm.ErrCodeInvalidCommandId) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "cancelling SSM Command (%s): %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+func flattenCommandInvocations(apiObjects []*ssm.CommandInvocation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var outputs []string
+		for _, plugin := range apiObject.CommandPlugins {
+			if v := aws.StringValue(plugin.Output); v != "" {
+				outputs = append(outputs, v)
+			}
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"instance_id":    aws.StringValue(apiObject.InstanceId),
+			"instance_name":  aws.StringValue(apiObject.InstanceName),
+			"output":         strings.Join(outputs, "\n"),
+			"status":         aws.StringValue(apiObject.Status),
+			"status_details": aws.StringValue(apiObject.StatusDetails),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,98 @@
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+)
+
+func TestAccSSMCommand_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_command.test"
+	instanceResourceName := "aws_instance.test"
+
+	registrationSleep := func() resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			log.Print("[DEBUG] Test: Sleep to allow SSM Agent to register EC2 instance as a managed node.")
+			time.Sleep(1 * time.Minute)
+			return nil
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCommandDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check:  registrationSleep(),
+			},
+			{
+				Config: testAccCommandConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCommandExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "document_name", "AWS-RunShellScript"),
+					resource.TestCheckResourceAttr(resourceName, "status", ssm.CommandStatusSuccess),
+					resource.TestCheckResourceAttr(resourceName, "invocation.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "invocation.0.instance_id", instanceResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "invocation.0.status", ssm.CommandInvocationStatusSuccess),
+					resource.TestMatchResourceAttr(resourceName, "invocation.0.output", regexp.MustCompile(rName)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCommandExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Command ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+
+		_, err := tfssm.FindCommandByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckCommandDestroy(s *terraform.State) error {
+	// Completed commands cannot be deleted
+	return nil
+}
+
+func testAccCommandConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		fmt.Sprintf(`
+resource "aws_ssm_command" "test" {
+  document_name = "AWS-RunShellScript"
+  comment       = %[1]q
+  instance_ids  = [aws_instance.test.id]
+
+  parameters = {
+    commands = "echo %[1]s"
+  }
+}
+`, rName))
+}
@@ -7,3 +7,42 @@ import (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	resourceDataSyncTypeSyncFromSource    = "SyncFromSource"
+	resourceDataSyncTypeSyncToDestination = "SyncToDestination"
+)
+
+const (
+	resourceDataSyncSourceTypeAWSOrganizations          = "AwsOrganizations"
+	resourceDataSyncSourceTypeSingleAccountMultiRegions = "SingleAccountMultiRegions"
+)
+
+func resourceDataSyncSourceType_Values() []string {
+	return []string{
+		resourceDataSyncSourceTypeAWSOrganizations,
+		resourceDataSyncSourceTypeSingleAccountMultiRegions,
+	}
+}
+
+const (
+	resourceDataSyncOrganizationSourceTypeEntireOrganization  = "EntireOrganization"
+	resourceDataSyncOrganizationSourceTypeOrganizationalUnits = "OrganizationalUnits"
+)
+
+func resourceDataSyncOrganizationSourceType_Values() []string {
+	return []string{
+		resourceDataSyncOrganizationSourceTypeEntireOrganization,
+		resourceDataSyncOrganizationSourceTypeOrganizationalUnits,
+	}
+}
+
+const (
+	resourceDataSyncDestinationDataSharingTypeOrganization = "Organization"
+)
+
+func resourceDataSyncDestinationDataSharingType_Values() []string {
+	return []string{
+		resourceDataSyncDestinationDataSharingTypeOrganization,
+	}
+}
@@ -261,6 +261,7 @@ func defaultPatchBaselineRestoreOSDefault(ctx context.Context, meta ssmClient, o
 	}
 	if err != nil {
 		diags = sdkdiag.AppendErrorf(diags, "finding AWS-owned default Patch Baseline for operating system %q: %s", os, err)
+		return
 	}
 
 	log.Printf("[INFO] Restoring SSM Default Patch Baseline for operating system %q to %q", os, baselineID)
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -50,6 +51,30 @@ func ResourceDocument() *schema.Resource {
 					validation.StringLenBetween(3, 128),
 				),
 			},
+			"attachments_content": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hash_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"attachments_source": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -105,8 +130,10 @@ func ResourceDocument() *schema.Resource {
 				Computed: true,
 			},
 			"default_version": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[1-9][0-9]*$`), "must be a document version number"),
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -192,8 +219,35 @@ func ResourceDocument() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceDocumentCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceDocumentCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	// Changing the attachments publishes a new document version whose attachment hashes are only known after apply.
+	if diff.HasChange("attachments_source") {
+		keys := []string{"attachments_content", "document_version", "latest_version"}
+
+		// A configured default version is pinned and doesn't move to the new document version.
+		if diff.GetRawConfig().GetAttr("default_version").IsNull() {
+			keys = append(keys, "default_version")
+		}
+
+		for _, k := range keys {
+			if err := diff.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
 	}
+
+	return nil
 }
 
 func resourceDocumentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -256,6 +310,12 @@ func resourceDocumentCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "waiting for SSM Document (%s) to be Active: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("default_version"); ok && v.(string) != aws.StringValue(resp.DocumentDescription.DocumentVersion) {
+		if err := updateDocumentDefaultVersion(ctx, conn, d.Id(), v.(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating SSM Document (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceDocumentRead(ctx, d, meta)...)
 }
 
@@ -311,6 +371,9 @@ func resourceDocumentRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	doc := describeDocumentOutput.Document
 
+	if err := d.Set("attachments_content", flattenAttachmentContents(getDocumentOutput.AttachmentsContent)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting attachments_content: %s", err)
+	}
 	d.Set("content", getDocumentOutput.Content)
 	d.Set("created_date", aws.TimeValue(doc.CreatedDate).Format(time.RFC3339))
 	d.Set("default_version", doc.DefaultVersion)
@@ -421,11 +484,11 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	// update for schema version 1.x is not allowed
 	isSchemaVersion1, _ := regexp.MatchString("^1[.][0-9]$", d.Get("schema_version").(string))
 
-	if !d.HasChange("content") && isSchemaVersion1 {
+	if !d.HasChanges("content", "default_version") && isSchemaVersion1 {
 		return diags
 	}
 
-	if d.HasChangesExcept("tags", "tags_all", "permissions") {
+	if d.HasChangesExcept("tags", "tags_all", "permissions", "default_version") {
 		diags = append(diags, sdkdiag.WrapDiagsf(updateDocument(ctx, d, meta), "updating SSM Document (%s)", d.Id())...)
 		if diags.HasError() {
 			return diags
@@ -435,6 +498,11 @@ func resourceDocumentUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for SSM Document (%s) to be Active: %s", d.Id(), err)
 		}
+	} else if d.HasChange("default_version") {
+		// Only the default version changed, e.g. to roll back to an earlier version.
+		if err := updateDocumentDefaultVersion(ctx, conn, d.Id(), d.Get("default_version").(string)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Document (%s): %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceDocumentRead(ctx, d, meta)...)
@@ -495,6 +563,29 @@ func expandAttachmentsSources(a []interface{}) []*ssm.AttachmentsSource {
 	return results
 }
 
+func flattenAttachmentContents(apiObjects []*ssm.AttachmentContent) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"hash":      aws.StringValue(apiObject.Hash),
+			"hash_type": aws.StringValue(apiObject.HashType),
+			"name":      aws.StringValue(apiObject.Name),
+			"size":      aws.Int64Value(apiObject.Size),
+		})
+	}
+
+	return tfList
+}
+
 func setDocumentPermissions(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMConn()
@@ -673,7 +764,7 @@ func updateDocument(ctx context.Context, d *schema.ResourceData, meta interface{
 		Name:            aws.String(name),
 		Content:         aws.String(d.Get("content").(string)),
 		DocumentFormat:  aws.String(d.Get("document_format").(string)),
-		DocumentVersion: aws.String(d.Get("default_version").(string)),
+		DocumentVersion: aws.String("$LATEST"),
 	}
 
 	if v, ok := d.GetOk("target_type"); ok {
@@ -693,7 +784,10 @@ func updateDocument(ctx context.Context, d *schema.ResourceData, meta interface{
 	conn := meta.(*conns.AWSClient).SSMConn()
 	updated, err := conn.UpdateDocumentWithContext(ctx, updateDocInput)
 
-	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeDuplicateDocumentContent) {
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeDuplicateDocumentContent) && d.HasChange("attachments_source") {
+		// SSM only creates a new document version when the content changes, so the new attachments would be silently dropped.
+		return sdkdiag.AppendErrorf(diags, "updating SSM document: attachments_source changed but content is unchanged, update content (for example a file checksum) to publish a new document version: %s", err)
+	} else if tfawserr.ErrCodeEquals(err, ssm.ErrCodeDuplicateDocumentContent) {
 		log.Printf("[DEBUG] Content is a duplicate of the latest version so update is not necessary: %s", d.Id())
 		log.Printf("[INFO] Updating the default version to the latest version %s: %s", newDefaultVersion, d.Id())
 
@@ -705,17 +799,31 @@ func updateDocument(ctx context.Context, d *schema.ResourceData, meta interface{
 		newDefaultVersion = aws.StringValue(updated.DocumentDescription.DocumentVersion)
 	}
 
-	updateDefaultInput := &ssm.UpdateDocumentDefaultVersionInput{
+	// A configured default version is pinned, so the new document version is published without becoming the default.
+	if !d.GetRawConfig().GetAttr("default_version").IsNull() {
+		newDefaultVersion = d.Get("default_version").(string)
+	}
+
+	if err := updateDocumentDefaultVersion(ctx, conn, name, newDefaultVersion); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating the default document version to that of the updated document: %s", err)
+	}
+	return diags
+}
+
+func updateDocumentDefaultVersion(ctx context.Context, conn *ssm.SSM, name, version string) error {
+	input := &ssm.UpdateDocumentDefaultVersionInput{
 		Name:            aws.String(name),
-		DocumentVersion: aws.String(newDefaultVersion),
+		DocumentVersion: aws.String(version),
 	}
 
-	_, err = conn.UpdateDocumentDefaultVersionWithContext(ctx, updateDefaultInput)
+	log.Printf("[INFO] Updating the default version of SSM Document (%s) to %s", name, version)
+	_, err := conn.UpdateDocumentDefaultVersionWithContext(ctx, input)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "updating the default document version to that of the updated document: %s", err)
+		return fmt.Errorf("setting default version to %s: %w", version, err)
 	}
-	return diags
+
+	return nil
 }
 
 // Validates that type and account_ids are defined
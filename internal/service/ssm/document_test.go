@@ -184,6 +184,57 @@ func TestAccSSMDocument_update(t *testing.T) {
 	})
 }
 
+func TestAccSSMDocument_defaultVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := sdkacctest.RandString(10)
+	resourceName := "aws_ssm_document.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDocumentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDocumentConfig_defaultVersion(name, "Get-Process", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+				),
+			},
+			{
+				Config: testAccDocumentConfig_defaultVersion(name, "Get-Process -Verbose", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+				),
+			},
+			{
+				Config: testAccDocumentConfig_defaultVersion(name, "Get-Process -Verbose", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDocumentConfig_defaultVersion(name, "Get-Process -Verbose", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDocumentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMDocument_Permission_public(t *testing.T) {
 	ctx := acctest.Context(t)
 	name := sdkacctest.RandString(10)
@@ -386,6 +437,10 @@ func TestAccSSMDocument_package(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDocumentExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "document_type", "Package"),
+					resource.TestCheckResourceAttr(resourceName, "attachments_content.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attachments_content.0.name", "test.zip"),
+					resource.TestCheckResourceAttr(resourceName, "attachments_content.0.hash_type", "Sha256"),
+					resource.TestCheckResourceAttrSet(resourceName, "attachments_content.0.hash"),
 				),
 			},
 			{
@@ -837,6 +892,36 @@ DOC
 `, rName)
 }
 
+func testAccDocumentConfig_defaultVersion(rName, command, defaultVersion string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "test" {
+  name            = "test_document-%[1]s"
+  document_type   = "Command"
+  default_version = %[3]q
+
+  content = <<DOC
+{
+  "schemaVersion": "2.0",
+  "description": "Sample version 2.0 document v2",
+  "parameters": {},
+  "mainSteps": [
+    {
+      "action": "aws:runPowerShellScript",
+      "name": "runPowerShellScript",
+      "inputs": {
+        "runCommand": [
+          %[2]q
+        ]
+      }
+    }
+  ]
+}
+DOC
+
+}
+`, rName, command, defaultVersion)
+}
+
 func testAccDocumentConfig_publicPermission(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_document" "test" {
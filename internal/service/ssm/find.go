@@ -35,6 +35,154 @@ func FindAssociationById(ctx context.Context, conn *ssm.SSM, id string) (*ssm.As
 	return output.AssociationDescription, nil
 }
 
+func FindAutomationExecutionByID(ctx context.Context, conn *ssm.SSM, id string) (*ssm.AutomationExecution, error) {
+	input := &ssm.GetAutomationExecutionInput{
+		AutomationExecutionId: aws.String(id),
+	}
+
+	output, err := conn.GetAutomationExecutionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeAutomationExecutionNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AutomationExecution == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AutomationExecution, nil
+}
+
+func FindCommandByID(ctx context.Context, conn *ssm.SSM, id string) (*ssm.Command, error) {
+	input := &ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	output, err := conn.ListCommandsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeInvalidCommandId) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Commands) == 0 || output.Commands[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Commands); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Commands[0], nil
+}
+
+func findCommandInvocationsByCommandID(ctx context.Context, conn *ssm.SSM, id string) ([]*ssm.CommandInvocation, error) {
+	input := &ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(id),
+		Details:   aws.Bool(true),
+	}
+	var output []*ssm.CommandInvocation
+
+	err := conn.ListCommandInvocationsPagesWithContext(ctx, input, func(page *ssm.ListCommandInvocationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CommandInvocations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findLatestAssociationExecutionByAssociationID(ctx context.Context, conn *ssm.SSM, id string) (*ssm.AssociationExecution, error) {
+	input := &ssm.DescribeAssociationExecutionsInput{
+		AssociationId: aws.String(id),
+	}
+	var output *ssm.AssociationExecution
+
+	err := conn.DescribeAssociationExecutionsPagesWithContext(ctx, input, func(page *ssm.DescribeAssociationExecutionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AssociationExecutions {
+			if v == nil {
+				continue
+			}
+
+			if output == nil || aws.TimeValue(v.CreatedTime).After(aws.TimeValue(output.CreatedTime)) {
+				output = v
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeAssociationDoesNotExist) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findAssociationExecutionTargets(ctx context.Context, conn *ssm.SSM, input *ssm.DescribeAssociationExecutionTargetsInput) ([]*ssm.AssociationExecutionTarget, error) {
+	var output []*ssm.AssociationExecutionTarget
+
+	err := conn.DescribeAssociationExecutionTargetsPagesWithContext(ctx, input, func(page *ssm.DescribeAssociationExecutionTargetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AssociationExecutionTargets {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 // FindDocumentByName returns the Document corresponding to the specified name.
 func FindDocumentByName(ctx context.Context, conn *ssm.SSM, name string) (*ssm.DocumentDescription, error) {
 	input := &ssm.DescribeDocumentInput{
@@ -59,6 +207,80 @@ func FindDocumentByName(ctx context.Context, conn *ssm.SSM, name string) (*ssm.D
 	return output.Document, nil
 }
 
+func FindOpsItemByID(ctx context.Context, conn *ssm.SSM, id string) (*ssm.OpsItem, error) {
+	input := &ssm.GetOpsItemInput{
+		OpsItemId: aws.String(id),
+	}
+
+	output, err := conn.GetOpsItemWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeOpsItemNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OpsItem == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.OpsItem, nil
+}
+
+// FindParameterVersionByLabel returns the version of the parameter that currently holds the label.
+func FindParameterVersionByLabel(ctx context.Context, conn *ssm.SSM, name, label string) (*ssm.ParameterHistory, error) {
+	input := &ssm.GetParameterHistoryInput{
+		Name: aws.String(name),
+	}
+	var result *ssm.ParameterHistory
+
+	err := conn.GetParameterHistoryPagesWithContext(ctx, input, func(page *ssm.GetParameterHistoryOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Parameters {
+			if v == nil {
+				continue
+			}
+
+			for _, l := range v.Labels {
+				if aws.StringValue(l) == label {
+					result = v
+					return false
+				}
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("label %s not found", label),
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
+
 // FindPatchGroup returns matching SSM Patch Group by Patch Group and BaselineId.
 func FindPatchGroup(ctx context.Context, conn *ssm.SSM, patchGroup, baselineId string) (*ssm.PatchGroupPatchBaselineMapping, error) {
 	input := &ssm.DescribePatchGroupsInput{}
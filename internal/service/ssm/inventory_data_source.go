@@ -0,0 +1,218 @@
+package ssm
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourceInventory() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInventoryRead,
+		Schema: map[string]*schema.Schema{
+			"entities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"capture_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"content": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeMap,
+											Elem: &schema.Schema{Type: schema.TypeString},
+										},
+									},
+									"content_hash": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"schema_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter": inventoryFilterSchema(),
+			"type_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	input := &ssm.GetInventoryInput{}
+
+	if v, ok := d.GetOk("filter"); ok && len(v.([]interface{})) > 0 {
+		input.Filters = expandInventoryFilters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("type_names"); ok && v.(*schema.Set).Len() > 0 {
+		for _, typeName := range flex.ExpandStringValueSet(v.(*schema.Set)) {
+			input.ResultAttributes = append(input.ResultAttributes, &ssm.ResultAttribute{
+				TypeName: aws.String(typeName),
+			})
+		}
+	}
+
+	var entities []*ssm.InventoryResultEntity
+
+	err := conn.GetInventoryPagesWithContext(ctx, input, func(page *ssm.GetInventoryOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, entity := range page.Entities {
+			if entity == nil {
+				continue
+			}
+
+			entities = append(entities, entity)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Inventory: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("entities", flattenInventoryResultEntities(entities)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting entities: %s", err)
+	}
+
+	return diags
+}
+
+func inventoryFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 5,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      ssm.InventoryQueryOperatorTypeEqual,
+					ValidateFunc: validation.StringInSlice(ssm.InventoryQueryOperatorType_Values(), false),
+				},
+				"values": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 40,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandInventoryFilters(tfList []interface{}) []*ssm.InventoryFilter {
+	var apiObjects []*ssm.InventoryFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ssm.InventoryFilter{
+			Key:    aws.String(tfMap["key"].(string)),
+			Type:   aws.String(tfMap["type"].(string)),
+			Values: flex.ExpandStringList(tfMap["values"].([]interface{})),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenInventoryResultEntities(apiObjects []*ssm.InventoryResultEntity) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		var data []interface{}
+
+		// Sort the inventory types so that the order of data is stable.
+		typeNames := make([]string, 0, len(apiObject.Data))
+		for k := range apiObject.Data {
+			typeNames = append(typeNames, k)
+		}
+		sort.Strings(typeNames)
+
+		for _, typeName := range typeNames {
+			item := apiObject.Data[typeName]
+
+			if item == nil {
+				continue
+			}
+
+			data = append(data, map[string]interface{}{
+				"capture_time":   aws.StringValue(item.CaptureTime),
+				"content":        flattenInventoryContent(item.Content),
+				"content_hash":   aws.StringValue(item.ContentHash),
+				"schema_version": aws.StringValue(item.SchemaVersion),
+				"type_name":      aws.StringValue(item.TypeName),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"data": data,
+			"id":   aws.StringValue(apiObject.Id),
+		})
+	}
+
+	return tfList
+}
+
+func flattenInventoryContent(apiObjects []map[string]*string) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, aws.StringValueMap(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,64 @@
+package ssm_test
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSSMInventoryDataSource_filter(t *testing.T) {
+	dataSourceName := "data.aws_ssm_inventory.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	registrationSleep := func() resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			log.Print("[DEBUG] Test: Sleep to allow SSM Agent to register EC2 instance as a managed node.")
+			time.Sleep(1 * time.Minute)
+			return nil
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check:  registrationSleep(),
+			},
+			{
+				Config: testAccInventoryDataSourceConfig_filter(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "entities.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "entities.0.id", "aws_instance.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "entities.0.data.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "entities.0.data.0.type_name", "AWS:InstanceInformation"),
+					resource.TestCheckResourceAttr(dataSourceName, "entities.0.data.0.content.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "entities.0.data.0.content.0.InstanceId", "aws_instance.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInventoryDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		`
+data "aws_ssm_inventory" "test" {
+  type_names = ["AWS:InstanceInformation"]
+
+  filter {
+    key    = "AWS:InstanceInformation.InstanceId"
+    values = [aws_instance.test.id]
+  }
+}
+`)
+}
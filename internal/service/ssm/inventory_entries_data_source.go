@@ -0,0 +1,91 @@
+package ssm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceInventoryEntries() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInventoryEntriesRead,
+		Schema: map[string]*schema.Schema{
+			"capture_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"filter": inventoryFilterSchema(),
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"schema_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceInventoryEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	instanceID := d.Get("instance_id").(string)
+	typeName := d.Get("type_name").(string)
+	input := &ssm.ListInventoryEntriesInput{
+		InstanceId: aws.String(instanceID),
+		TypeName:   aws.String(typeName),
+	}
+
+	if v, ok := d.GetOk("filter"); ok && len(v.([]interface{})) > 0 {
+		input.Filters = expandInventoryFilters(v.([]interface{}))
+	}
+
+	var entries []map[string]*string
+	var output *ssm.ListInventoryEntriesOutput
+
+	for {
+		page, err := conn.ListInventoryEntriesWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSM Inventory Entries (%s, %s): %s", instanceID, typeName, err)
+		}
+
+		output = page
+		entries = append(entries, page.Entries...)
+
+		if aws.StringValue(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", instanceID, typeName))
+	d.Set("capture_time", output.CaptureTime)
+	if err := d.Set("entries", flattenInventoryContent(entries)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting entries: %s", err)
+	}
+	d.Set("schema_version", output.SchemaVersion)
+
+	return diags
+}
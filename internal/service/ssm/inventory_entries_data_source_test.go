@@ -0,0 +1,58 @@
+package ssm_test
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSSMInventoryEntriesDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ssm_inventory_entries.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	registrationSleep := func() resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			log.Print("[DEBUG] Test: Sleep to allow SSM Agent to register EC2 instance as a managed node.")
+			time.Sleep(1 * time.Minute)
+			return nil
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check:  registrationSleep(),
+			},
+			{
+				Config: testAccInventoryEntriesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "entries.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "entries.0.InstanceId", "aws_instance.test", "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "capture_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "schema_version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInventoryEntriesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		`
+data "aws_ssm_inventory_entries" "test" {
+  instance_id = aws_instance.test.id
+  type_name   = "AWS:InstanceInformation"
+}
+`)
+}
@@ -46,6 +46,36 @@ func ResourceMaintenanceWindowTask() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"alarm_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+								},
+							},
+						},
+						"ignore_poll_alarm_failure": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"cutoff_behavior": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -694,6 +724,10 @@ func resourceMaintenanceWindowTaskCreate(ctx context.Context, d *schema.Resource
 		params.CutoffBehavior = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("alarm_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		params.AlarmConfiguration = expandAlarmConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("targets"); ok {
 		params.Targets = expandTargets(v.([]interface{}))
 	}
@@ -759,6 +793,9 @@ func resourceMaintenanceWindowTaskRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("name", resp.Name)
 	d.Set("description", resp.Description)
 	d.Set("cutoff_behavior", resp.CutoffBehavior)
+	if err := d.Set("alarm_configuration", flattenAlarmConfiguration(resp.AlarmConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarm_configuration: %s", err)
+	}
 
 	if resp.TaskInvocationParameters != nil {
 		if err := d.Set("task_invocation_parameters", flattenTaskInvocationParameters(resp.TaskInvocationParameters)); err != nil {
@@ -818,6 +855,11 @@ func resourceMaintenanceWindowTaskUpdate(ctx context.Context, d *schema.Resource
 		params.CutoffBehavior = aws.String(v.(string))
 	}
 
+	// As the task is replaced, removing the block removes the alarm from the task.
+	if v, ok := d.GetOk("alarm_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		params.AlarmConfiguration = expandAlarmConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("name"); ok {
 		params.Name = aws.String(v.(string))
 	}
@@ -874,3 +916,46 @@ func resourceMaintenanceWindowTaskImport(ctx context.Context, d *schema.Resource
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func expandAlarmConfiguration(tfMap map[string]interface{}) *ssm.AlarmConfiguration {
+	apiObject := &ssm.AlarmConfiguration{
+		IgnorePollAlarmFailure: aws.Bool(tfMap["ignore_poll_alarm_failure"].(bool)),
+	}
+
+	for _, tfMapRaw := range tfMap["alarm"].([]interface{}) {
+		alarm, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.Alarms = append(apiObject.Alarms, &ssm.Alarm{
+			Name: aws.String(alarm["name"].(string)),
+		})
+	}
+
+	return apiObject
+}
+
+func flattenAlarmConfiguration(apiObject *ssm.AlarmConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var alarms []interface{}
+
+	for _, alarm := range apiObject.Alarms {
+		if alarm == nil {
+			continue
+		}
+
+		alarms = append(alarms, map[string]interface{}{
+			"name": aws.StringValue(alarm.Name),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"alarm":                     alarms,
+		"ignore_poll_alarm_failure": aws.BoolValue(apiObject.IgnorePollAlarmFailure),
+	}}
+}
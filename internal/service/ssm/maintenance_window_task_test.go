@@ -128,6 +128,54 @@ func TestAccSSMMaintenanceWindowTask_cutoff(t *testing.T) {
 	})
 }
 
+func TestAccSSMMaintenanceWindowTask_alarmConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var task ssm.MaintenanceWindowTask
+	resourceName := "aws_ssm_maintenance_window_task.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMaintenanceWindowTaskDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMaintenanceWindowTaskConfig_alarmConfiguration(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMaintenanceWindowTaskExists(ctx, resourceName, &task),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.0.alarm.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "alarm_configuration.0.alarm.0.name", "aws_cloudwatch_metric_alarm.test", "alarm_name"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.0.ignore_poll_alarm_failure", "false"),
+					resource.TestCheckResourceAttr(resourceName, "cutoff_behavior", "CANCEL_TASK"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccMaintenanceWindowTaskImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMaintenanceWindowTaskConfig_alarmConfiguration(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMaintenanceWindowTaskExists(ctx, resourceName, &task),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.0.ignore_poll_alarm_failure", "true"),
+				),
+			},
+			{
+				Config: testAccMaintenanceWindowTaskConfig_cutoff(rName, "CANCEL_TASK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMaintenanceWindowTaskExists(ctx, resourceName, &task),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSMMaintenanceWindowTask_noRole(t *testing.T) {
 	ctx := acctest.Context(t)
 	var task ssm.MaintenanceWindowTask
@@ -673,6 +721,39 @@ resource "aws_ssm_maintenance_window_task" "test" {
 `, cutoff)
 }
 
+func testAccMaintenanceWindowTaskConfig_alarmConfiguration(rName string, ignorePollAlarmFailure bool) string {
+	return fmt.Sprintf(testAccMaintenanceWindowTaskBaseConfig(rName)+`
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 2
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/EC2"
+  period              = 120
+  statistic           = "Average"
+  threshold           = 80
+}
+
+resource "aws_ssm_maintenance_window_task" "test" {
+  window_id        = aws_ssm_maintenance_window.test.id
+  task_type        = "AUTOMATION"
+  task_arn         = "AWS-RunShellScript"
+  priority         = 1
+  service_role_arn = aws_iam_role.test.arn
+  cutoff_behavior  = "CANCEL_TASK"
+
+  alarm_configuration {
+    ignore_poll_alarm_failure = %[2]t
+
+    alarm {
+      name = aws_cloudwatch_metric_alarm.test.alarm_name
+    }
+  }
+}
+`, rName, ignorePollAlarmFailure)
+}
+
 func testAccMaintenanceWindowTaskConfig_basicUpdate(rName, description, taskType, taskArn string, priority, maxConcurrency, maxErrors int) string {
 	return fmt.Sprintf(testAccMaintenanceWindowTaskBaseConfig(rName)+`
 
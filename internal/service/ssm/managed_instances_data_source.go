@@ -0,0 +1,201 @@
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceManagedInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceManagedInstancesRead,
+		Schema: map[string]*schema.Schema{
+			"activation_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"activation_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"agent_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"computer_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"iam_role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_latest_version": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"last_ping_date_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ping_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"platform_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"platform_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"platform_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"registration_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceManagedInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	input := &ssm.DescribeInstanceInformationInput{}
+
+	if v, ok := d.GetOk("filter"); ok {
+		input.Filters = expandInstanceInformationStringFilters(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("activation_id"); ok {
+		input.Filters = append(input.Filters, &ssm.InstanceInformationStringFilter{
+			Key:    aws.String("ActivationIds"),
+			Values: aws.StringSlice([]string{v.(string)}),
+		})
+	}
+
+	for k, v := range tftags.New(ctx, d.Get("tags").(map[string]interface{})).Map() {
+		input.Filters = append(input.Filters, &ssm.InstanceInformationStringFilter{
+			Key:    aws.String(fmt.Sprintf("tag:%s", k)),
+			Values: aws.StringSlice([]string{v}),
+		})
+	}
+
+	var results []*ssm.InstanceInformation
+
+	err := conn.DescribeInstanceInformationPagesWithContext(ctx, input, func(page *ssm.DescribeInstanceInformationOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, instanceInformation := range page.InstanceInformationList {
+			if instanceInformation == nil {
+				continue
+			}
+
+			results = append(results, instanceInformation)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Managed Instances: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("instances", flattenManagedInstances(results)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instances: %s", err)
+	}
+
+	return diags
+}
+
+func flattenManagedInstances(apiObjects []*ssm.InstanceInformation) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"activation_id":     aws.StringValue(apiObject.ActivationId),
+			"agent_version":     aws.StringValue(apiObject.AgentVersion),
+			"computer_name":     aws.StringValue(apiObject.ComputerName),
+			"iam_role":          aws.StringValue(apiObject.IamRole),
+			"id":                aws.StringValue(apiObject.InstanceId),
+			"ip_address":        aws.StringValue(apiObject.IPAddress),
+			"is_latest_version": aws.BoolValue(apiObject.IsLatestVersion),
+			"name":              aws.StringValue(apiObject.Name),
+			"ping_status":       aws.StringValue(apiObject.PingStatus),
+			"platform_name":     aws.StringValue(apiObject.PlatformName),
+			"platform_type":     aws.StringValue(apiObject.PlatformType),
+			"platform_version":  aws.StringValue(apiObject.PlatformVersion),
+			"resource_type":     aws.StringValue(apiObject.ResourceType),
+		}
+
+		if v := apiObject.LastPingDateTime; v != nil {
+			tfMap["last_ping_date_time"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.RegistrationDate; v != nil {
+			tfMap["registration_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,99 @@
+package ssm_test
+
+import (
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSSMManagedInstancesDataSource_tags(t *testing.T) {
+	dataSourceName := "data.aws_ssm_managed_instances.test"
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	registrationSleep := func() resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			log.Print("[DEBUG] Test: Sleep to allow SSM Agent to register EC2 instance as a managed node.")
+			time.Sleep(1 * time.Minute)
+			return nil
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check:  registrationSleep(),
+			},
+			{
+				Config: testAccManagedInstancesDataSourceConfig_tags(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.agent_version"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.ping_status", ssm.PingStatusOnline),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.platform_type", ssm.PlatformTypeLinux),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.resource_type", ssm.ResourceTypeEc2instance),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMManagedInstancesDataSource_activationID(t *testing.T) {
+	dataSourceName := "data.aws_ssm_managed_instances.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedInstancesDataSourceConfig_activationID(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccManagedInstancesDataSourceConfig_tags(rName string) string {
+	return acctest.ConfigCompose(
+		testAccInstancesDataSourceConfig_filterInstance(rName),
+		`
+data "aws_ssm_managed_instances" "test" {
+  tags = {
+    Name = aws_instance.test.tags["Name"]
+  }
+}
+`)
+}
+
+func testAccManagedInstancesDataSourceConfig_activationID(rName string) string {
+	return acctest.ConfigCompose(
+		testAccActivationBasicBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_ssm_activation" "test" {
+  name               = %[1]q
+  iam_role           = aws_iam_role.test_role.name
+  registration_limit = 5
+  depends_on         = [aws_iam_role_policy_attachment.test_attach]
+}
+
+data "aws_ssm_managed_instances" "test" {
+  activation_id = aws_ssm_activation.test.id
+}
+`, rName))
+}
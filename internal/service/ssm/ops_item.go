@@ -0,0 +1,519 @@
+package ssm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// OpsCenter shows the resources in this operational data key as the related resources of the OpsItem.
+	opsItemRelatedResourcesKey = "/aws/resources"
+)
+
+func ResourceOpsItem() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceOpsItemCreate,
+		ReadWithoutTimeout:   resourceOpsItemRead,
+		UpdateWithoutTimeout: resourceOpsItemUpdate,
+		DeleteWithoutTimeout: resourceOpsItemDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"notification_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"operational_data": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 128),
+								validation.StringDoesNotMatch(regexp.MustCompile(`(?i)^/?(amazon|aws|amzn|ssm)`), "must not begin with amazon, aws, amzn or ssm"),
+							),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ssm.OpsItemDataTypeSearchableString,
+							ValidateFunc: validation.StringInSlice(ssm.OpsItemDataType_Values(), false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"ops_item_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+			"related_ops_item_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"related_resources": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"1", "2", "3", "4"}, false),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ssm.OpsItemStatus_Values(), false),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceOpsItemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+
+	title := d.Get("title").(string)
+	input := &ssm.CreateOpsItemInput{
+		Description: aws.String(d.Get("description").(string)),
+		Source:      aws.String(d.Get("source").(string)),
+		Title:       aws.String(title),
+	}
+
+	if v, ok := d.GetOk("category"); ok {
+		input.Category = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification_arns"); ok && v.(*schema.Set).Len() > 0 {
+		input.Notifications = expandOpsItemNotifications(v.(*schema.Set))
+	}
+
+	operationalData, err := expandOpsItemOperationalData(d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM OpsItem (%s): %s", title, err)
+	}
+
+	if len(operationalData) > 0 {
+		input.OperationalData = operationalData
+	}
+
+	if v, ok := d.GetOk("ops_item_type"); ok {
+		input.OpsItemType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("priority"); ok {
+		input.Priority = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("related_ops_item_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.RelatedOpsItems = expandRelatedOpsItems(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("severity"); ok {
+		input.Severity = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SSM OpsItem: %s", input)
+	output, err := conn.CreateOpsItemWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM OpsItem (%s): %s", title, err)
+	}
+
+	d.SetId(aws.StringValue(output.OpsItemId))
+
+	// New OpsItems are always Open.
+	if v, ok := d.GetOk("status"); ok && v.(string) != ssm.OpsItemStatusOpen {
+		_, err := conn.UpdateOpsItemWithContext(ctx, &ssm.UpdateOpsItemInput{
+			OpsItemId: aws.String(d.Id()),
+			Status:    aws.String(v.(string)),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting SSM OpsItem (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceOpsItemRead(ctx, d, meta)...)
+}
+
+func resourceOpsItemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	opsItem, err := FindOpsItemByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM OpsItem (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM OpsItem (%s): %s", d.Id(), err)
+	}
+
+	if v := aws.StringValue(opsItem.OpsItemArn); v != "" {
+		d.Set("arn", v)
+	} else {
+		d.Set("arn", arn.ARN{
+			Partition: meta.(*conns.AWSClient).Partition,
+			Service:   "ssm",
+			Region:    meta.(*conns.AWSClient).Region,
+			AccountID: meta.(*conns.AWSClient).AccountID,
+			Resource:  fmt.Sprintf("opsitem/%s", d.Id()),
+		}.String())
+	}
+	d.Set("category", opsItem.Category)
+	d.Set("description", opsItem.Description)
+	d.Set("notification_arns", flattenOpsItemNotifications(opsItem.Notifications))
+	operationalData, relatedResources, err := flattenOpsItemOperationalData(opsItem.OperationalData)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM OpsItem (%s): %s", d.Id(), err)
+	}
+	if err := d.Set("operational_data", operationalData); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting operational_data: %s", err)
+	}
+	d.Set("ops_item_type", opsItem.OpsItemType)
+	d.Set("priority", opsItem.Priority)
+	d.Set("related_ops_item_ids", flattenRelatedOpsItems(opsItem.RelatedOpsItems))
+	d.Set("related_resources", relatedResources)
+	d.Set("severity", opsItem.Severity)
+	d.Set("source", opsItem.Source)
+	d.Set("status", opsItem.Status)
+	d.Set("title", opsItem.Title)
+
+	tags, err := ListTags(ctx, conn, d.Id(), ssm.ResourceTypeForTaggingOpsItem)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for SSM OpsItem (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceOpsItemUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ssm.UpdateOpsItemInput{
+			OpsItemId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("category") {
+			input.Category = aws.String(d.Get("category").(string))
+		}
+
+		if d.HasChange("description") {
+			input.Description = aws.String(d.Get("description").(string))
+		}
+
+		if d.HasChange("notification_arns") {
+			input.Notifications = expandOpsItemNotifications(d.Get("notification_arns").(*schema.Set))
+		}
+
+		if d.HasChanges("operational_data", "related_resources") {
+			operationalData, err := expandOpsItemOperationalData(d)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating SSM OpsItem (%s): %s", d.Id(), err)
+			}
+
+			input.OperationalData = operationalData
+
+			// Keys that are not set again are merged with the existing operational data, so removed keys must be deleted explicitly.
+			o, _ := d.GetChange("operational_data")
+			for _, tfMapRaw := range o.(*schema.Set).List() {
+				key := tfMapRaw.(map[string]interface{})["key"].(string)
+
+				if _, ok := operationalData[key]; !ok {
+					input.OperationalDataToDelete = append(input.OperationalDataToDelete, aws.String(key))
+				}
+			}
+
+			if o, _ := d.GetChange("related_resources"); o.(*schema.Set).Len() > 0 {
+				if _, ok := operationalData[opsItemRelatedResourcesKey]; !ok {
+					input.OperationalDataToDelete = append(input.OperationalDataToDelete, aws.String(opsItemRelatedResourcesKey))
+				}
+			}
+		}
+
+		if d.HasChange("priority") {
+			input.Priority = aws.Int64(int64(d.Get("priority").(int)))
+		}
+
+		if d.HasChange("related_ops_item_ids") {
+			input.RelatedOpsItems = expandRelatedOpsItems(d.Get("related_ops_item_ids").(*schema.Set))
+		}
+
+		if d.HasChange("severity") {
+			input.Severity = aws.String(d.Get("severity").(string))
+		}
+
+		if d.HasChange("status") {
+			input.Status = aws.String(d.Get("status").(string))
+		}
+
+		if d.HasChange("title") {
+			input.Title = aws.String(d.Get("title").(string))
+		}
+
+		log.Printf("[DEBUG] Updating SSM OpsItem: %s", input)
+		_, err := conn.UpdateOpsItemWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM OpsItem (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), ssm.ResourceTypeForTaggingOpsItem, o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM OpsItem (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceOpsItemRead(ctx, d, meta)...)
+}
+
+func resourceOpsItemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	// OpsItems cannot be deleted. Resolve the OpsItem instead.
+	if d.Get("status").(string) == ssm.OpsItemStatusResolved {
+		return diags
+	}
+
+	log.Printf("[DEBUG] Resolving SSM OpsItem: %s", d.Id())
+	_, err := conn.UpdateOpsItemWithContext(ctx, &ssm.UpdateOpsItemInput{
+		OpsItemId: aws.String(d.Id()),
+		Status:    aws.String(ssm.OpsItemStatusResolved),
+	})
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "resolving SSM OpsItem (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+type opsItemRelatedResource struct {
+	ARN string `json:"arn"`
+}
+
+func expandOpsItemOperationalData(d *schema.ResourceData) (map[string]*ssm.OpsItemDataValue, error) {
+	apiObject := make(map[string]*ssm.OpsItemDataValue)
+
+	for _, tfMapRaw := range d.Get("operational_data").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		apiObject[tfMap["key"].(string)] = &ssm.OpsItemDataValue{
+			Type:  aws.String(tfMap["type"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		}
+	}
+
+	if v := d.Get("related_resources").(*schema.Set); v.Len() > 0 {
+		var resources []opsItemRelatedResource
+
+		for _, arn := range flex.ExpandStringValueSet(v) {
+			resources = append(resources, opsItemRelatedResource{ARN: arn})
+		}
+
+		b, err := json.Marshal(resources)
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject[opsItemRelatedResourcesKey] = &ssm.OpsItemDataValue{
+			Type:  aws.String(ssm.OpsItemDataTypeSearchableString),
+			Value: aws.String(string(b)),
+		}
+	}
+
+	return apiObject, nil
+}
+
+func flattenOpsItemOperationalData(apiObject map[string]*ssm.OpsItemDataValue) ([]interface{}, []string, error) {
+	var tfList []interface{}
+	var relatedResources []string
+
+	for k, v := range apiObject {
+		if v == nil {
+			continue
+		}
+
+		if k == opsItemRelatedResourcesKey {
+			var resources []opsItemRelatedResource
+
+			if err := json.Unmarshal([]byte(aws.StringValue(v.Value)), &resources); err != nil {
+				return nil, nil, fmt.Errorf("parsing related resources: %w", err)
+			}
+
+			for _, resource := range resources {
+				relatedResources = append(relatedResources, resource.ARN)
+			}
+
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"key":   k,
+			"type":  aws.StringValue(v.Type),
+			"value": aws.StringValue(v.Value),
+		})
+	}
+
+	return tfList, relatedResources, nil
+}
+
+func expandOpsItemNotifications(tfSet *schema.Set) []*ssm.OpsItemNotification {
+	apiObjects := make([]*ssm.OpsItemNotification, 0, tfSet.Len())
+
+	for _, arn := range flex.ExpandStringValueSet(tfSet) {
+		apiObjects = append(apiObjects, &ssm.OpsItemNotification{
+			Arn: aws.String(arn),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenOpsItemNotifications(apiObjects []*ssm.OpsItemNotification) []string {
+	var tfList []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.Arn))
+	}
+
+	return tfList
+}
+
+func expandRelatedOpsItems(tfSet *schema.Set) []*ssm.RelatedOpsItem {
+	apiObjects := make([]*ssm.RelatedOpsItem, 0, tfSet.Len())
+
+	for _, id := range flex.ExpandStringValueSet(tfSet) {
+		apiObjects = append(apiObjects, &ssm.RelatedOpsItem{
+			OpsItemId: aws.String(id),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenRelatedOpsItems(apiObjects []*ssm.RelatedOpsItem) []string {
+	var tfList []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.OpsItemId))
+	}
+
+	return tfList
+}
@@ -0,0 +1,282 @@
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSMOpsItem_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ssm", regexp.MustCompile(`opsitem/oi-[0-9a-f]+$`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ops_item_type", "/aws/issue"),
+					resource.TestCheckResourceAttr(resourceName, "related_resources.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "source", "terraform"),
+					resource.TestCheckResourceAttr(resourceName, "status", ssm.OpsItemStatusOpen),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "title", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItem_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_full(rName, "Availability", "2", 2, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "category", "Availability"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operational_data.*", map[string]string{
+						"key":   "first",
+						"type":  ssm.OpsItemDataTypeSearchableString,
+						"value": rName,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "priority", "2"),
+					resource.TestCheckResourceAttr(resourceName, "related_resources.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "related_resources.*", "aws_sns_topic.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOpsItemConfig_full(rName, "Security", "3", 4, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "category", "Security"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "operational_data.*", map[string]string{
+						"key":   "second",
+						"value": rName,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "priority", "4"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "3"),
+				),
+			},
+			{
+				Config: testAccOpsItemConfig_status(rName, ssm.OpsItemStatusInProgress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "related_resources.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "status", ssm.OpsItemStatusInProgress),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMOpsItem_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_ops_item.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOpsItemDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOpsItemConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOpsItemConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccOpsItemConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOpsItemExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOpsItemExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM OpsItem ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+
+		_, err := tfssm.FindOpsItemByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+// OpsItems cannot be deleted, so destroying the resource only resolves them.
+func testAccCheckOpsItemDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_ops_item" {
+				continue
+			}
+
+			opsItem, err := tfssm.FindOpsItemByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if aws.StringValue(opsItem.Status) == ssm.OpsItemStatusResolved {
+				continue
+			}
+
+			return fmt.Errorf("SSM OpsItem %s is still %s", rs.Primary.ID, aws.StringValue(opsItem.Status))
+		}
+
+		return nil
+	}
+}
+
+func testAccOpsItemConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "test"
+  source      = "terraform"
+}
+`, rName)
+}
+
+func testAccOpsItemConfig_full(rName, category, severity string, priority int, key string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "test"
+  source      = "terraform"
+  category    = %[2]q
+  severity    = %[3]q
+  priority    = %[4]d
+
+  operational_data {
+    key   = %[5]q
+    value = %[1]q
+  }
+
+  related_resources = [aws_sns_topic.test.arn]
+}
+`, rName, category, severity, priority, key)
+}
+
+func testAccOpsItemConfig_status(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "test"
+  source      = "terraform"
+  status      = %[2]q
+}
+`, rName, status)
+}
+
+func testAccOpsItemConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "test"
+  source      = "terraform"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccOpsItemConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  title       = %[1]q
+  description = "test"
+  source      = "terraform"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -293,7 +293,9 @@ func resourceParameterRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("allowed_pattern", detail.AllowedPattern)
 	d.Set("data_type", detail.DataType)
 
-	tags, err := ListTags(ctx, conn, name, ssm.ResourceTypeForTaggingParameter)
+	tags, err := meta.(*conns.AWSClient).BatchListTags(ctx, aws.StringValue(param.ARN), d.IsNewResource() || d.HasChange("tags_all"), func() (tftags.KeyValueTags, error) {
+		return ListTags(ctx, conn, name, ssm.ResourceTypeForTaggingParameter)
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "listing tags for SSM Parameter (%s): %s", name, err)
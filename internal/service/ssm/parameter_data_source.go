@@ -2,12 +2,14 @@ package ssm
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
@@ -20,6 +22,16 @@ func DataSourceParameter() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"insecure_value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"label": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"version"},
+				ValidateFunc:  validation.StringLenBetween(1, 100),
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -39,8 +51,11 @@ func DataSourceParameter() *schema.Resource {
 				Default:  true,
 			},
 			"version": {
-				Type:     schema.TypeInt,
-				Computed: true,
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"label"},
+				ValidateFunc:  validation.IntAtLeast(1),
 			},
 		},
 	}
@@ -52,8 +67,17 @@ func dataParameterRead(ctx context.Context, d *schema.ResourceData, meta interfa
 
 	name := d.Get("name").(string)
 
+	// A specific version or label is selected by appending it to the name, e.g. name:3 or name:release.
+	// The selector may also be given as part of the name itself.
+	selector := name
+	if v, ok := d.GetOk("label"); ok {
+		selector = fmt.Sprintf("%s:%s", name, v.(string))
+	} else if v, ok := d.GetOk("version"); ok {
+		selector = fmt.Sprintf("%s:%d", name, v.(int))
+	}
+
 	paramInput := &ssm.GetParameterInput{
-		Name:           aws.String(name),
+		Name:           aws.String(selector),
 		WithDecryption: aws.Bool(d.Get("with_decryption").(bool)),
 	}
 
@@ -61,13 +85,19 @@ func dataParameterRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	resp, err := conn.GetParameterWithContext(ctx, paramInput)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "describing SSM parameter (%s): %s", name, err)
+		return sdkdiag.AppendErrorf(diags, "describing SSM parameter (%s): %s", selector, err)
 	}
 
 	param := resp.Parameter
 
 	d.SetId(aws.StringValue(param.Name))
 	d.Set("arn", param.ARN)
+	// The plain text value of a SecureString is never exposed in a non-sensitive attribute.
+	if aws.StringValue(param.Type) != ssm.ParameterTypeSecureString {
+		d.Set("insecure_value", param.Value)
+	} else {
+		d.Set("insecure_value", nil)
+	}
 	d.Set("name", param.Name)
 	d.Set("type", param.Type)
 	d.Set("value", param.Value)
@@ -26,6 +26,7 @@ func TestAccSSMParameterDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttr(resourceName, "type", "String"),
 					resource.TestCheckResourceAttr(resourceName, "value", "TestValue"),
+					resource.TestCheckResourceAttr(resourceName, "insecure_value", "TestValue"),
 					resource.TestCheckResourceAttr(resourceName, "with_decryption", "false"),
 					resource.TestCheckResourceAttrSet(resourceName, "version"),
 				),
@@ -67,6 +68,56 @@ func TestAccSSMParameterDataSource_fullPath(t *testing.T) {
 	})
 }
 
+func TestAccSSMParameterDataSource_version(t *testing.T) {
+	resourceName := "data.aws_ssm_parameter.test"
+	selectorResourceName := "data.aws_ssm_parameter.selector"
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterDataSourceConfig_version(name, "first"),
+			},
+			{
+				Config: testAccParameterDataSourceConfig_version(name, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_ssm_parameter.test", "version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "value", "first"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttr(selectorResourceName, "name", name),
+					resource.TestCheckResourceAttr(selectorResourceName, "value", "first"),
+					resource.TestCheckResourceAttr(selectorResourceName, "version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameterDataSource_secureString(t *testing.T) {
+	resourceName := "data.aws_ssm_parameter.test"
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterDataSourceConfig_secureString(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "SecureString"),
+					resource.TestCheckResourceAttr(resourceName, "value", "TestValue"),
+					resource.TestCheckResourceAttr(resourceName, "insecure_value", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccParameterDataSourceConfig_basic(name string, withDecryption string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_parameter" "test" {
@@ -81,3 +132,36 @@ data "aws_ssm_parameter" "test" {
 }
 `, name, withDecryption)
 }
+
+func testAccParameterDataSourceConfig_version(name, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "String"
+  value = %[2]q
+}
+
+data "aws_ssm_parameter" "test" {
+  name    = aws_ssm_parameter.test.name
+  version = 1
+}
+
+data "aws_ssm_parameter" "selector" {
+  name = "${aws_ssm_parameter.test.name}:1"
+}
+`, name, value)
+}
+
+func testAccParameterDataSourceConfig_secureString(name string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "SecureString"
+  value = "TestValue"
+}
+
+data "aws_ssm_parameter" "test" {
+  name = aws_ssm_parameter.test.name
+}
+`, name)
+}
@@ -0,0 +1,179 @@
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceParameterLabel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceParameterLabelCreate,
+		ReadWithoutTimeout:   resourceParameterLabelRead,
+		UpdateWithoutTimeout: resourceParameterLabelUpdate,
+		DeleteWithoutTimeout: resourceParameterLabelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z_.-][a-zA-Z0-9_.-]{0,99}$`), "must be up to 100 letters, numbers, periods, hyphens or underscores, and must not begin with a number"),
+					validation.StringDoesNotMatch(regexp.MustCompile(`(?i)^(aws|ssm)`), "must not begin with aws or ssm"),
+				),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceParameterLabelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	name := d.Get("name").(string)
+	label := d.Get("label").(string)
+
+	// Without a version the label is attached to the latest version of the parameter.
+	if err := labelParameterVersion(ctx, conn, name, label, int64(d.Get("version").(int))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Parameter Label (%s): %s", label, err)
+	}
+
+	d.SetId(ParameterLabelCreateResourceID(name, label))
+
+	return append(diags, resourceParameterLabelRead(ctx, d, meta)...)
+}
+
+func resourceParameterLabelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	name, label, err := ParameterLabelParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameter Label (%s): %s", d.Id(), err)
+	}
+
+	parameter, err := FindParameterVersionByLabel(ctx, conn, name, label)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Parameter Label (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameter Label (%s): %s", d.Id(), err)
+	}
+
+	d.Set("label", label)
+	d.Set("name", name)
+	d.Set("version", parameter.Version)
+
+	return diags
+}
+
+func resourceParameterLabelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	// Labelling another version moves the label away from the version that currently holds it.
+	if d.HasChange("version") {
+		if err := labelParameterVersion(ctx, conn, d.Get("name").(string), d.Get("label").(string), int64(d.Get("version").(int))); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Parameter Label (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceParameterLabelRead(ctx, d, meta)...)
+}
+
+func resourceParameterLabelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	log.Printf("[DEBUG] Deleting SSM Parameter Label: %s", d.Id())
+	_, err := conn.UnlabelParameterVersionWithContext(ctx, &ssm.UnlabelParameterVersionInput{
+		Labels:           aws.StringSlice([]string{d.Get("label").(string)}),
+		Name:             aws.String(d.Get("name").(string)),
+		ParameterVersion: aws.Int64(int64(d.Get("version").(int))),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeParameterNotFound, ssm.ErrCodeParameterVersionNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Parameter Label (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func labelParameterVersion(ctx context.Context, conn *ssm.SSM, name, label string, version int64) error {
+	input := &ssm.LabelParameterVersionInput{
+		Labels: aws.StringSlice([]string{label}),
+		Name:   aws.String(name),
+	}
+
+	if version > 0 {
+		input.ParameterVersion = aws.Int64(version)
+	}
+
+	output, err := conn.LabelParameterVersionWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	if len(output.InvalidLabels) > 0 {
+		return fmt.Errorf("invalid labels: %s", strings.Join(aws.StringValueSlice(output.InvalidLabels), ", "))
+	}
+
+	return nil
+}
+
+const parameterLabelResourceIDSeparator = ","
+
+func ParameterLabelCreateResourceID(name, label string) string {
+	parts := []string{name, label}
+	id := strings.Join(parts, parameterLabelResourceIDSeparator)
+
+	return id
+}
+
+func ParameterLabelParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, parameterLabelResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected NAME%[2]sLABEL", id, parameterLabelResourceIDSeparator)
+}
@@ -0,0 +1,203 @@
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSMParameterLabel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameter_label.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterLabelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterLabelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterLabelExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "label", "production"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", "aws_ssm_parameter.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameterLabel_version(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameter_label.test"
+	dataSourceName := "data.aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterLabelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterLabelConfig_version(rName, "first", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterLabelExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccParameterLabelConfig_version(rName, "second", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterLabelExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "value", "first"),
+				),
+			},
+			{
+				Config: testAccParameterLabelConfig_version(rName, "second", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterLabelExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "value", "second"),
+					resource.TestCheckResourceAttr(dataSourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParameterLabel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameter_label.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterLabelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterLabelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterLabelExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfssm.ResourceParameterLabel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckParameterLabelExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Parameter Label ID is set")
+		}
+
+		name, label, err := tfssm.ParameterLabelParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+
+		_, err = tfssm.FindParameterVersionByLabel(ctx, conn, name, label)
+
+		return err
+	}
+}
+
+func testAccCheckParameterLabelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_parameter_label" {
+				continue
+			}
+
+			name, label, err := tfssm.ParameterLabelParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfssm.FindParameterVersionByLabel(ctx, conn, name, label)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSM Parameter Label %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccParameterLabelConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "String"
+  value = "test"
+}
+
+resource "aws_ssm_parameter_label" "test" {
+  name  = aws_ssm_parameter.test.name
+  label = "production"
+}
+`, rName)
+}
+
+func testAccParameterLabelConfig_version(rName, value string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "String"
+  value = %[2]q
+}
+
+resource "aws_ssm_parameter_label" "test" {
+  name    = aws_ssm_parameter.test.name
+  label   = "production"
+  version = %[3]d
+}
+
+data "aws_ssm_parameter" "test" {
+  name  = aws_ssm_parameter_label.test.name
+  label = aws_ssm_parameter_label.test.label
+
+  depends_on = [aws_ssm_parameter.test]
+}
+`, rName, value, version)
+}
@@ -2,13 +2,16 @@ package ssm
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
 func DataSourceParametersByPath() *schema.Resource {
@@ -21,11 +24,41 @@ func DataSourceParametersByPath() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"KeyId", "Label", "Tier", "Type"}, false),
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"names": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"parameters": {
+				Type:      schema.TypeMap,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+			},
 			"path": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -60,16 +93,50 @@ func dataSourceParametersReadByPath(ctx context.Context, d *schema.ResourceData,
 	conn := meta.(*conns.AWSClient).SSMConn()
 
 	path := d.Get("path").(string)
+	recursive := d.Get("recursive").(bool)
 	input := &ssm.GetParametersByPathInput{
 		Path:           aws.String(path),
-		Recursive:      aws.Bool(d.Get("recursive").(bool)),
+		Recursive:      aws.Bool(recursive),
 		WithDecryption: aws.Bool(d.Get("with_decryption").(bool)),
 	}
 
+	// GetParametersByPath doesn't support the Tier filter, so it's applied to the names returned by DescribeParameters instead.
+	var tiers []string
+
+	for _, tfMapRaw := range d.Get("filter").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		key := tfMap["key"].(string)
+		values := flex.ExpandStringValueSet(tfMap["values"].(*schema.Set))
+
+		if key == "Tier" {
+			tiers = append(tiers, values...)
+			continue
+		}
+
+		input.ParameterFilters = append(input.ParameterFilters, &ssm.ParameterStringFilter{
+			Key:    aws.String(key),
+			Option: aws.String("Equals"),
+			Values: aws.StringSlice(values),
+		})
+	}
+
+	var tierNames map[string]struct{}
+
+	if len(tiers) > 0 {
+		var err error
+		tierNames, err = findParameterNamesByPathAndTiers(ctx, conn, path, recursive, tiers)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "getting SSM parameters by path (%s): %s", path, err)
+		}
+	}
+
+	maxResults := d.Get("max_results").(int)
 	arns := make([]string, 0)
 	names := make([]string, 0)
 	types := make([]string, 0)
 	values := make([]string, 0)
+	parameters := make(map[string]string)
 
 	err := conn.GetParametersByPathPagesWithContext(ctx, input, func(page *ssm.GetParametersByPathOutput, lastPage bool) bool {
 		if page == nil {
@@ -77,10 +144,23 @@ func dataSourceParametersReadByPath(ctx context.Context, d *schema.ResourceData,
 		}
 
 		for _, param := range page.Parameters {
+			name := aws.StringValue(param.Name)
+
+			if tierNames != nil {
+				if _, ok := tierNames[name]; !ok {
+					continue
+				}
+			}
+
+			if maxResults > 0 && len(names) >= maxResults {
+				return false
+			}
+
 			arns = append(arns, aws.StringValue(param.ARN))
-			names = append(names, aws.StringValue(param.Name))
+			names = append(names, name)
 			types = append(types, aws.StringValue(param.Type))
 			values = append(values, aws.StringValue(param.Value))
+			parameters[parameterRelativeName(path, name)] = aws.StringValue(param.Value)
 		}
 
 		return !lastPage
@@ -93,8 +173,55 @@ func dataSourceParametersReadByPath(ctx context.Context, d *schema.ResourceData,
 	d.SetId(path)
 	d.Set("arns", arns)
 	d.Set("names", names)
+	d.Set("parameters", parameters)
 	d.Set("types", types)
 	d.Set("values", values)
 
 	return diags
 }
+
+func findParameterNamesByPathAndTiers(ctx context.Context, conn *ssm.SSM, path string, recursive bool, tiers []string) (map[string]struct{}, error) {
+	option := "OneLevel"
+	if recursive {
+		option = "Recursive"
+	}
+
+	input := &ssm.DescribeParametersInput{
+		ParameterFilters: []*ssm.ParameterStringFilter{
+			{
+				Key:    aws.String("Path"),
+				Option: aws.String(option),
+				Values: aws.StringSlice([]string{path}),
+			},
+			{
+				Key:    aws.String("Tier"),
+				Values: aws.StringSlice(tiers),
+			},
+		},
+	}
+	names := make(map[string]struct{})
+
+	err := conn.DescribeParametersPagesWithContext(ctx, input, func(page *ssm.DescribeParametersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Parameters {
+			names[aws.StringValue(v.Name)] = struct{}{}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
+// parameterRelativeName returns the parameter name without the path prefix,
+// e.g. "db/password" for "/app/db/password" under "/app".
+func parameterRelativeName(path, name string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(name, strings.TrimSuffix(path, "/")), "/")
+}
@@ -116,3 +116,133 @@ data "aws_ssm_parameters_by_path" "recursive" {
 }
 `, pathPrefix)
 }
+
+func TestAccSSMParametersByPathDataSource_filter(t *testing.T) {
+	resourceName := "data.aws_ssm_parameters_by_path.test"
+	pathPrefix := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersByPathDataSourceConfig_filter(pathPrefix, "Type", ssm.ParameterTypeStringList),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "names.0", "aws_ssm_parameter.list", "name"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.nested/list", "a,b"),
+				),
+			},
+			{
+				Config: testAccParametersByPathDataSourceConfig_filter(pathPrefix, "Tier", ssm.ParameterTierAdvanced),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "names.0", "aws_ssm_parameter.advanced", "name"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.advanced", "TestValueB"),
+				),
+			},
+			{
+				Config: testAccParametersByPathDataSourceConfig_filter(pathPrefix, "Label", "production"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "names.0", "aws_ssm_parameter.standard", "name"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.standard", "TestValueA"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParametersByPathDataSource_maxResults(t *testing.T) {
+	resourceName := "data.aws_ssm_parameters_by_path.test"
+	pathPrefix := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersByPathDataSourceConfig_maxResults(pathPrefix, 12),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "names.#", "12"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "12"),
+				),
+			},
+		},
+	})
+}
+
+func testAccParametersByPathDataSourceConfig_filterBase(pathPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "standard" {
+  name  = "/%[1]s/standard"
+  type  = "String"
+  value = "TestValueA"
+}
+
+resource "aws_ssm_parameter_label" "standard" {
+  name  = aws_ssm_parameter.standard.name
+  label = "production"
+}
+
+resource "aws_ssm_parameter" "advanced" {
+  name  = "/%[1]s/advanced"
+  type  = "String"
+  tier  = "Advanced"
+  value = "TestValueB"
+}
+
+resource "aws_ssm_parameter" "list" {
+  name  = "/%[1]s/nested/list"
+  type  = "StringList"
+  value = "a,b"
+}
+`, pathPrefix)
+}
+
+func testAccParametersByPathDataSourceConfig_filter(pathPrefix, key, value string) string {
+	return acctest.ConfigCompose(
+		testAccParametersByPathDataSourceConfig_filterBase(pathPrefix),
+		fmt.Sprintf(`
+data "aws_ssm_parameters_by_path" "test" {
+  path      = "/%[1]s"
+  recursive = true
+
+  filter {
+    key    = %[2]q
+    values = [%[3]q]
+  }
+
+  depends_on = [
+    aws_ssm_parameter.standard,
+    aws_ssm_parameter_label.standard,
+    aws_ssm_parameter.advanced,
+    aws_ssm_parameter.list,
+  ]
+}
+`, pathPrefix, key, value))
+}
+
+func testAccParametersByPathDataSourceConfig_maxResults(pathPrefix string, maxResults int) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  count = 25
+
+  name  = "/%[1]s/param-${count.index}"
+  type  = "String"
+  value = "TestValue${count.index}"
+}
+
+data "aws_ssm_parameters_by_path" "test" {
+  path        = "/%[1]s"
+  max_results = %[2]d
+
+  depends_on = [aws_ssm_parameter.test]
+}
+`, pathPrefix, maxResults)
+}
@@ -321,7 +321,11 @@ func resourcePatchBaselineUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	if d.HasChange("source") {
+		// Sources are updated in place. An empty list removes all alternate source repositories.
 		params.Sources = expandPatchSource(d)
+		if params.Sources == nil {
+			params.Sources = []*ssm.PatchSource{}
+		}
 	}
 
 	if d.HasChange("approved_patches_enable_non_security") {
@@ -282,6 +282,19 @@ func TestAccSSMPatchBaseline_sources(t *testing.T) {
 					},
 				),
 			},
+			{
+				Config: testAccPatchBaselineConfig_sourceRemoved(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPatchBaselineExists(ctx, resourceName, &after),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "0"),
+					func(*terraform.State) error {
+						if aws.StringValue(before.BaselineId) != aws.StringValue(after.BaselineId) {
+							t.Fatal("Baseline IDs changed unexpectedly")
+						}
+						return nil
+					},
+				),
+			},
 		},
 	})
 }
@@ -672,6 +685,18 @@ resource "aws_ssm_patch_baseline" "test" {
 `, rName)
 }
 
+func testAccPatchBaselineConfig_sourceRemoved(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name                              = %[1]q
+  description                       = "Baseline containing all updates approved for production systems"
+  approved_patches_compliance_level = "CRITICAL"
+  approved_patches                  = ["test123"]
+  operating_system                  = "AMAZON_LINUX"
+}
+`, rName)
+}
+
 func testAccPatchBaselineConfig_basicApprovedPatchesNonSec(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_patch_baseline" "test" {
@@ -0,0 +1,174 @@
+package ssm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+func DataSourcePatchBaselines() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataPatchBaselinesRead,
+		Schema: map[string]*schema.Schema{
+			"baseline_identities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"baseline_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"baseline_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"baseline_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_baseline": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"operating_system": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"default_baselines": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"NAME_PREFIX", "OPERATING_SYSTEM", "OWNER"}, false),
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataPatchBaselinesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	input := &ssm.DescribePatchBaselinesInput{}
+
+	if v, ok := d.GetOk("filter"); ok {
+		input.Filters = expandPatchOrchestratorFilters(v.(*schema.Set).List())
+	}
+
+	var results []*ssm.PatchBaselineIdentity
+
+	err := conn.DescribePatchBaselinesPagesWithContext(ctx, input, func(page *ssm.DescribePatchBaselinesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, baselineIdentity := range page.BaselineIdentities {
+			if baselineIdentity == nil {
+				continue
+			}
+
+			if d.Get("default_baselines").(bool) && !aws.BoolValue(baselineIdentity.DefaultBaseline) {
+				continue
+			}
+
+			results = append(results, baselineIdentity)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Patch Baselines: %s", err)
+	}
+
+	var baselineIDs []string
+
+	for _, r := range results {
+		baselineIDs = append(baselineIDs, aws.StringValue(r.BaselineId))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("baseline_identities", flattenPatchBaselineIdentities(results)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting baseline_identities: %s", err)
+	}
+	d.Set("ids", baselineIDs)
+
+	return diags
+}
+
+func expandPatchOrchestratorFilters(tfList []interface{}) []*ssm.PatchOrchestratorFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ssm.PatchOrchestratorFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ssm.PatchOrchestratorFilter{}
+
+		if v, ok := tfMap["key"].(string); ok && v != "" {
+			apiObject.Key = aws.String(v)
+		}
+
+		if v, ok := tfMap["values"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Values = flex.ExpandStringList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenPatchBaselineIdentities(apiObjects []*ssm.PatchBaselineIdentity) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"baseline_description": aws.StringValue(apiObject.BaselineDescription),
+			"baseline_id":          aws.StringValue(apiObject.BaselineId),
+			"baseline_name":        aws.StringValue(apiObject.BaselineName),
+			"default_baseline":     aws.BoolValue(apiObject.DefaultBaseline),
+			"operating_system":     aws.StringValue(apiObject.OperatingSystem),
+		})
+	}
+
+	return tfList
+}
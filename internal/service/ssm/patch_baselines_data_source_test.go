@@ -0,0 +1,102 @@
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSSMPatchBaselinesDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_patch_baselines.test"
+	resourceName := "aws_ssm_patch_baseline.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchBaselineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchBaselinesDataSourceConfig_filter(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "baseline_identities.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "baseline_identities.0.baseline_description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "baseline_identities.0.baseline_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "baseline_identities.0.baseline_name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "baseline_identities.0.default_baseline", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "baseline_identities.0.operating_system", resourceName, "operating_system"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMPatchBaselinesDataSource_defaultBaselines(t *testing.T) {
+	dataSourceName := "data.aws_ssm_patch_baselines.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchBaselinesDataSourceConfig_defaultBaselines(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "baseline_identities.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "baseline_identities.0.default_baseline", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "baseline_identities.0.operating_system", "AMAZON_LINUX_2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPatchBaselinesDataSourceConfig_filter(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name             = %[1]q
+  description      = "Baseline containing all updates approved for production systems"
+  operating_system = "AMAZON_LINUX_2"
+  approved_patches = ["KB123456"]
+}
+
+data "aws_ssm_patch_baselines" "test" {
+  filter {
+    key    = "OWNER"
+    values = ["Self"]
+  }
+
+  filter {
+    key    = "NAME_PREFIX"
+    values = [aws_ssm_patch_baseline.test.name]
+  }
+}
+`, rName)
+}
+
+func testAccPatchBaselinesDataSourceConfig_defaultBaselines() string {
+	return `
+data "aws_ssm_patch_baselines" "test" {
+  default_baselines = true
+
+  filter {
+    key    = "OWNER"
+    values = ["AWS"]
+  }
+
+  filter {
+    key    = "OPERATING_SYSTEM"
+    values = ["AMAZON_LINUX_2"]
+  }
+}
+`
+}
@@ -0,0 +1,105 @@
+package ssm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourcePatchGroupState() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataPatchGroupStateRead,
+		Schema: map[string]*schema.Schema{
+			"instances": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_critical_non_compliant_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_failed_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_installed_other_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_installed_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_installed_pending_reboot_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_installed_rejected_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_missing_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_not_applicable_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_other_non_compliant_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_security_non_compliant_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"instances_with_unreported_not_applicable_patches": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"patch_group": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+func dataPatchGroupStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	patchGroup := d.Get("patch_group").(string)
+	output, err := conn.DescribePatchGroupStateWithContext(ctx, &ssm.DescribePatchGroupStateInput{
+		PatchGroup: aws.String(patchGroup),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Patch Group (%s) state: %s", patchGroup, err)
+	}
+
+	d.SetId(patchGroup)
+	d.Set("instances", output.Instances)
+	d.Set("instances_with_critical_non_compliant_patches", output.InstancesWithCriticalNonCompliantPatches)
+	d.Set("instances_with_failed_patches", output.InstancesWithFailedPatches)
+	d.Set("instances_with_installed_other_patches", output.InstancesWithInstalledOtherPatches)
+	d.Set("instances_with_installed_patches", output.InstancesWithInstalledPatches)
+	d.Set("instances_with_installed_pending_reboot_patches", output.InstancesWithInstalledPendingRebootPatches)
+	d.Set("instances_with_installed_rejected_patches", output.InstancesWithInstalledRejectedPatches)
+	d.Set("instances_with_missing_patches", output.InstancesWithMissingPatches)
+	d.Set("instances_with_not_applicable_patches", output.InstancesWithNotApplicablePatches)
+	d.Set("instances_with_other_non_compliant_patches", output.InstancesWithOtherNonCompliantPatches)
+	d.Set("instances_with_security_non_compliant_patches", output.InstancesWithSecurityNonCompliantPatches)
+	d.Set("instances_with_unreported_not_applicable_patches", output.InstancesWithUnreportedNotApplicablePatches)
+	d.Set("patch_group", patchGroup)
+
+	return diags
+}
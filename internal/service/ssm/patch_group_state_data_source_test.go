@@ -0,0 +1,53 @@
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccSSMPatchGroupStateDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_patch_group_state.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchGroupStateDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "patch_group", "aws_ssm_patch_group.test", "patch_group"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_failed_patches", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances_with_missing_patches", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPatchGroupStateDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name             = %[1]q
+  approved_patches = ["KB123456"]
+}
+
+resource "aws_ssm_patch_group" "test" {
+  baseline_id = aws_ssm_patch_baseline.test.id
+  patch_group = %[1]q
+}
+
+data "aws_ssm_patch_group_state" "test" {
+  patch_group = aws_ssm_patch_group.test.patch_group
+}
+`, rName)
+}
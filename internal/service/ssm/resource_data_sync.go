@@ -3,23 +3,29 @@ package ssm
 import (
 	"context"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceResourceDataSync() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceResourceDataSyncCreate,
 		ReadWithoutTimeout:   resourceResourceDataSyncRead,
+		UpdateWithoutTimeout: resourceResourceDataSyncUpdate,
 		DeleteWithoutTimeout: resourceResourceDataSyncDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -33,10 +39,11 @@ func ResourceResourceDataSync() *schema.Resource {
 				ForceNew: true,
 			},
 			"s3_destination": {
-				Type:     schema.TypeList,
-				Required: true,
-				ForceNew: true,
-				MaxItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"s3_destination", "sync_source"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"kms_key_arn": {
@@ -49,6 +56,12 @@ func ResourceResourceDataSync() *schema.Resource {
 							Required: true,
 							ForceNew: true,
 						},
+						"destination_data_sharing_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(resourceDataSyncDestinationDataSharingType_Values(), false),
+						},
 						"prefix": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -68,6 +81,66 @@ func ResourceResourceDataSync() *schema.Resource {
 					},
 				},
 			},
+			"sync_source": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"s3_destination", "sync_source"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_organizations_source": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"organization_source_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(resourceDataSyncOrganizationSourceType_Values(), false),
+									},
+									"organizational_units": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"enable_all_ops_data_sources": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"include_future_regions": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"source_regions": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidRegionName,
+							},
+						},
+						"source_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(resourceDataSyncSourceType_Values(), false),
+						},
+					},
+				},
+			},
+			"sync_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -79,8 +152,19 @@ func resourceResourceDataSyncCreate(ctx context.Context, d *schema.ResourceData,
 	name := d.Get("name").(string)
 
 	input := &ssm.CreateResourceDataSyncInput{
-		S3Destination: expandResourceDataSyncS3Destination(d),
-		SyncName:      aws.String(name),
+		SyncName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("s3_destination"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.S3Destination = expandResourceDataSyncS3Destination(v.([]interface{})[0].(map[string]interface{}))
+		input.SyncType = aws.String(resourceDataSyncTypeSyncToDestination)
+
+		diags = append(diags, checkResourceDataSyncS3DestinationPolicy(ctx, meta.(*conns.AWSClient), aws.StringValue(input.S3Destination.BucketName))...)
+	}
+
+	if v, ok := d.GetOk("sync_source"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SyncSource = expandResourceDataSyncSource(v.([]interface{})[0].(map[string]interface{}))
+		input.SyncType = aws.String(resourceDataSyncTypeSyncFromSource)
 	}
 
 	err := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
@@ -122,10 +206,38 @@ func resourceResourceDataSyncRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	d.Set("name", syncItem.SyncName)
-	d.Set("s3_destination", flattenResourceDataSyncS3Destination(syncItem.S3Destination))
+	if err := d.Set("s3_destination", flattenResourceDataSyncS3Destination(syncItem.S3Destination)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting s3_destination: %s", err)
+	}
+	if err := d.Set("sync_source", flattenResourceDataSyncSource(syncItem.SyncSource)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sync_source: %s", err)
+	}
+	d.Set("sync_type", syncItem.SyncType)
 	return diags
 }
 
+func resourceResourceDataSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	// Only syncs of type SyncFromSource can be updated.
+	if d.HasChange("sync_source") {
+		input := &ssm.UpdateResourceDataSyncInput{
+			SyncName:   aws.String(d.Id()),
+			SyncSource: expandResourceDataSyncSource(d.Get("sync_source").([]interface{})[0].(map[string]interface{})),
+			SyncType:   aws.String(resourceDataSyncTypeSyncFromSource),
+		}
+
+		_, err := conn.UpdateResourceDataSyncWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating SSM Resource Data Sync (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceResourceDataSyncRead(ctx, d, meta)...)
+}
+
 func resourceResourceDataSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMConn()
@@ -173,7 +285,45 @@ func FindResourceDataSyncItem(ctx context.Context, conn *ssm.SSM, name string) (
 	return result, nil
 }
 
+// checkResourceDataSyncS3DestinationPolicy returns warnings if the destination bucket's policy
+// does not appear to grant Systems Manager the permissions it needs to write the synced data.
+// The check is best effort: if the policy cannot be read, e.g. for a bucket in another account, no warning is returned.
+func checkResourceDataSyncS3DestinationPolicy(ctx context.Context, client *conns.AWSClient, bucket string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	output, err := client.S3Conn().GetBucketPolicyWithContext(ctx, &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	})
+
+	if tfawserr.ErrCodeEquals(err, "NoSuchBucketPolicy") {
+		return sdkdiag.AppendWarningf(diags, "S3 bucket (%s) has no bucket policy. SSM Resource Data Sync requires a bucket policy allowing %s to perform s3:GetBucketAcl and s3:PutObject.", bucket, client.PartitionHostname("ssm"))
+	}
+
+	if err != nil {
+		log.Printf("[WARN] Unable to read S3 bucket (%s) policy, skipping SSM Resource Data Sync destination check: %s", bucket, err)
+		return diags
+	}
+
+	policy := aws.StringValue(output.Policy)
+
+	if !strings.Contains(policy, client.PartitionHostname("ssm")) {
+		return sdkdiag.AppendWarningf(diags, "S3 bucket (%s) policy does not grant access to %s. SSM Resource Data Sync may fail to write to the bucket.", bucket, client.PartitionHostname("ssm"))
+	}
+
+	for _, action := range []string{"s3:GetBucketAcl", "s3:PutObject"} {
+		if !strings.Contains(policy, action) && !strings.Contains(policy, "s3:*") {
+			diags = sdkdiag.AppendWarningf(diags, "S3 bucket (%s) policy does not allow %s. SSM Resource Data Sync may fail to write to the bucket.", bucket, action)
+		}
+	}
+
+	return diags
+}
+
 func flattenResourceDataSyncS3Destination(dest *ssm.ResourceDataSyncS3Destination) []interface{} {
+	if dest == nil {
+		return nil
+	}
+
 	result := make(map[string]interface{})
 	result["bucket_name"] = aws.StringValue(dest.BucketName)
 	result["region"] = aws.StringValue(dest.Region)
@@ -181,19 +331,26 @@ func flattenResourceDataSyncS3Destination(dest *ssm.ResourceDataSyncS3Destinatio
 	if dest.AWSKMSKeyARN != nil {
 		result["kms_key_arn"] = aws.StringValue(dest.AWSKMSKeyARN)
 	}
+	if dest.DestinationDataSharing != nil {
+		result["destination_data_sharing_type"] = aws.StringValue(dest.DestinationDataSharing.DestinationDataSharingType)
+	}
 	if dest.Prefix != nil {
 		result["prefix"] = aws.StringValue(dest.Prefix)
 	}
 	return []interface{}{result}
 }
 
-func expandResourceDataSyncS3Destination(d *schema.ResourceData) *ssm.ResourceDataSyncS3Destination {
-	raw := d.Get("s3_destination").([]interface{})[0].(map[string]interface{})
+func expandResourceDataSyncS3Destination(raw map[string]interface{}) *ssm.ResourceDataSyncS3Destination {
 	s3dest := &ssm.ResourceDataSyncS3Destination{
 		BucketName: aws.String(raw["bucket_name"].(string)),
 		Region:     aws.String(raw["region"].(string)),
 		SyncFormat: aws.String(raw["sync_format"].(string)),
 	}
+	if v, ok := raw["destination_data_sharing_type"].(string); ok && v != "" {
+		s3dest.DestinationDataSharing = &ssm.ResourceDataSyncDestinationDataSharing{
+			DestinationDataSharingType: aws.String(v),
+		}
+	}
 	if v, ok := raw["kms_key_arn"].(string); ok && v != "" {
 		s3dest.AWSKMSKeyARN = aws.String(v)
 	}
@@ -202,3 +359,66 @@ func expandResourceDataSyncS3Destination(d *schema.ResourceData) *ssm.ResourceDa
 	}
 	return s3dest
 }
+
+func expandResourceDataSyncSource(tfMap map[string]interface{}) *ssm.ResourceDataSyncSource {
+	apiObject := &ssm.ResourceDataSyncSource{
+		EnableAllOpsDataSources: aws.Bool(tfMap["enable_all_ops_data_sources"].(bool)),
+		IncludeFutureRegions:    aws.Bool(tfMap["include_future_regions"].(bool)),
+		SourceRegions:           flex.ExpandStringSet(tfMap["source_regions"].(*schema.Set)),
+		SourceType:              aws.String(tfMap["source_type"].(string)),
+	}
+
+	if v, ok := tfMap["aws_organizations_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AwsOrganizationsSource = expandResourceDataSyncAwsOrganizationsSource(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandResourceDataSyncAwsOrganizationsSource(tfMap map[string]interface{}) *ssm.ResourceDataSyncAwsOrganizationsSource {
+	apiObject := &ssm.ResourceDataSyncAwsOrganizationsSource{
+		OrganizationSourceType: aws.String(tfMap["organization_source_type"].(string)),
+	}
+
+	if v, ok := tfMap["organizational_units"].(*schema.Set); ok && v.Len() > 0 {
+		for _, id := range flex.ExpandStringValueSet(v) {
+			apiObject.OrganizationalUnits = append(apiObject.OrganizationalUnits, &ssm.ResourceDataSyncOrganizationalUnit{
+				OrganizationalUnitId: aws.String(id),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenResourceDataSyncSource(apiObject *ssm.ResourceDataSyncSourceWithState) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enable_all_ops_data_sources": aws.BoolValue(apiObject.EnableAllOpsDataSources),
+		"include_future_regions":      aws.BoolValue(apiObject.IncludeFutureRegions),
+		"source_regions":              aws.StringValueSlice(apiObject.SourceRegions),
+		"source_type":                 aws.StringValue(apiObject.SourceType),
+	}
+
+	if v := apiObject.AwsOrganizationsSource; v != nil {
+		var ouIDs []string
+
+		for _, ou := range v.OrganizationalUnits {
+			if ou == nil {
+				continue
+			}
+
+			ouIDs = append(ouIDs, aws.StringValue(ou.OrganizationalUnitId))
+		}
+
+		tfMap["aws_organizations_source"] = []interface{}{map[string]interface{}{
+			"organization_source_type": aws.StringValue(v.OrganizationSourceType),
+			"organizational_units":     ouIDs,
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
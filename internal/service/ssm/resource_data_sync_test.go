@@ -73,6 +73,46 @@ func TestAccSSMResourceDataSync_update(t *testing.T) {
 	})
 }
 
+func TestAccSSMResourceDataSync_syncSource(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_resource_data_sync.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDataSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDataSyncConfig_syncSource(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceDataSyncExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "s3_destination.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "sync_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sync_source.0.source_type", "SingleAccountMultiRegions"),
+					resource.TestCheckResourceAttr(resourceName, "sync_source.0.source_regions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sync_source.0.include_future_regions", "false"),
+					resource.TestCheckResourceAttr(resourceName, "sync_type", "SyncFromSource"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccResourceDataSyncConfig_syncSource(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceDataSyncExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sync_source.0.include_future_regions", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sync_type", "SyncFromSource"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckResourceDataSyncDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
@@ -227,3 +267,19 @@ resource "aws_ssm_resource_data_sync" "test" {
 }
 `, rInt, rName)
 }
+
+func testAccResourceDataSyncConfig_syncSource(rName string, includeFutureRegions bool) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_ssm_resource_data_sync" "test" {
+  name = %[1]q
+
+  sync_source {
+    source_type            = "SingleAccountMultiRegions"
+    source_regions         = [data.aws_region.current.name]
+    include_future_regions = %[2]t
+  }
+}
+`, rName, includeFutureRegions)
+}
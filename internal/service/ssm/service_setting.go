@@ -3,6 +3,7 @@ package ssm
 import (
 	"context"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -33,8 +35,10 @@ func ResourceServiceSetting() *schema.Resource {
 				Computed: true,
 			},
 			"setting_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: serviceSettingIDDiffSuppress,
 			},
 			"setting_value": {
 				Type:     schema.TypeString,
@@ -76,9 +80,14 @@ func resourceServiceSettingRead(ctx context.Context, d *schema.ResourceData, met
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMConn()
 
-	log.Printf("[DEBUG] Reading SSM Activation: %s", d.Id())
-
 	output, err := FindServiceSettingByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Service Setting (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
 	if err != nil {
 		return create.DiagError(names.SSM, create.ErrActionReading, ResNameServiceSetting, d.Id(), err)
 	}
@@ -114,3 +123,17 @@ func resourceServiceSettingReset(ctx context.Context, d *schema.ResourceData, me
 
 	return diags
 }
+
+// serviceSettingIDDiffSuppress suppresses differences between a setting ID,
+// e.g. /ssm/parameter-store/high-throughput-enabled, and the equivalent service setting ARN.
+func serviceSettingIDDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return serviceSettingIDFromARN(old) == serviceSettingIDFromARN(new)
+}
+
+func serviceSettingIDFromARN(s string) string {
+	if _, id, ok := strings.Cut(s, ":servicesetting"); ok {
+		return id
+	}
+
+	return s
+}
@@ -51,6 +51,30 @@ func TestAccSSMServiceSetting_basic(t *testing.T) {
 	})
 }
 
+func TestAccSSMServiceSetting_settingIDPath(t *testing.T) {
+	ctx := acctest.Context(t)
+	var setting ssm.ServiceSetting
+	resourceName := "aws_ssm_service_setting.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceSettingDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceSettingConfig_settingIDPath("true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccServiceSettingExists(ctx, resourceName, &setting),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "ssm", "servicesetting/ssm/parameter-store/high-throughput-enabled"),
+					resource.TestCheckResourceAttr(resourceName, "setting_value", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Customized"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckServiceSettingDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
@@ -118,3 +142,12 @@ resource "aws_ssm_service_setting" "test" {
 }
 `, settingValue)
 }
+
+func testAccServiceSettingConfig_settingIDPath(settingValue string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_service_setting" "test" {
+  setting_id    = "/ssm/parameter-store/high-throughput-enabled"
+  setting_value = %[1]q
+}
+`, settingValue)
+}
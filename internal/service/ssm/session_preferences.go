@@ -0,0 +1,400 @@
+package ssm
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+const (
+	// The Session Manager console and the AWS CLI read preferences from this document by default.
+	sessionPreferencesDefaultDocumentName = "SSM-SessionManagerRunShell"
+)
+
+func ResourceSessionPreferences() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSessionPreferencesCreate,
+		ReadWithoutTimeout:   resourceSessionPreferencesRead,
+		UpdateWithoutTimeout: resourceSessionPreferencesUpdate,
+		DeleteWithoutTimeout: resourceSessionPreferencesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloudwatch_logging": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"log_group_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"streaming_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"idle_session_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_session_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 1440),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  sessionPreferencesDefaultDocumentName,
+			},
+			"run_as_default_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"s3_logging": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"encryption_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"key_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"shell_profile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"linux": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"windows": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSessionPreferencesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	content, err := expandSessionPreferencesDocument(d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Session Preferences: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	input := &ssm.CreateDocumentInput{
+		Content:        aws.String(content),
+		DocumentFormat: aws.String(ssm.DocumentFormatJson),
+		DocumentType:   aws.String(ssm.DocumentTypeSession),
+		Name:           aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating SSM Session Preferences: %s", input)
+	_, err = conn.CreateDocumentWithContext(ctx, input)
+
+	// The preferences document is created the first time preferences are saved in the console.
+	// Adopt an existing document rather than requiring it to be imported.
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeDocumentAlreadyExists) {
+		log.Printf("[INFO] SSM Session Preferences (%s) already exist, updating", name)
+		err = updateSessionPreferencesDocument(ctx, conn, name, content)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SSM Session Preferences (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitDocumentActive(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SSM Session Preferences (%s) to be Active: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSessionPreferencesRead(ctx, d, meta)...)
+}
+
+func resourceSessionPreferencesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	output, err := conn.GetDocumentWithContext(ctx, &ssm.GetDocumentInput{
+		DocumentFormat: aws.String(ssm.DocumentFormatJson),
+		Name:           aws.String(d.Id()),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ssm.ErrCodeInvalidDocument) {
+		log.Printf("[WARN] SSM Session Preferences (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Session Preferences (%s): %s", d.Id(), err)
+	}
+
+	var doc sessionPreferencesDocument
+
+	if err := json.Unmarshal([]byte(aws.StringValue(output.Content)), &doc); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Session Preferences (%s): parsing document content: %s", d.Id(), err)
+	}
+
+	inputs := doc.Inputs
+
+	if inputs.CloudWatchLogGroupName != "" {
+		if err := d.Set("cloudwatch_logging", []interface{}{map[string]interface{}{
+			"encryption_enabled": inputs.CloudWatchEncryptionEnabled,
+			"log_group_name":     inputs.CloudWatchLogGroupName,
+			"streaming_enabled":  inputs.CloudWatchStreamingEnabled,
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting cloudwatch_logging: %s", err)
+		}
+	} else {
+		d.Set("cloudwatch_logging", nil)
+	}
+	if v, err := strconv.Atoi(inputs.IdleSessionTimeout); err == nil {
+		d.Set("idle_session_timeout", v)
+	}
+	d.Set("kms_key_id", inputs.KMSKeyID)
+	if v, err := strconv.Atoi(inputs.MaxSessionDuration); err == nil {
+		d.Set("max_session_duration", v)
+	} else {
+		d.Set("max_session_duration", nil)
+	}
+	d.Set("name", output.Name)
+	if inputs.RunAsEnabled {
+		d.Set("run_as_default_user", inputs.RunAsDefaultUser)
+	} else {
+		d.Set("run_as_default_user", nil)
+	}
+	if inputs.S3BucketName != "" {
+		if err := d.Set("s3_logging", []interface{}{map[string]interface{}{
+			"bucket_name":        inputs.S3BucketName,
+			"encryption_enabled": inputs.S3EncryptionEnabled,
+			"key_prefix":         inputs.S3KeyPrefix,
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting s3_logging: %s", err)
+		}
+	} else {
+		d.Set("s3_logging", nil)
+	}
+	if inputs.ShellProfile.Linux != "" || inputs.ShellProfile.Windows != "" {
+		if err := d.Set("shell_profile", []interface{}{map[string]interface{}{
+			"linux":   inputs.ShellProfile.Linux,
+			"windows": inputs.ShellProfile.Windows,
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting shell_profile: %s", err)
+		}
+	} else {
+		d.Set("shell_profile", nil)
+	}
+
+	return diags
+}
+
+func resourceSessionPreferencesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	content, err := expandSessionPreferencesDocument(d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SSM Session Preferences (%s): %s", d.Id(), err)
+	}
+
+	if err := updateSessionPreferencesDocument(ctx, conn, d.Id(), content); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SSM Session Preferences (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDocumentActive(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for SSM Session Preferences (%s) to be Active: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSessionPreferencesRead(ctx, d, meta)...)
+}
+
+func resourceSessionPreferencesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMConn()
+
+	log.Printf("[INFO] Deleting SSM Session Preferences: %s", d.Id())
+	_, err := conn.DeleteDocumentWithContext(ctx, &ssm.DeleteDocumentInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeInvalidDocument) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SSM Session Preferences (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDocumentDeleted(ctx, conn, d.Id()); err != nil && !tfawserr.ErrCodeEquals(err, ssm.ErrCodeInvalidDocument) {
+		return sdkdiag.AppendErrorf(diags, "waiting for SSM Session Preferences (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// updateSessionPreferencesDocument publishes the content as a new document version and makes it the default version.
+func updateSessionPreferencesDocument(ctx context.Context, conn *ssm.SSM, name, content string) error {
+	output, err := conn.UpdateDocumentWithContext(ctx, &ssm.UpdateDocumentInput{
+		Content:         aws.String(content),
+		DocumentFormat:  aws.String(ssm.DocumentFormatJson),
+		DocumentVersion: aws.String("$LATEST"),
+		Name:            aws.String(name),
+	})
+
+	var version string
+
+	if tfawserr.ErrCodeEquals(err, ssm.ErrCodeDuplicateDocumentContent) {
+		doc, err := FindDocumentByName(ctx, conn, name)
+
+		if err != nil {
+			return err
+		}
+
+		version = aws.StringValue(doc.LatestVersion)
+	} else if err != nil {
+		return err
+	} else {
+		version = aws.StringValue(output.DocumentDescription.DocumentVersion)
+	}
+
+	_, err = conn.UpdateDocumentDefaultVersionWithContext(ctx, &ssm.UpdateDocumentDefaultVersionInput{
+		DocumentVersion: aws.String(version),
+		Name:            aws.String(name),
+	})
+
+	return err
+}
+
+type sessionPreferencesDocument struct {
+	SchemaVersion string                   `json:"schemaVersion"`
+	Description   string                   `json:"description"`
+	SessionType   string                   `json:"sessionType"`
+	Inputs        sessionPreferencesInputs `json:"inputs"`
+}
+
+type sessionPreferencesInputs struct {
+	S3BucketName                string                         `json:"s3BucketName"`
+	S3KeyPrefix                 string                         `json:"s3KeyPrefix"`
+	S3EncryptionEnabled         bool                           `json:"s3EncryptionEnabled"`
+	CloudWatchLogGroupName      string                         `json:"cloudWatchLogGroupName"`
+	CloudWatchEncryptionEnabled bool                           `json:"cloudWatchEncryptionEnabled"`
+	CloudWatchStreamingEnabled  bool                           `json:"cloudWatchStreamingEnabled"`
+	KMSKeyID                    string                         `json:"kmsKeyId"`
+	RunAsEnabled                bool                           `json:"runAsEnabled"`
+	RunAsDefaultUser            string                         `json:"runAsDefaultUser"`
+	IdleSessionTimeout          string                         `json:"idleSessionTimeout"`
+	MaxSessionDuration          string                         `json:"maxSessionDuration"`
+	ShellProfile                sessionPreferencesShellProfile `json:"shellProfile"`
+}
+
+type sessionPreferencesShellProfile struct {
+	Linux   string `json:"linux"`
+	Windows string `json:"windows"`
+}
+
+func expandSessionPreferencesDocument(d *schema.ResourceData) (string, error) {
+	doc := sessionPreferencesDocument{
+		SchemaVersion: "1.0",
+		Description:   "Document to hold regional settings for Session Manager",
+		SessionType:   "Standard_Stream",
+		Inputs: sessionPreferencesInputs{
+			IdleSessionTimeout: strconv.Itoa(d.Get("idle_session_timeout").(int)),
+			KMSKeyID:           d.Get("kms_key_id").(string),
+		},
+	}
+
+	if v, ok := d.GetOk("cloudwatch_logging"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		doc.Inputs.CloudWatchEncryptionEnabled = tfMap["encryption_enabled"].(bool)
+		doc.Inputs.CloudWatchLogGroupName = tfMap["log_group_name"].(string)
+		doc.Inputs.CloudWatchStreamingEnabled = tfMap["streaming_enabled"].(bool)
+	}
+
+	if v, ok := d.GetOk("max_session_duration"); ok {
+		doc.Inputs.MaxSessionDuration = strconv.Itoa(v.(int))
+	}
+
+	if v, ok := d.GetOk("run_as_default_user"); ok {
+		doc.Inputs.RunAsEnabled = true
+		doc.Inputs.RunAsDefaultUser = v.(string)
+	}
+
+	if v, ok := d.GetOk("s3_logging"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		doc.Inputs.S3BucketName = tfMap["bucket_name"].(string)
+		doc.Inputs.S3EncryptionEnabled = tfMap["encryption_enabled"].(bool)
+		doc.Inputs.S3KeyPrefix = tfMap["key_prefix"].(string)
+	}
+
+	if v, ok := d.GetOk("shell_profile"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		doc.Inputs.ShellProfile.Linux = tfMap["linux"].(string)
+		doc.Inputs.ShellProfile.Windows = tfMap["windows"].(string)
+	}
+
+	b, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,200 @@
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+)
+
+func TestAccSSMSessionPreferences_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_session_preferences.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSessionPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSessionPreferencesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSessionPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_timeout", "20"),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "s3_logging.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "shell_profile.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMSessionPreferences_full(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_session_preferences.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ssm.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSessionPreferencesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSessionPreferencesConfig_full(rName, 15),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSessionPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_logging.0.log_group_name", "aws_cloudwatch_log_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging.0.encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging.0.streaming_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_timeout", "15"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "max_session_duration", "120"),
+					resource.TestCheckResourceAttr(resourceName, "run_as_default_user", "ssm-user"),
+					resource.TestCheckResourceAttr(resourceName, "s3_logging.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_logging.0.bucket_name", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "s3_logging.0.encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "s3_logging.0.key_prefix", "sessions/"),
+					resource.TestCheckResourceAttr(resourceName, "shell_profile.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "shell_profile.0.linux", "exec bash"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSessionPreferencesConfig_full(rName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSessionPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_timeout", "30"),
+				),
+			},
+			{
+				Config: testAccSessionPreferencesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSessionPreferencesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "kms_key_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "run_as_default_user", ""),
+					resource.TestCheckResourceAttr(resourceName, "s3_logging.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "shell_profile.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSessionPreferencesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM Session Preferences ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+
+		_, err := tfssm.FindDocumentByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckSessionPreferencesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn()
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_session_preferences" {
+				continue
+			}
+
+			_, err := tfssm.FindDocumentByName(ctx, conn, rs.Primary.ID)
+
+			if tfawserr.ErrCodeEquals(err, ssm.ErrCodeInvalidDocument) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSM Session Preferences %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSessionPreferencesConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_session_preferences" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccSessionPreferencesConfig_full(rName string, idleSessionTimeout int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_ssm_session_preferences" "test" {
+  name                 = %[1]q
+  idle_session_timeout = %[2]d
+  kms_key_id           = aws_kms_key.test.arn
+  max_session_duration = 120
+  run_as_default_user  = "ssm-user"
+
+  cloudwatch_logging {
+    log_group_name     = aws_cloudwatch_log_group.test.name
+    encryption_enabled = false
+    streaming_enabled  = true
+  }
+
+  s3_logging {
+    bucket_name        = aws_s3_bucket.test.bucket
+    encryption_enabled = false
+    key_prefix         = "sessions/"
+  }
+
+  shell_profile {
+    linux = "exec bash"
+  }
+}
+`, rName, idleSessionTimeout)
+}
@@ -35,6 +35,38 @@ func statusAssociation(ctx context.Context, conn *ssm.SSM, id string) resource.S
 	}
 }
 
+func statusAutomationExecution(ctx context.Context, conn *ssm.SSM, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAutomationExecutionByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AutomationExecutionStatus), nil
+	}
+}
+
+func statusCommand(ctx context.Context, conn *ssm.SSM, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCommandByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
 // statusDocument fetches the Document and its Status
 func statusDocument(ctx context.Context, conn *ssm.SSM, name string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
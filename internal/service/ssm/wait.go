@@ -3,10 +3,13 @@ package ssm
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -28,7 +31,7 @@ func waitAssociationSuccess(ctx context.Context, conn *ssm.SSM, id string, timeo
 
 	if output, ok := outputRaw.(*ssm.AssociationDescription); ok && output.Overview != nil {
 		if status := aws.StringValue(output.Overview.Status); status == ssm.AssociationStatusNameFailed {
-			tfresource.SetLastError(err, errors.New(aws.StringValue(output.Overview.DetailedStatus)))
+			tfresource.SetLastError(err, associationExecutionFailureError(ctx, conn, id, aws.StringValue(output.Overview.DetailedStatus)))
 		}
 		return output, err
 	}
@@ -36,6 +39,104 @@ func waitAssociationSuccess(ctx context.Context, conn *ssm.SSM, id string, timeo
 	return nil, err
 }
 
+func waitAutomationExecutionCompleted(ctx context.Context, conn *ssm.SSM, id string, timeout time.Duration) (*ssm.AutomationExecution, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ssm.AutomationExecutionStatusApproved,
+			ssm.AutomationExecutionStatusCancelling,
+			ssm.AutomationExecutionStatusChangeCalendarOverrideApproved,
+			ssm.AutomationExecutionStatusInProgress,
+			ssm.AutomationExecutionStatusPending,
+			ssm.AutomationExecutionStatusPendingApproval,
+			ssm.AutomationExecutionStatusPendingChangeCalendarOverride,
+			ssm.AutomationExecutionStatusRunbookInProgress,
+			ssm.AutomationExecutionStatusScheduled,
+			ssm.AutomationExecutionStatusWaiting,
+		},
+		Target:  []string{ssm.AutomationExecutionStatusCompletedWithSuccess, ssm.AutomationExecutionStatusSuccess},
+		Refresh: statusAutomationExecution(ctx, conn, id),
+		Timeout: timeout,
+		// Executions are not always immediately visible to GetAutomationExecution.
+		NotFoundChecks: 20,
+		Delay:          5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssm.AutomationExecution); ok {
+		if v := aws.StringValue(output.FailureMessage); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitCommandCompleted(ctx context.Context, conn *ssm.SSM, id string, timeout time.Duration) (*ssm.Command, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ssm.CommandStatusPending, ssm.CommandStatusInProgress, ssm.CommandStatusCancelling},
+		Target:  []string{ssm.CommandStatusSuccess},
+		Refresh: statusCommand(ctx, conn, id),
+		Timeout: timeout,
+		// Commands are not always immediately visible to ListCommands.
+		NotFoundChecks: 20,
+		Delay:          5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ssm.Command); ok {
+		if v := aws.StringValue(output.StatusDetails); v != "" {
+			tfresource.SetLastError(err, errors.New(v))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// associationExecutionFailureError returns an error describing the failed targets of the association's latest execution.
+// The association's detailed status is returned if the execution details cannot be read.
+func associationExecutionFailureError(ctx context.Context, conn *ssm.SSM, id, detailedStatus string) error {
+	execution, err := findLatestAssociationExecutionByAssociationID(ctx, conn, id)
+
+	if err != nil {
+		log.Printf("[WARN] reading SSM Association (%s) executions: %s", id, err)
+		return errors.New(detailedStatus)
+	}
+
+	input := &ssm.DescribeAssociationExecutionTargetsInput{
+		AssociationId: aws.String(id),
+		ExecutionId:   execution.ExecutionId,
+		Filters: []*ssm.AssociationExecutionTargetsFilter{{
+			Key:   aws.String(ssm.AssociationExecutionTargetsFilterKeyStatus),
+			Value: aws.String(ssm.AssociationStatusNameFailed),
+		}},
+	}
+
+	targets, err := findAssociationExecutionTargets(ctx, conn, input)
+
+	if err != nil {
+		log.Printf("[WARN] reading SSM Association (%s) execution (%s) targets: %s", id, aws.StringValue(execution.ExecutionId), err)
+		return errors.New(detailedStatus)
+	}
+
+	var errs *multierror.Error
+
+	for _, target := range targets {
+		errs = multierror.Append(errs, fmt.Errorf("%s (%s): %s", aws.StringValue(target.ResourceId), aws.StringValue(target.ResourceType), aws.StringValue(target.DetailedStatus)))
+	}
+
+	if errs == nil {
+		return errors.New(detailedStatus)
+	}
+
+	return fmt.Errorf("execution (%s) %s: %w", aws.StringValue(execution.ExecutionId), detailedStatus, errs)
+}
+
 // waitDocumentDeleted waits for an Document to return Deleted
 func waitDocumentDeleted(ctx context.Context, conn *ssm.SSM, name string) (*ssm.DocumentDescription, error) {
 	stateConf := &resource.StateChangeConf{
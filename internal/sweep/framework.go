@@ -19,7 +19,7 @@ import (
 
 type FrameworkSupplementalAttribute struct {
 	Path  string
-	Value string
+	Value any
 }
 
 type SweepFrameworkResource struct {
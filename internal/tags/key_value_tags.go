@@ -31,6 +31,9 @@ type DefaultConfig struct {
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	KeyPatterns []*regexp.Regexp
+	// KeyValues maps tag keys to patterns of the tag values to ignore.
+	KeyValues map[string]*regexp.Regexp
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -99,6 +102,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnorePatterns(config.KeyPatterns)
+	result = result.IgnoreValues(config.KeyValues)
 
 	return result
 }
@@ -150,6 +155,52 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnorePatterns returns tag keys not matching any of the patterns.
+func (tags KeyValueTags) IgnorePatterns(ignoreTagPatterns []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, ignoreTagPattern := range ignoreTagPatterns {
+			if ignoreTagPattern.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreValues returns tags whose value doesn't match the pattern configured for their key.
+func (tags KeyValueTags) IgnoreValues(ignoreTagValues map[string]*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if pattern, ok := ignoreTagValues[k]; ok {
+			var value string
+			if v != nil && v.Value != nil {
+				value = *v.Value
+			}
+
+			if pattern.MatchString(value) {
+				continue
+			}
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreRDS returns non-AWS and non-RDS tag keys.
 func (tags KeyValueTags) IgnoreRDS() KeyValueTags {
 	result := make(KeyValueTags)
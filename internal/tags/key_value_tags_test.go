@@ -2,6 +2,7 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -527,6 +528,41 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 			},
 			want: map[string]string{},
 		},
+		{
+			name: "key patterns",
+			tags: New(ctx, map[string]string{
+				"map-migrated-app": "value1",
+				"map-migrated":     "value2",
+				"key3":             "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyPatterns: []*regexp.Regexp{
+					regexp.MustCompile(`^map-migrated-.+`),
+				},
+			},
+			want: map[string]string{
+				"map-migrated": "value2",
+				"key3":         "value3",
+			},
+		},
+		{
+			name: "key values",
+			tags: New(ctx, map[string]string{
+				"key1": "TICKET-123",
+				"key2": "TICKET-456",
+				"key3": "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyValues: map[string]*regexp.Regexp{
+					"key1": regexp.MustCompile(`^TICKET-\d+$`),
+					"key3": regexp.MustCompile(`^TICKET-\d+$`),
+				},
+			},
+			want: map[string]string{
+				"key2": "TICKET-456",
+				"key3": "value3",
+			},
+		},
 		{
 			name: "key prefixes some prefixed",
 			tags: New(ctx, map[string]string{
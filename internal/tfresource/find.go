@@ -0,0 +1,69 @@
+package tfresource
+
+import (
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// PagesFunc calls an AWS SDK for Go v1 `...PagesWithContext` API operation, invoking `fn` for each page of results.
+// P is the API operation's output type; pages are passed by pointer.
+type PagesFunc[P any] func(fn func(page *P, lastPage bool) bool) error
+
+// FindAllPages returns all the elements of all pages of a paginated List or Describe API operation that satisfy all the specified filters.
+// `items` extracts the elements from a page. Nil pages are skipped.
+// An API error whose code is one of `notFoundErrorCodes` is returned as a `resource.NotFoundError` for `lastRequest`.
+func FindAllPages[P, T any](lastRequest any, pages PagesFunc[P], items func(*P) []T, notFoundErrorCodes []string, filters ...tfslices.FilterFunc[T]) ([]T, error) {
+	var output []T
+
+	err := pages(func(page *P, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+	elements:
+		for _, v := range items(page) {
+			for _, filter := range filters {
+				if !filter(v) {
+					continue elements
+				}
+			}
+
+			output = append(output, v)
+		}
+
+		return !lastPage
+	})
+
+	if len(notFoundErrorCodes) > 0 && tfawserr.ErrCodeEquals(err, notFoundErrorCodes...) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: lastRequest,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// NotNil is a filter that excludes nil elements.
+func NotNil[T any](v *T) bool {
+	return v != nil
+}
+
+// AssertSinglePtrResult returns the single non-nil element of a slice of pointers.
+// An EmptyResultError is returned if there are no such elements and a TooManyResultsError if there are more than one.
+func AssertSinglePtrResult[T any](a []*T, lastRequest any) (*T, error) {
+	a = tfslices.Filter(a, NotNil[T])
+
+	if l := len(a); l == 0 {
+		return nil, NewEmptyResultError(lastRequest)
+	} else if l > 1 {
+		return nil, NewTooManyResultsError(l, lastRequest)
+	}
+
+	return a[0], nil
+}
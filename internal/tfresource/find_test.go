@@ -0,0 +1,89 @@
+package tfresource
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/google/go-cmp/cmp"
+)
+
+type findTestPage struct {
+	Items []*string
+}
+
+func findTestPages(pages ...*findTestPage) PagesFunc[findTestPage] {
+	return func(fn func(*findTestPage, bool) bool) error {
+		for i, page := range pages {
+			if !fn(page, i == len(pages)-1) {
+				break
+			}
+		}
+
+		return nil
+	}
+}
+
+func findTestItems(page *findTestPage) []*string {
+	return page.Items
+}
+
+func TestFindAllPages(t *testing.T) {
+	t.Parallel()
+
+	pages := findTestPages(
+		&findTestPage{Items: aws.StringSlice([]string{"one", "two"})},
+		nil,
+		&findTestPage{Items: []*string{nil, aws.String("three")}},
+	)
+
+	output, err := FindAllPages(nil, pages, findTestItems, nil, NotNil[string], func(v *string) bool {
+		return aws.StringValue(v) != "two"
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(aws.StringValueSlice(output), []string{"one", "three"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFindAllPagesError(t *testing.T) {
+	t.Parallel()
+
+	lastRequest := 123
+	pages := func(fn func(*findTestPage, bool) bool) error {
+		return awserr.New("ResourceNotFoundException", "not found", nil)
+	}
+
+	_, err := FindAllPages(lastRequest, pages, findTestItems, []string{"ResourceNotFoundException"})
+
+	if !NotFound(err) {
+		t.Errorf("expected NotFound error, got %s", err)
+	}
+
+	_, err = FindAllPages(lastRequest, pages, findTestItems, []string{"ValidationException"})
+
+	if err == nil || NotFound(err) {
+		t.Errorf("expected API error, got %v", err)
+	}
+}
+
+func TestAssertSinglePtrResult(t *testing.T) {
+	t.Parallel()
+
+	if _, err := AssertSinglePtrResult([]*string{nil}, nil); !errors.Is(err, ErrEmptyResult) {
+		t.Errorf("expected empty result error, got %v", err)
+	}
+
+	if _, err := AssertSinglePtrResult(aws.StringSlice([]string{"one", "two"}), nil); !errors.Is(err, ErrTooManyResults) {
+		t.Errorf("expected too many results error, got %v", err)
+	}
+
+	if v, err := AssertSinglePtrResult([]*string{nil, aws.String("one")}, nil); err != nil || aws.StringValue(v) != "one" {
+		t.Errorf("unexpected result: %v, %v", aws.StringValue(v), err)
+	}
+}
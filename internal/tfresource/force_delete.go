@@ -0,0 +1,92 @@
+package tfresource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ForceDeleter deletes a resource that has a force_delete or force_destroy argument.
+// All such resources share the same semantics:
+//   - Without Force, the resource is deleted once and an error is returned if it still has dependents.
+//   - With Force, dependents are cleaned up before the resource is deleted and, if IsDependencyViolation
+//     is set, deletion is retried while the API reports a dependency violation, until Timeout expires.
+//   - DryRun describes the dependents that a forced delete would also delete.
+type ForceDeleter struct {
+	// Force is the value of the resource's force_delete or force_destroy argument.
+	Force bool
+	// Timeout bounds the retries on dependency violations. Required if IsDependencyViolation is set.
+	Timeout time.Duration
+
+	// Delete deletes the resource, passing force to APIs that support forced deletion.
+	Delete func(ctx context.Context, force bool) error
+	// DeleteDependents, if set, deletes the resource's dependents before each forced delete attempt.
+	// It is not needed when the API deletes dependents itself.
+	DeleteDependents func(ctx context.Context) error
+	// IsDependencyViolation, if set, returns whether an error from Delete means that the resource still has dependents.
+	IsDependencyViolation func(err error) bool
+	// ListDependents, if set, returns descriptions of the resource's dependents.
+	ListDependents func(ctx context.Context) ([]string, error)
+}
+
+// Run deletes the resource.
+func (fd *ForceDeleter) Run(ctx context.Context) error {
+	if !fd.Force {
+		return fd.Delete(ctx, false)
+	}
+
+	f := func() (interface{}, error) {
+		if fd.DeleteDependents != nil {
+			if err := fd.DeleteDependents(ctx); err != nil {
+				return nil, fmt.Errorf("deleting dependents: %w", err)
+			}
+		}
+
+		return nil, fd.Delete(ctx, true)
+	}
+
+	if fd.IsDependencyViolation == nil {
+		_, err := f()
+
+		return err
+	}
+
+	_, err := RetryWhen(ctx, fd.Timeout, f, func(err error) (bool, error) {
+		if fd.IsDependencyViolation(err) {
+			return true, err
+		}
+
+		return false, err
+	})
+
+	return err
+}
+
+// forceDeleterDryRunMaxDependents is the maximum number of dependents that DryRun describes individually.
+const forceDeleterDryRunMaxDependents = 10
+
+// DryRun returns a description of the dependents that a forced delete would also delete,
+// or an empty string if there are none or they can't be listed.
+// Only the first few dependents are listed, followed by the number of remaining ones.
+func (fd *ForceDeleter) DryRun(ctx context.Context) (string, error) {
+	if fd.ListDependents == nil {
+		return "", nil
+	}
+
+	dependents, err := fd.ListDependents(ctx)
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(dependents) == 0 {
+		return "", nil
+	}
+
+	if n := len(dependents) - forceDeleterDryRunMaxDependents; n > 0 {
+		return fmt.Sprintf("%s and %d more", strings.Join(dependents[:forceDeleterDryRunMaxDependents], ", "), n), nil
+	}
+
+	return strings.Join(dependents, ", "), nil
+}
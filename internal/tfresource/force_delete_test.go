@@ -0,0 +1,180 @@
+package tfresource_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestForceDeleterRun(t *testing.T) {
+	t.Parallel()
+
+	errDependencyViolation := errors.New("DependencyViolation")
+
+	testCases := []struct {
+		Name                    string
+		Force                   bool
+		Violations              int
+		ExpectError             bool
+		ExpectDeletes           int
+		ExpectDependentsDeletes int
+		ExpectForceAPIParameter bool
+	}{
+		{
+			Name:          "not forced",
+			ExpectDeletes: 1,
+		},
+		{
+			Name:          "not forced with dependents",
+			Violations:    1,
+			ExpectError:   true,
+			ExpectDeletes: 1,
+		},
+		{
+			Name:                    "forced",
+			Force:                   true,
+			ExpectDeletes:           1,
+			ExpectDependentsDeletes: 1,
+			ExpectForceAPIParameter: true,
+		},
+		{
+			Name:                    "forced with dependency violations",
+			Force:                   true,
+			Violations:              2,
+			ExpectDeletes:           3,
+			ExpectDependentsDeletes: 3,
+			ExpectForceAPIParameter: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := acctest.Context(t)
+			var deletes, dependentsDeletes, dependentsLists int
+			var force bool
+
+			fd := &tfresource.ForceDeleter{
+				Force:   testCase.Force,
+				Timeout: 30 * time.Second,
+				Delete: func(_ context.Context, f bool) error {
+					deletes++
+					force = f
+
+					if deletes <= testCase.Violations {
+						return errDependencyViolation
+					}
+
+					return nil
+				},
+				DeleteDependents: func(context.Context) error {
+					dependentsDeletes++
+
+					return nil
+				},
+				IsDependencyViolation: func(err error) bool {
+					return errors.Is(err, errDependencyViolation)
+				},
+				ListDependents: func(context.Context) ([]string, error) {
+					dependentsLists++
+
+					return nil, nil
+				},
+			}
+
+			err := fd.Run(ctx)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := deletes, testCase.ExpectDeletes; got != expected {
+				t.Errorf("Delete calls: got %d, expected %d", got, expected)
+			}
+
+			if got, expected := dependentsDeletes, testCase.ExpectDependentsDeletes; got != expected {
+				t.Errorf("DeleteDependents calls: got %d, expected %d", got, expected)
+			}
+
+			if got, expected := force, testCase.ExpectForceAPIParameter; got != expected {
+				t.Errorf("force: got %t, expected %t", got, expected)
+			}
+
+			if got := dependentsLists; got != 0 {
+				t.Errorf("ListDependents calls: got %d, expected 0", got)
+			}
+		})
+	}
+}
+
+func TestForceDeleterDryRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	testCases := []struct {
+		Name     string
+		List     func(context.Context) ([]string, error)
+		Expected string
+	}{
+		{
+			Name: "no list function",
+		},
+		{
+			Name: "no dependents",
+			List: func(context.Context) ([]string, error) {
+				return nil, nil
+			},
+		},
+		{
+			Name: "dependents",
+			List: func(context.Context) ([]string, error) {
+				return []string{"image latest", "image v1"}, nil
+			},
+			Expected: "image latest, image v1",
+		},
+		{
+			Name: "many dependents",
+			List: func(context.Context) ([]string, error) {
+				var images []string
+
+				for i := 0; i < 25; i++ {
+					images = append(images, fmt.Sprintf("image v%d", i))
+				}
+
+				return images, nil
+			},
+			Expected: "image v0, image v1, image v2, image v3, image v4, image v5, image v6, image v7, image v8, image v9 and 15 more",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			fd := &tfresource.ForceDeleter{ListDependents: testCase.List}
+
+			got, err := fd.DryRun(ctx)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
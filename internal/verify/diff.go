@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -55,6 +56,52 @@ func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{
 	return nil
 }
 
+// ValidARNInPartition returns a SchemaValidateFunc that validates an ARN for the specified service
+// in the partition, and the region if the ARN has one, the provider is configured for.
+// It must be called with the configured provider's meta, so can only be used at plan time.
+func ValidARNInPartition(meta interface{}, service string) schema.SchemaValidateFunc {
+	client := meta.(*conns.AWSClient)
+
+	return ValidARNCheck(
+		ARNCheckPartition(client.Partition),
+		ARNCheckRegion(client.Region),
+		ARNCheckService(service),
+	)
+}
+
+// ValidARNInPartitionDiff returns a CustomizeDiffFunc that validates the ARNs planned for the specified
+// top-level attribute, a string or a set or list of strings, with ValidARNInPartition.
+func ValidARNInPartitionDiff(key, service string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+
+		var values []interface{}
+
+		switch v := diff.Get(key).(type) {
+		case string:
+			values = append(values, v)
+		case []interface{}:
+			values = v
+		case *schema.Set:
+			values = v.List()
+		}
+
+		var errs *multierror.Error
+		f := ValidARNInPartition(meta, service)
+
+		for _, v := range values {
+			if v, ok := v.(string); ok {
+				_, es := f(v, key)
+				errs = multierror.Append(errs, es...)
+			}
+		}
+
+		return errs.ErrorOrNil()
+	}
+}
+
 // SuppressEquivalentStringCaseInsensitive provides custom difference suppression
 // for strings that are equal under case-insensitivity.
 func SuppressEquivalentStringCaseInsensitive(k, old, new string, d *schema.ResourceData) bool {
@@ -36,19 +36,80 @@ func Valid4ByteASN(v interface{}, k string) (ws []string, errors []error) {
 }
 
 func ValidARN(v interface{}, k string) (ws []string, errors []error) {
-	value := v.(string)
+	return ValidARNCheck()(v, k)
+}
 
-	if value == "" {
-		return ws, errors
+// ARNCheckFunc is an additional check of a parsed ARN's components.
+type ARNCheckFunc func(arn.ARN) error
+
+// ARNCheckPartition checks that an ARN is in the specified partition.
+func ARNCheckPartition(partition string) ARNCheckFunc {
+	return func(v arn.ARN) error {
+		if v.Partition != partition {
+			return fmt.Errorf("partition (%s) does not match the expected partition (%s)", v.Partition, partition)
+		}
+
+		return nil
+	}
+}
+
+// ARNCheckRegion checks that an ARN with a region is in the specified region.
+// ARNs of global resources, which have no region, pass the check.
+func ARNCheckRegion(region string) ARNCheckFunc {
+	return func(v arn.ARN) error {
+		if v.Region != "" && v.Region != region {
+			return fmt.Errorf("region (%s) does not match the expected region (%s)", v.Region, region)
+		}
+
+		return nil
 	}
+}
 
-	parsedARN, err := arn.Parse(value)
+// ARNCheckService checks that an ARN is for the specified service.
+func ARNCheckService(service string) ARNCheckFunc {
+	return func(v arn.ARN) error {
+		if v.Service != service {
+			return fmt.Errorf("service (%s) does not match the expected service (%s)", v.Service, service)
+		}
+
+		return nil
+	}
+}
+
+// ValidARNCheck returns a SchemaValidateFunc that validates an ARN as ValidARN does
+// and, if it is well-formed, also applies the specified checks.
+func ValidARNCheck(f ...ARNCheckFunc) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+
+		if value == "" {
+			return ws, errors
+		}
+
+		parsedARN, err := arn.Parse(value)
+
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+			return ws, errors
+		}
+
+		errors = append(errors, validARNComponents(parsedARN, k, value)...)
+
+		if len(errors) > 0 {
+			return ws, errors
+		}
+
+		for _, check := range f {
+			if err := check(parsedARN); err != nil {
+				errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+			}
+		}
 
-	if err != nil {
-		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
 		return ws, errors
 	}
+}
 
+func validARNComponents(parsedARN arn.ARN, k, value string) (errors []error) {
 	if parsedARN.Partition == "" {
 		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: missing partition value", k, value))
 	} else if !partitionRegexp.MatchString(parsedARN.Partition) {
@@ -67,7 +128,7 @@ func ValidARN(v interface{}, k string) (ws []string, errors []error) {
 		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: missing resource value", k, value))
 	}
 
-	return ws, errors
+	return errors
 }
 
 func ValidAccountID(v interface{}, k string) (ws []string, errors []error) {
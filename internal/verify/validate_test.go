@@ -173,6 +173,33 @@ func TestValidARN(t *testing.T) {
 	}
 }
 
+func TestValidARNCheck(t *testing.T) {
+	t.Parallel()
+
+	f := ValidARNCheck(ARNCheckPartition("aws-cn"), ARNCheckRegion("cn-north-1"), ARNCheckService("servicediscovery")) //lintignore:AWSAT003
+
+	for _, ts := range []struct {
+		value string
+		valid bool
+	}{
+		{"", true},
+		{"arn:aws-cn:servicediscovery:cn-north-1:123456789012:service/srv-12345678", true},      //lintignore:AWSAT003,AWSAT005
+		{"arn:aws-cn:servicediscovery::123456789012:service/srv-12345678", true},                //lintignore:AWSAT005
+		{"arn:aws:servicediscovery:us-east-1:123456789012:service/srv-12345678", false},         //lintignore:AWSAT003,AWSAT005
+		{"arn:aws-cn:servicediscovery:cn-northwest-1:123456789012:service/srv-12345678", false}, //lintignore:AWSAT003,AWSAT005
+		{"arn:aws-cn:ecs:cn-north-1:123456789012:service/srv-12345678", false},                  //lintignore:AWSAT003,AWSAT005
+		{"arn:aws-cn", false},
+	} {
+		_, errors := f(ts.value, "arn")
+		if !ts.valid && len(errors) == 0 {
+			t.Fatalf("%q should be an invalid ARN", ts.value)
+		}
+		if ts.valid && len(errors) != 0 {
+			t.Fatalf("%q should be a valid ARN: %q", ts.value, errors)
+		}
+	}
+}
+
 func TestValidateCIDRBlock(t *testing.T) {
 	t.Parallel()
 
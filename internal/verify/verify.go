@@ -1,8 +1,13 @@
 package verify
 
 import (
+	"errors"
+	"strings"
+
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/smithy-go"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"gopkg.in/yaml.v2"
 )
 
@@ -64,69 +69,120 @@ func ErrorISOUnsupported(partition string, err error) bool {
 		return false
 	}
 
+	return errorUnsupported(err)
+}
+
+// errorUnsupported returns whether an error suggests that the requested feature
+// or operation isn't supported, regardless of partition.
+func errorUnsupported(err error) bool {
 	if err == nil { // not strictly necessary but make logic clearer
 		return false
 	}
 
-	if tfawserr.ErrCodeContains(err, ErrCodeAccessDenied) {
+	if errCodeContains(err, ErrCodeAccessDenied) {
 		return true
 	}
 
-	if tfawserr.ErrCodeContains(err, ErrCodeAuthorizationError) {
+	if errCodeContains(err, ErrCodeAuthorizationError) {
 		return true
 	}
 
-	if tfawserr.ErrCodeContains(err, ErrCodeInternalException) {
+	if errCodeContains(err, ErrCodeInternalException) {
 		return true
 	}
 
-	if tfawserr.ErrCodeContains(err, ErrCodeInternalServiceError) {
+	if errCodeContains(err, ErrCodeInternalServiceError) {
 		return true
 	}
 
-	if tfawserr.ErrCodeContains(err, ErrCodeInvalidAction) {
+	if errCodeContains(err, ErrCodeInvalidAction) {
 		return true
 	}
 
-	if tfawserr.ErrCodeContains(err, ErrCodeInvalidParameterException) {
+	if errCodeContains(err, ErrCodeInvalidParameterException) {
 		return true
 	}
 
-	if tfawserr.ErrCodeContains(err, ErrCodeInvalidParameterValue) {
+	if errCodeContains(err, ErrCodeInvalidParameterValue) {
 		return true
 	}
 
-	if tfawserr.ErrCodeContains(err, ErrCodeInvalidRequest) {
+	if errCodeContains(err, ErrCodeInvalidRequest) {
 		return true
 	}
 
-	if tfawserr.ErrCodeContains(err, ErrCodeOperationDisabledException) {
+	if errCodeContains(err, ErrCodeOperationDisabledException) {
 		return true
 	}
 
-	if tfawserr.ErrCodeContains(err, ErrCodeOperationNotPermitted) {
+	if errCodeContains(err, ErrCodeOperationNotPermitted) {
 		return true
 	}
 
-	if tfawserr.ErrCodeContains(err, ErrCodeUnknownOperationException) {
+	if errCodeContains(err, ErrCodeUnknownOperationException) {
 		return true
 	}
 
-	if tfawserr.ErrCodeContains(err, ErrCodeUnsupportedFeatureException) {
+	if errCodeContains(err, ErrCodeUnsupportedFeatureException) {
 		return true
 	}
 
-	if tfawserr.ErrCodeContains(err, ErrCodeUnsupportedOperation) {
+	if errCodeContains(err, ErrCodeUnsupportedOperation) {
 		return true
 	}
 
-	if tfawserr.ErrMessageContains(err, ErrCodeValidationError, "not support tagging") {
+	if errMessageContains(err, ErrCodeValidationError, "not support tagging") {
 		return true
 	}
 
-	if tfawserr.ErrCodeContains(err, ErrCodeValidationException) {
+	if errCodeContains(err, ErrCodeValidationException) {
 		return true
 	}
 
 	return false
 }
+
+// errCodeContains returns whether the error code of an AWS SDK for Go v1 or v2 API error contains the specified code.
+func errCodeContains(err error, code string) bool {
+	if tfawserr.ErrCodeContains(err, code) {
+		return true
+	}
+
+	var apiErr smithy.APIError
+
+	return errors.As(err, &apiErr) && strings.Contains(apiErr.ErrorCode(), code)
+}
+
+// errMessageContains returns whether an AWS SDK for Go v1 or v2 API error has the specified code and its message contains the specified message.
+func errMessageContains(err error, code string, message string) bool {
+	if tfawserr.ErrMessageContains(err, code, message) {
+		return true
+	}
+
+	var apiErr smithy.APIError
+
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == code && strings.Contains(apiErr.ErrorMessage(), message)
+}
+
+// ErrorTaggingUnsupported returns whether an error from a tagging operation
+// should be reported as a warning and ignored rather than returned. This is the
+// case when the error suggests that tagging isn't supported and either tagging
+// failures have been allowed with the provider's skip_tagging argument or the
+// resource is in a partition (e.g., ISO) where tagging may be unsupported and
+// the provider's strict_tagging argument isn't set. Other errors, such as
+// missing resources or throttling, are always returned.
+func ErrorTaggingUnsupported(client *conns.AWSClient, err error) bool {
+	if !errorUnsupported(err) {
+		return false
+	}
+
+	if client.SkipTagging {
+		return true
+	}
+
+	if client.StrictTagging {
+		return false
+	}
+
+	return client.Partition != endpoints.AwsPartitionID
+}
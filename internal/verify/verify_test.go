@@ -1,7 +1,13 @@
 package verify
 
 import (
+	"fmt"
 	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 func TestCheckYAMLString(t *testing.T) {
@@ -41,3 +47,112 @@ abc:
 		t.Fatalf("Got:\n\n%s\n\nExpected:\n\n%s\n", actual, invalidYaml)
 	}
 }
+
+func TestErrorTaggingUnsupported(t *testing.T) {
+	t.Parallel()
+
+	accessDenied := awserr.New(ErrCodeAccessDenied, "not authorized", nil)
+	notFound := awserr.New("ResourceNotFoundException", "not found", nil)
+	accessDeniedV2 := &smithy.GenericAPIError{Code: ErrCodeAccessDenied, Message: "not authorized"}
+	notFoundV2 := &smithy.GenericAPIError{Code: "ResourceNotFoundException", Message: "not found"}
+
+	testCases := []struct {
+		name          string
+		partition     string
+		skipTagging   bool
+		strictTagging bool
+		err           error
+		expected      bool
+	}{
+		{
+			name:      "no error",
+			partition: endpoints.AwsIsoPartitionID,
+		},
+		{
+			name:      "commercial partition",
+			partition: endpoints.AwsPartitionID,
+			err:       accessDenied,
+		},
+		{
+			name:      "ISO partition",
+			partition: endpoints.AwsIsoPartitionID,
+			err:       accessDenied,
+			expected:  true,
+		},
+		{
+			name:      "ISO partition unrelated error",
+			partition: endpoints.AwsIsoPartitionID,
+			err:       notFound,
+		},
+		{
+			name:      "ISO partition AWS SDK for Go v2",
+			partition: endpoints.AwsIsoPartitionID,
+			err:       fmt.Errorf("tagging: %w", accessDeniedV2),
+			expected:  true,
+		},
+		{
+			name:      "ISO partition unrelated error AWS SDK for Go v2",
+			partition: endpoints.AwsIsoPartitionID,
+			err:       notFoundV2,
+		},
+		{
+			name:        "skip tagging",
+			partition:   endpoints.AwsPartitionID,
+			skipTagging: true,
+			err:         accessDenied,
+			expected:    true,
+		},
+		{
+			name:        "skip tagging AWS SDK for Go v2",
+			partition:   endpoints.AwsPartitionID,
+			skipTagging: true,
+			err:         fmt.Errorf("tagging: %w", accessDeniedV2),
+			expected:    true,
+		},
+		{
+			name:        "skip tagging unrelated error",
+			partition:   endpoints.AwsPartitionID,
+			skipTagging: true,
+			err:         notFound,
+		},
+		{
+			name:        "skip tagging unrelated error AWS SDK for Go v2",
+			partition:   endpoints.AwsPartitionID,
+			skipTagging: true,
+			err:         notFoundV2,
+		},
+		{
+			name:        "skip tagging no error",
+			partition:   endpoints.AwsPartitionID,
+			skipTagging: true,
+		},
+		{
+			name:          "strict tagging ISO partition",
+			partition:     endpoints.AwsIsoPartitionID,
+			strictTagging: true,
+			err:           accessDenied,
+		},
+		{
+			name:          "strict tagging and skip tagging",
+			partition:     endpoints.AwsIsoPartitionID,
+			skipTagging:   true,
+			strictTagging: true,
+			err:           accessDenied,
+			expected:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &conns.AWSClient{Partition: testCase.partition, SkipTagging: testCase.skipTagging, StrictTagging: testCase.strictTagging}
+
+			if got := ErrorTaggingUnsupported(client, testCase.err); got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
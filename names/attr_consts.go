@@ -1,12 +0,0 @@
-package names
-
-const (
-	AttrARN         = "arn"
-	AttrDescription = "description"
-	AttrEnabled     = "enabled"
-	AttrKMSKeyARN   = "kms_key_arn"
-	AttrName        = "name"
-	AttrTags        = "tags"
-	AttrTagsAll     = "tags_all"
-	AttrType        = "type"
-)
@@ -0,0 +1,19 @@
+// Code generated by internal/generate/attrconsts/main.go; DO NOT EDIT.
+package names
+
+const (
+	AttrARN                  = "arn"
+	AttrAssignPublicIP       = "assign_public_ip"
+	AttrDescription          = "description"
+	AttrEnabled              = "enabled"
+	AttrKMSKeyARN            = "kms_key_arn"
+	AttrName                 = "name"
+	AttrNetworkConfiguration = "network_configuration"
+	AttrSecurityGroupIDs     = "security_group_ids"
+	AttrSecurityGroups       = "security_groups"
+	AttrSubnetIDs            = "subnet_ids"
+	AttrSubnets              = "subnets"
+	AttrTags                 = "tags"
+	AttrTagsAll              = "tags_all"
+	AttrType                 = "type"
+)
@@ -1,4 +1,5 @@
 //go:generate go run ../internal/generate/namesconsts/main.go
+//go:generate go run ../internal/generate/attrconsts/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package names
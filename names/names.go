@@ -27,6 +27,8 @@ const (
 	CloudWatchLogsEndpointID       = "logs"
 	ComprehendEndpointID           = "comprehend"
 	ComputeOptimizerEndpointID     = "computeoptimizer"
+	ConfigServiceEndpointID        = "config"
+	ECSEndpointID                  = "ecs"
 	IdentityStoreEndpointID        = "identitystore"
 	Inspector2EndpointID           = "inspector2"
 	IVSChatEndpointID              = "ivschat"